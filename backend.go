@@ -0,0 +1,160 @@
+// Copyright 2014 Joseph Hager. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package webgl
+
+// Backend is the WebGL 1.0 API as a Go interface. Context implements it by
+// calling into the browser's WebGL implementation; other implementations
+// can record, mock or emulate the calls, which lets rendering code written
+// against Backend run under go test without a JavaScript runtime.
+//
+// Methods of Context that take or return raw JavaScript values, such as
+// GetParameter, ReadPixels or the DOM source overloads of TexImage2D, are
+// not part of Backend.
+type Backend interface {
+	GetContextAttributes() ContextAttributes
+	ActiveTexture(texture GLenum)
+	AttachShader(program Program, shader Shader)
+	BindAttribLocation(program Program, index int, name string)
+	BindBuffer(target GLenum, buffer Buffer)
+	BindFramebuffer(target GLenum, framebuffer Framebuffer)
+	BindRenderbuffer(target GLenum, renderbuffer Renderbuffer)
+	BindTexture(target GLenum, texture Texture)
+	BlendColor(r, g, b, a float64)
+	BlendEquation(mode GLenum)
+	BlendEquationSeparate(modeRGB, modeAlpha GLenum)
+	BlendFunc(sfactor, dfactor GLenum)
+	BlendFuncSeparate(srcRGB, dstRGB, srcAlpha, dstAlpha GLenum)
+	BufferData(target GLenum, data interface{}, usage GLenum)
+	BufferSubData(target GLenum, offset int, data interface{})
+	BufferDataSize(target GLenum, size int, usage GLenum)
+	BufferDataBytes(target GLenum, data []byte, usage GLenum)
+	BufferDataUint16(target GLenum, data []uint16, usage GLenum)
+	BufferDataFloat32(target GLenum, data []float32, usage GLenum)
+	BufferSubDataBytes(target GLenum, offset int, data []byte)
+	BufferSubDataUint16(target GLenum, offset int, data []uint16)
+	BufferSubDataFloat32(target GLenum, offset int, data []float32)
+	CheckFramebufferStatus(target GLenum) GLenum
+	Clear(flags GLenum)
+	ClearColor(r, g, b, a float32)
+	ClearDepth(depth float64)
+	ClearStencil(s int)
+	ColorMask(r, g, b, a bool)
+	CompileShader(shader Shader)
+	CopyTexImage2D(target GLenum, level int, internal GLenum, x, y, w, h, border int)
+	CopyTexSubImage2D(target GLenum, level, xoffset, yoffset, x, y, w, h int)
+	CreateBuffer() Buffer
+	CreateFramebuffer() Framebuffer
+	CreateProgram() Program
+	CreateRenderbuffer() Renderbuffer
+	CreateShader(typ GLenum) Shader
+	CreateTexture() Texture
+	CullFace(mode GLenum)
+	DeleteBuffer(buffer Buffer)
+	DeleteFramebuffer(framebuffer Framebuffer)
+	DeleteProgram(program Program)
+	DeleteRenderbuffer(renderbuffer Renderbuffer)
+	DeleteShader(shader Shader)
+	DeleteTexture(texture Texture)
+	DepthFunc(fun GLenum)
+	DepthMask(flag bool)
+	DepthRange(zNear, zFar float64)
+	DetachShader(program Program, shader Shader)
+	Disable(cap GLenum)
+	DisableVertexAttribArray(index int)
+	DrawingBufferWidth() int
+	DrawingBufferHeight() int
+	DrawArrays(mode GLenum, first, count int)
+	DrawElements(mode GLenum, count int, typ GLenum, offset int)
+	Enable(cap GLenum)
+	EnableVertexAttribArray(index int)
+	Finish()
+	Flush()
+	FramebufferRenderbuffer(target, attachment, renderbufferTarget GLenum, renderbuffer Renderbuffer)
+	FramebufferTexture2D(target, attachment, textarget GLenum, texture Texture, level int)
+	FrontFace(mode GLenum)
+	GenerateMipmap(target GLenum)
+	GetActiveAttribInfo(program Program, index int) ActiveInfo
+	GetActiveUniformInfo(program Program, index int) ActiveInfo
+	GetAttachedShaders(program Program) []Shader
+	GetAttribLocation(program Program, name string) int
+	GetError() ErrorCode
+	GetProgramParameteri(program Program, pname GLenum) int
+	GetProgramParameterb(program Program, pname GLenum) bool
+	GetProgramInfoLog(program Program) string
+	GetShaderParameterb(shader Shader, pname GLenum) bool
+	GetShaderInfoLog(shader Shader) string
+	GetShaderSource(shader Shader) string
+	GetStencilBits() int
+	GetStencilClearValue() int
+	GetStencilState(face GLenum) StencilState
+	GetSupportedExtensions() []string
+	GetUniformLocation(program Program, name string) UniformLocation
+	GetVertexAttribOffset(index int, pname GLenum) int
+	Hint(target, mode GLenum)
+	IsBuffer(buffer Buffer) bool
+	IsContextLost() bool
+	IsFramebuffer(framebuffer Framebuffer) bool
+	IsProgram(program Program) bool
+	IsRenderbuffer(renderbuffer Renderbuffer) bool
+	IsShader(shader Shader) bool
+	IsTexture(texture Texture) bool
+	IsEnabled(capability GLenum) bool
+	LineWidth(width float64)
+	LinkProgram(program Program)
+	PixelStorei(pname GLenum, param int)
+	PolygonOffset(factor, units float64)
+	ReadPixelsBytes(x, y, width, height int, format, typ GLenum, pixels []byte)
+	ReadPixelsFloat32(x, y, width, height int, format, typ GLenum, pixels []float32)
+	RenderbufferStorage(target, internalFormat GLenum, width, height int)
+	SampleCoverage(value float64, invert bool)
+	Scissor(x, y, width, height int)
+	ShaderSource(shader Shader, source string)
+	StencilFunc(fun GLenum, ref int, mask uint32)
+	StencilFuncSeparate(face, fun GLenum, ref int, mask uint32)
+	StencilMask(mask uint32)
+	StencilMaskSeparate(face GLenum, mask uint32)
+	StencilOp(fail, zfail, zpass GLenum)
+	StencilOpSeparate(face, fail, zfail, zpass GLenum)
+	TexImage2DBytes(target GLenum, level int, internalFormat GLenum, width, height, border int, format, typ GLenum, pixels []byte)
+	TexImage2DUint16(target GLenum, level int, internalFormat GLenum, width, height, border int, format, typ GLenum, pixels []uint16)
+	TexImage2DFloat32(target GLenum, level int, internalFormat GLenum, width, height, border int, format, typ GLenum, pixels []float32)
+	TexParameteri(target, pname GLenum, param int)
+	TexSubImage2DBytes(target GLenum, level, xoffset, yoffset, width, height int, format, typ GLenum, pixels []byte)
+	TexSubImage2DUint16(target GLenum, level, xoffset, yoffset, width, height int, format, typ GLenum, pixels []uint16)
+	TexSubImage2DFloat32(target GLenum, level, xoffset, yoffset, width, height int, format, typ GLenum, pixels []float32)
+	Uniform1f(location UniformLocation, x float32)
+	Uniform1i(location UniformLocation, x int)
+	Uniform2f(location UniformLocation, x, y float32)
+	Uniform2i(location UniformLocation, x, y int)
+	Uniform3f(location UniformLocation, x, y, z float32)
+	Uniform3i(location UniformLocation, x, y, z int)
+	Uniform4f(location UniformLocation, x, y, z, w float32)
+	Uniform4i(location UniformLocation, x, y, z, w int)
+	Uniform1fv(location UniformLocation, v []float32)
+	Uniform1iv(location UniformLocation, v []int32)
+	Uniform2fv(location UniformLocation, v []float32)
+	Uniform2iv(location UniformLocation, v []int32)
+	Uniform3fv(location UniformLocation, v []float32)
+	Uniform3iv(location UniformLocation, v []int32)
+	Uniform4fv(location UniformLocation, v []float32)
+	Uniform4iv(location UniformLocation, v []int32)
+	UniformMatrix2fv(location UniformLocation, transpose bool, value []float32)
+	UniformMatrix3fv(location UniformLocation, transpose bool, value []float32)
+	UniformMatrix4fv(location UniformLocation, transpose bool, value []float32)
+	UseProgram(program Program)
+	ValidateProgram(program Program)
+	VertexAttrib1f(index int, x float32)
+	VertexAttrib1fv(index int, v []float32)
+	VertexAttrib2f(index int, x, y float32)
+	VertexAttrib2fv(index int, v []float32)
+	VertexAttrib3f(index int, x, y, z float32)
+	VertexAttrib3fv(index int, v []float32)
+	VertexAttrib4f(index int, x, y, z, w float32)
+	VertexAttrib4fv(index int, v []float32)
+	VertexAttribPointer(index, size int, typ GLenum, normal bool, stride int, offset int)
+	Viewport(x, y, width, height int)
+}
+
+var _ Backend = (*Context)(nil)
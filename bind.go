@@ -0,0 +1,251 @@
+// Copyright 2014 Joseph Hager. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package webgl
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// UniformBinding uploads the fields of a Go struct to the uniforms of a
+// program. Fields are bound to uniforms by their gl tag:
+//
+//	type Material struct {
+//		ModelView [16]float32    `gl:"u_modelView"`
+//		Color     [4]float32     `gl:"u_color"`
+//		Lights    [4][3]float32  `gl:"u_lights"`
+//		Texture   int            `gl:"u_texture"`
+//	}
+//
+// Fields are matched to the GLSL type of their uniform by their scalars:
+// float uniforms take float32 or float64, int uniforms and samplers any
+// integer type, and bool uniforms bool, in scalars or arrays of any
+// nesting whose scalar count is that of the uniform. A mat4 takes a
+// [16]float32 as well as a [4][4]float32, in column-major order. An array
+// uniform takes any whole number of elements, since drivers shorten
+// arrays whose last elements are unused.
+//
+// Fields without a gl tag, or with the tag "-", are not bound. Fields
+// whose uniform is not active in the program are skipped, as compilers
+// drop uniforms that do not affect the output.
+type UniformBinding struct {
+	b       Backend
+	program Program
+	typ     reflect.Type
+	fields  []boundField
+}
+
+// boundField is a struct field bound to a uniform.
+type boundField struct {
+	index    []int
+	name     string
+	typ      GLSLType
+	array    bool
+	location UniformLocation
+
+	// last holds the values uploaded last, and next those being
+	// uploaded. uploaded is false until the first upload.
+	last, next []float32
+	uploaded   bool
+
+	// ints holds next converted for the int uniforms and samplers.
+	ints []int32
+}
+
+// BindUniforms binds the struct type of v, a struct or a pointer to one,
+// to the active uniforms of program, which must be linked. The locations
+// of the uniforms are looked up once. It returns an error if a tagged
+// field cannot hold the type of its uniform.
+func BindUniforms(b Backend, program Program, v interface{}) (*UniformBinding, error) {
+	t := reflect.TypeOf(v)
+	if t != nil && t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	if t == nil || t.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("webgl: BindUniforms of %T, not a struct", v)
+	}
+
+	active := make(map[string]ActiveInfo)
+	n := b.GetProgramParameteri(program, ACTIVE_UNIFORMS)
+	for i := 0; i < n; i++ {
+		info := b.GetActiveUniformInfo(program, i)
+		active[strings.TrimSuffix(info.Name, "[0]")] = info
+	}
+
+	u := &UniformBinding{b: b, program: program, typ: t}
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		name := f.Tag.Get("gl")
+		if name == "" || name == "-" {
+			continue
+		}
+		name = strings.TrimSuffix(name, "[0]")
+		info, ok := active[name]
+		if !ok {
+			continue
+		}
+		typ := GLSLType(info.Type)
+		array := strings.HasSuffix(info.Name, "[0]")
+		kind, count := scalars(f.Type)
+		want := typ.Components()
+		switch {
+		case kind != scalarKind(typ),
+			!array && count != want,
+			array && (count == 0 || count%want != 0):
+			return nil, fmt.Errorf("webgl: field %s of type %v cannot hold uniform %s of type %v", f.Name, f.Type, info.Name, typ)
+		}
+		u.fields = append(u.fields, boundField{
+			index:    f.Index,
+			name:     info.Name,
+			typ:      typ,
+			array:    array,
+			location: b.GetUniformLocation(program, info.Name),
+			last:     make([]float32, 0, count),
+			next:     make([]float32, 0, count),
+		})
+	}
+	return u, nil
+}
+
+// Upload sets the uniforms bound to the fields of v, which must be of the
+// type the binding was made with or a pointer to it. Only fields that
+// changed since the last upload are set, so the program must be in use
+// and its uniforms must not have been set by other means in between; call
+// Invalidate if they have.
+func (u *UniformBinding) Upload(v interface{}) error {
+	rv := reflect.ValueOf(v)
+	if rv.Kind() == reflect.Ptr {
+		rv = rv.Elem()
+	}
+	if !rv.IsValid() || rv.Type() != u.typ {
+		return fmt.Errorf("webgl: Upload of %T to a binding of %v", v, u.typ)
+	}
+	for i := range u.fields {
+		f := &u.fields[i]
+		f.next = appendScalars(f.next[:0], rv.FieldByIndex(f.index))
+		if f.uploaded && equalFloats(f.next, f.last) {
+			continue
+		}
+		f.upload(u.b)
+		f.last, f.next = f.next, f.last
+		f.uploaded = true
+	}
+	return nil
+}
+
+// Invalidate makes the next Upload set every bound uniform.
+func (u *UniformBinding) Invalidate() {
+	for i := range u.fields {
+		u.fields[i].uploaded = false
+	}
+}
+
+// Program returns the program the binding was made for.
+func (u *UniformBinding) Program() Program {
+	return u.program
+}
+
+// upload sets the uniform of f to f.next.
+func (f *boundField) upload(b Backend) {
+	switch f.typ {
+	case GLSLFloat:
+		b.Uniform1fv(f.location, f.next)
+	case GLSLVec2:
+		b.Uniform2fv(f.location, f.next)
+	case GLSLVec3:
+		b.Uniform3fv(f.location, f.next)
+	case GLSLVec4:
+		b.Uniform4fv(f.location, f.next)
+	case GLSLMat2:
+		b.UniformMatrix2fv(f.location, false, f.next)
+	case GLSLMat3:
+		b.UniformMatrix3fv(f.location, false, f.next)
+	case GLSLMat4:
+		b.UniformMatrix4fv(f.location, false, f.next)
+	default:
+		f.ints = f.ints[:0]
+		for _, x := range f.next {
+			f.ints = append(f.ints, int32(x))
+		}
+		switch f.typ.Components() {
+		case 1:
+			b.Uniform1iv(f.location, f.ints)
+		case 2:
+			b.Uniform2iv(f.location, f.ints)
+		case 3:
+			b.Uniform3iv(f.location, f.ints)
+		case 4:
+			b.Uniform4iv(f.location, f.ints)
+		}
+	}
+}
+
+// scalarKind returns the Go kind of the scalars that can hold t: Float32
+// for float types, Int for int types and samplers, and Bool for bool
+// types.
+func scalarKind(t GLSLType) reflect.Kind {
+	switch t {
+	case GLSLInt, GLSLIVec2, GLSLIVec3, GLSLIVec4, GLSLSampler2D, GLSLSamplerCube:
+		return reflect.Int
+	case GLSLBool, GLSLBVec2, GLSLBVec3, GLSLBVec4:
+		return reflect.Bool
+	}
+	return reflect.Float32
+}
+
+// scalars returns the kind of the scalars in t, as scalarKind does, and
+// their number. The kind is Invalid if t is not a scalar or a fixed-size
+// array of them.
+func scalars(t reflect.Type) (reflect.Kind, int) {
+	switch t.Kind() {
+	case reflect.Float32, reflect.Float64:
+		return reflect.Float32, 1
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return reflect.Int, 1
+	case reflect.Bool:
+		return reflect.Bool, 1
+	case reflect.Array:
+		kind, n := scalars(t.Elem())
+		return kind, n * t.Len()
+	}
+	return reflect.Invalid, 0
+}
+
+// appendScalars appends the scalars of v, which scalars accepts the type
+// of, to s. Integers and bools are stored as float32, which holds them
+// exactly for the values uniforms take.
+func appendScalars(s []float32, v reflect.Value) []float32 {
+	switch v.Kind() {
+	case reflect.Float32, reflect.Float64:
+		return append(s, float32(v.Float()))
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return append(s, float32(v.Int()))
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return append(s, float32(v.Uint()))
+	case reflect.Bool:
+		if v.Bool() {
+			return append(s, 1)
+		}
+		return append(s, 0)
+	}
+	for i := 0; i < v.Len(); i++ {
+		s = appendScalars(s, v.Index(i))
+	}
+	return s
+}
+
+func equalFloats(a, b []float32) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,192 @@
+// Copyright 2014 Joseph Hager. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package webgl
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// uniformBackend is a standalone Recorder whose programs have the active
+// uniforms set by the test.
+type uniformBackend struct {
+	*Recorder
+	uniforms []ActiveInfo
+}
+
+func (b *uniformBackend) GetProgramParameteri(program Program, pname GLenum) int {
+	if pname == ACTIVE_UNIFORMS {
+		return len(b.uniforms)
+	}
+	return b.Recorder.GetProgramParameteri(program, pname)
+}
+
+func (b *uniformBackend) GetActiveUniformInfo(program Program, index int) ActiveInfo {
+	return b.uniforms[index]
+}
+
+func TestBindUniforms(t *testing.T) {
+	tests := []struct {
+		uniform ActiveInfo
+		value   interface{} // of the field bound to it
+		ok      bool
+	}{
+		{ActiveInfo{"u_v", 1, FLOAT}, float32(0), true},
+		{ActiveInfo{"u_v", 1, FLOAT}, float64(0), true},
+		{ActiveInfo{"u_v", 1, FLOAT}, 0, false},
+		{ActiveInfo{"u_v", 1, FLOAT}, "", false},
+		{ActiveInfo{"u_v", 1, FLOAT_VEC3}, [3]float32{}, true},
+		{ActiveInfo{"u_v", 1, FLOAT_VEC3}, [4]float32{}, false},
+		{ActiveInfo{"u_v", 1, FLOAT_MAT4}, [16]float32{}, true},
+		{ActiveInfo{"u_v", 1, FLOAT_MAT4}, [4][4]float32{}, true},
+		{ActiveInfo{"u_v", 1, FLOAT_MAT4}, [9]float32{}, false},
+		{ActiveInfo{"u_v", 1, INT}, 0, true},
+		{ActiveInfo{"u_v", 1, INT}, float32(0), false},
+		{ActiveInfo{"u_v", 1, INT_VEC2}, [2]uint8{}, true},
+		{ActiveInfo{"u_v", 1, SAMPLER_2D}, int32(0), true},
+		{ActiveInfo{"u_v", 1, SAMPLER_CUBE}, false, false},
+		{ActiveInfo{"u_v", 1, BOOL}, false, true},
+		{ActiveInfo{"u_v", 1, BOOL}, 0, false},
+		{ActiveInfo{"u_v", 1, BOOL_VEC2}, [2]bool{}, true},
+		{ActiveInfo{"u_v[0]", 4, FLOAT_VEC2}, [8]float32{}, true},
+		{ActiveInfo{"u_v[0]", 4, FLOAT_VEC2}, [4][2]float32{}, true},
+		// Drivers can shorten arrays, so any whole number of elements
+		// is accepted.
+		{ActiveInfo{"u_v[0]", 4, FLOAT_VEC2}, [2]float32{}, true},
+		{ActiveInfo{"u_v[0]", 4, FLOAT_VEC2}, [10]float32{}, true},
+		{ActiveInfo{"u_v[0]", 4, FLOAT_VEC2}, [3]float32{}, false},
+		{ActiveInfo{"u_v[0]", 4, FLOAT_VEC2}, [0]float32{}, false},
+		// Fields of uniforms that are not active are skipped.
+		{ActiveInfo{"u_other", 1, FLOAT}, "", true},
+	}
+	for _, test := range tests {
+		typ := reflect.StructOf([]reflect.StructField{{
+			Name: "V",
+			Type: reflect.TypeOf(test.value),
+			Tag:  `gl:"u_v"`,
+		}})
+		b := &uniformBackend{NewRecorder(nil), []ActiveInfo{test.uniform}}
+		_, err := BindUniforms(b, b.CreateProgram(), reflect.New(typ).Interface())
+		if ok := err == nil; ok != test.ok {
+			t.Errorf("%T bound to %s %v: error %v, want ok %v", test.value, test.uniform.Name, GLSLType(test.uniform.Type), err, test.ok)
+		}
+	}
+
+	b := &uniformBackend{Recorder: NewRecorder(nil)}
+	if _, err := BindUniforms(b, b.CreateProgram(), 0); err == nil {
+		t.Error("BindUniforms of an int succeeded")
+	}
+}
+
+type material struct {
+	Color   [4]float32  `gl:"u_color"`
+	Texture int         `gl:"u_texture"`
+	Offsets [2][2]int16 `gl:"u_offsets[0]"`
+	Lit     bool        `gl:"u_lit"`
+	Unused  float32     `gl:"u_unused"`
+	Name    string
+}
+
+func TestUniformBindingUpload(t *testing.T) {
+	b := &uniformBackend{
+		Recorder: NewRecorder(nil),
+		uniforms: []ActiveInfo{
+			{"u_lit", 1, BOOL},
+			{"u_color", 1, FLOAT_VEC4},
+			{"u_offsets[0]", 2, INT_VEC2},
+			{"u_texture", 1, SAMPLER_2D},
+		},
+	}
+	u, err := BindUniforms(b, b.CreateProgram(), material{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	m := material{
+		Color:   [4]float32{1, 0, 0, 1},
+		Texture: 2,
+		Offsets: [2][2]int16{{1, 2}, {3, 4}},
+		Lit:     true,
+		Name:    "red",
+	}
+	all := []string{
+		"Uniform4fv(program1.u_color, [1 0 0 1])",
+		"Uniform1iv(program1.u_texture, [2])",
+		"Uniform2iv(program1.u_offsets[0], [1 2 3 4])",
+		"Uniform1iv(program1.u_lit, [1])",
+	}
+	tests := []struct {
+		name   string
+		upload func() error
+		trace  []string
+	}{
+		{
+			name:   "first",
+			upload: func() error { return u.Upload(m) },
+			trace:  all,
+		},
+		{
+			name:   "unchanged",
+			upload: func() error { return u.Upload(&m) },
+		},
+		{
+			name: "unbound field",
+			upload: func() error {
+				m.Unused, m.Name = 1, "blue"
+				return u.Upload(m)
+			},
+		},
+		{
+			name: "changed",
+			upload: func() error {
+				m.Texture = 3
+				m.Offsets[1][0] = 5
+				return u.Upload(m)
+			},
+			trace: []string{
+				"Uniform1iv(program1.u_texture, [3])",
+				"Uniform2iv(program1.u_offsets[0], [1 2 5 4])",
+			},
+		},
+		{
+			name: "changed back",
+			upload: func() error {
+				m.Texture = 2
+				return u.Upload(m)
+			},
+			trace: []string{
+				"Uniform1iv(program1.u_texture, [2])",
+			},
+		},
+		{
+			name: "invalidated",
+			upload: func() error {
+				m.Offsets[1][0] = 3
+				u.Invalidate()
+				return u.Upload(m)
+			},
+			trace: all,
+		},
+	}
+	for _, test := range tests {
+		n := len(b.Calls())
+		if err := test.upload(); err != nil {
+			t.Errorf("%s: %v", test.name, err)
+			continue
+		}
+		var trace []string
+		for _, c := range b.Calls()[n:] {
+			trace = append(trace, c.String())
+		}
+		if !reflect.DeepEqual(trace, test.trace) {
+			t.Errorf("%s: trace =\n%s\nwant\n%s", test.name, strings.Join(trace, "\n"), strings.Join(test.trace, "\n"))
+		}
+	}
+
+	if err := u.Upload(struct{ Color [4]float32 }{}); err == nil {
+		t.Error("Upload of another type succeeded")
+	}
+}
@@ -0,0 +1,731 @@
+// Copyright 2014 Joseph Hager. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package webgl
+
+// StateCache is a Backend that shadows the state of another Backend and
+// skips the calls that would not change it. Every call to a Context
+// crosses into JavaScript, so eliding redundant binds and state changes
+// saves time in code that sets up state for each draw.
+//
+// StateCache tracks the bound buffers, framebuffer, renderbuffer, program
+// and textures, the active texture unit, the enabled capabilities, the
+// blend, depth, stencil and culling state, the color mask, the viewport
+// and the scissor box. All state starts out unknown, so the first call
+// that sets it is always made.
+//
+// If the wrapped Backend is changed by other means, such as code that
+// does not go through the StateCache or a restore of a lost context, call
+// Invalidate. Calls that fail are not detected, and leave the cache
+// believing the state they would have set.
+type StateCache struct {
+	backend Backend
+	state   map[stateKey]interface{}
+	saved   int
+}
+
+// stateKey names a piece of state, such as the buffer bound to a target
+// or whether a capability is enabled. Which fields are used depends on
+// the method.
+type stateKey struct {
+	method string
+	a, b   GLenum
+}
+
+// NewStateCache returns a StateCache that forwards calls to backend.
+func NewStateCache(backend Backend) *StateCache {
+	return &StateCache{backend: backend, state: make(map[stateKey]interface{})}
+}
+
+// Saved returns the number of calls skipped since the StateCache was
+// created.
+func (s *StateCache) Saved() int {
+	return s.saved
+}
+
+// Invalidate forgets all cached state, so that the next call setting any
+// of it is made.
+func (s *StateCache) Invalidate() {
+	s.state = make(map[stateKey]interface{})
+}
+
+// change records v as the value of the state k, and reports whether it
+// differs from the value known before. It counts a saved call if not.
+func (s *StateCache) change(k stateKey, v interface{}) bool {
+	if old, ok := s.state[k]; ok && old == v {
+		s.saved++
+		return false
+	}
+	s.state[k] = v
+	return true
+}
+
+// changeFaces is change for state set per face, where face is FRONT, BACK
+// or FRONT_AND_BACK.
+func (s *StateCache) changeFaces(method string, face GLenum, v interface{}) bool {
+	if face != FRONT_AND_BACK {
+		return s.change(stateKey{method, face, 0}, v)
+	}
+	front, back := stateKey{method, FRONT, 0}, stateKey{method, BACK, 0}
+	if old, ok := s.state[front]; ok && old == v {
+		if old, ok := s.state[back]; ok && old == v {
+			s.saved++
+			return false
+		}
+	}
+	s.state[front], s.state[back] = v, v
+	return true
+}
+
+// unbind records that the object with key k is no longer bound where
+// method bound it, as happens when an object is deleted.
+func (s *StateCache) unbind(method string, k interface{}) {
+	for sk, v := range s.state {
+		if sk.method == method && v == k {
+			s.state[sk] = key(nil)
+		}
+	}
+}
+
+func (s *StateCache) ActiveTexture(texture GLenum) {
+	if s.change(stateKey{"ActiveTexture", 0, 0}, texture) {
+		s.backend.ActiveTexture(texture)
+	}
+}
+
+func (s *StateCache) BindBuffer(target GLenum, buffer Buffer) {
+	if s.change(stateKey{"BindBuffer", target, 0}, key(buffer.object)) {
+		s.backend.BindBuffer(target, buffer)
+	}
+}
+
+func (s *StateCache) BindFramebuffer(target GLenum, framebuffer Framebuffer) {
+	if s.change(stateKey{"BindFramebuffer", target, 0}, key(framebuffer.object)) {
+		s.backend.BindFramebuffer(target, framebuffer)
+	}
+}
+
+func (s *StateCache) BindRenderbuffer(target GLenum, renderbuffer Renderbuffer) {
+	if s.change(stateKey{"BindRenderbuffer", target, 0}, key(renderbuffer.object)) {
+		s.backend.BindRenderbuffer(target, renderbuffer)
+	}
+}
+
+func (s *StateCache) BindTexture(target GLenum, texture Texture) {
+	unit, ok := s.state[stateKey{"ActiveTexture", 0, 0}]
+	if !ok {
+		s.backend.BindTexture(target, texture)
+		return
+	}
+	if s.change(stateKey{"BindTexture", unit.(GLenum), target}, key(texture.object)) {
+		s.backend.BindTexture(target, texture)
+	}
+}
+
+func (s *StateCache) BlendColor(red, green, blue, alpha float64) {
+	if s.change(stateKey{"BlendColor", 0, 0}, [4]float64{red, green, blue, alpha}) {
+		s.backend.BlendColor(red, green, blue, alpha)
+	}
+}
+
+func (s *StateCache) BlendEquation(mode GLenum) {
+	if s.change(stateKey{"BlendEquation", 0, 0}, [2]GLenum{mode, mode}) {
+		s.backend.BlendEquation(mode)
+	}
+}
+
+func (s *StateCache) BlendEquationSeparate(modeRGB, modeAlpha GLenum) {
+	if s.change(stateKey{"BlendEquation", 0, 0}, [2]GLenum{modeRGB, modeAlpha}) {
+		s.backend.BlendEquationSeparate(modeRGB, modeAlpha)
+	}
+}
+
+func (s *StateCache) BlendFunc(sfactor, dfactor GLenum) {
+	if s.change(stateKey{"BlendFunc", 0, 0}, [4]GLenum{sfactor, dfactor, sfactor, dfactor}) {
+		s.backend.BlendFunc(sfactor, dfactor)
+	}
+}
+
+func (s *StateCache) BlendFuncSeparate(srcRGB, dstRGB, srcAlpha, dstAlpha GLenum) {
+	if s.change(stateKey{"BlendFunc", 0, 0}, [4]GLenum{srcRGB, dstRGB, srcAlpha, dstAlpha}) {
+		s.backend.BlendFuncSeparate(srcRGB, dstRGB, srcAlpha, dstAlpha)
+	}
+}
+
+func (s *StateCache) ColorMask(red, green, blue, alpha bool) {
+	if s.change(stateKey{"ColorMask", 0, 0}, [4]bool{red, green, blue, alpha}) {
+		s.backend.ColorMask(red, green, blue, alpha)
+	}
+}
+
+func (s *StateCache) CullFace(mode GLenum) {
+	if s.change(stateKey{"CullFace", 0, 0}, mode) {
+		s.backend.CullFace(mode)
+	}
+}
+
+func (s *StateCache) DeleteBuffer(buffer Buffer) {
+	s.unbind("BindBuffer", key(buffer.object))
+	s.backend.DeleteBuffer(buffer)
+}
+
+func (s *StateCache) DeleteFramebuffer(framebuffer Framebuffer) {
+	s.unbind("BindFramebuffer", key(framebuffer.object))
+	s.backend.DeleteFramebuffer(framebuffer)
+}
+
+func (s *StateCache) DeleteRenderbuffer(renderbuffer Renderbuffer) {
+	s.unbind("BindRenderbuffer", key(renderbuffer.object))
+	s.backend.DeleteRenderbuffer(renderbuffer)
+}
+
+func (s *StateCache) DeleteTexture(texture Texture) {
+	s.unbind("BindTexture", key(texture.object))
+	s.backend.DeleteTexture(texture)
+}
+
+func (s *StateCache) DepthFunc(fun GLenum) {
+	if s.change(stateKey{"DepthFunc", 0, 0}, fun) {
+		s.backend.DepthFunc(fun)
+	}
+}
+
+func (s *StateCache) DepthMask(flag bool) {
+	if s.change(stateKey{"DepthMask", 0, 0}, flag) {
+		s.backend.DepthMask(flag)
+	}
+}
+
+func (s *StateCache) DepthRange(zNear, zFar float64) {
+	if s.change(stateKey{"DepthRange", 0, 0}, [2]float64{zNear, zFar}) {
+		s.backend.DepthRange(zNear, zFar)
+	}
+}
+
+func (s *StateCache) Disable(cap GLenum) {
+	if s.change(stateKey{"Enable", cap, 0}, false) {
+		s.backend.Disable(cap)
+	}
+}
+
+func (s *StateCache) Enable(cap GLenum) {
+	if s.change(stateKey{"Enable", cap, 0}, true) {
+		s.backend.Enable(cap)
+	}
+}
+
+func (s *StateCache) FrontFace(mode GLenum) {
+	if s.change(stateKey{"FrontFace", 0, 0}, mode) {
+		s.backend.FrontFace(mode)
+	}
+}
+
+func (s *StateCache) Scissor(x, y, width, height int) {
+	if s.change(stateKey{"Scissor", 0, 0}, [4]int{x, y, width, height}) {
+		s.backend.Scissor(x, y, width, height)
+	}
+}
+
+// stencilFunc is the state set by StencilFunc for one face.
+type stencilFunc struct {
+	fun  GLenum
+	ref  int
+	mask uint32
+}
+
+func (s *StateCache) StencilFunc(fun GLenum, ref int, mask uint32) {
+	if s.changeFaces("StencilFunc", FRONT_AND_BACK, stencilFunc{fun, ref, mask}) {
+		s.backend.StencilFunc(fun, ref, mask)
+	}
+}
+
+func (s *StateCache) StencilFuncSeparate(face, fun GLenum, ref int, mask uint32) {
+	if s.changeFaces("StencilFunc", face, stencilFunc{fun, ref, mask}) {
+		s.backend.StencilFuncSeparate(face, fun, ref, mask)
+	}
+}
+
+func (s *StateCache) StencilMask(mask uint32) {
+	if s.changeFaces("StencilMask", FRONT_AND_BACK, mask) {
+		s.backend.StencilMask(mask)
+	}
+}
+
+func (s *StateCache) StencilMaskSeparate(face GLenum, mask uint32) {
+	if s.changeFaces("StencilMask", face, mask) {
+		s.backend.StencilMaskSeparate(face, mask)
+	}
+}
+
+func (s *StateCache) StencilOp(fail, zfail, zpass GLenum) {
+	if s.changeFaces("StencilOp", FRONT_AND_BACK, [3]GLenum{fail, zfail, zpass}) {
+		s.backend.StencilOp(fail, zfail, zpass)
+	}
+}
+
+func (s *StateCache) StencilOpSeparate(face, fail, zfail, zpass GLenum) {
+	if s.changeFaces("StencilOp", face, [3]GLenum{fail, zfail, zpass}) {
+		s.backend.StencilOpSeparate(face, fail, zfail, zpass)
+	}
+}
+
+func (s *StateCache) UseProgram(program Program) {
+	if s.change(stateKey{"UseProgram", 0, 0}, key(program.object)) {
+		s.backend.UseProgram(program)
+	}
+}
+
+func (s *StateCache) Viewport(x, y, width, height int) {
+	if s.change(stateKey{"Viewport", 0, 0}, [4]int{x, y, width, height}) {
+		s.backend.Viewport(x, y, width, height)
+	}
+}
+
+// The methods below are forwarded as they are.
+
+func (s *StateCache) GetContextAttributes() ContextAttributes {
+	return s.backend.GetContextAttributes()
+}
+
+func (s *StateCache) AttachShader(program Program, shader Shader) {
+	s.backend.AttachShader(program, shader)
+}
+
+func (s *StateCache) BindAttribLocation(program Program, index int, name string) {
+	s.backend.BindAttribLocation(program, index, name)
+}
+
+func (s *StateCache) BufferData(target GLenum, data interface{}, usage GLenum) {
+	s.backend.BufferData(target, data, usage)
+}
+
+func (s *StateCache) BufferSubData(target GLenum, offset int, data interface{}) {
+	s.backend.BufferSubData(target, offset, data)
+}
+
+func (s *StateCache) BufferDataSize(target GLenum, size int, usage GLenum) {
+	s.backend.BufferDataSize(target, size, usage)
+}
+
+func (s *StateCache) BufferDataBytes(target GLenum, data []byte, usage GLenum) {
+	s.backend.BufferDataBytes(target, data, usage)
+}
+
+func (s *StateCache) BufferDataUint16(target GLenum, data []uint16, usage GLenum) {
+	s.backend.BufferDataUint16(target, data, usage)
+}
+
+func (s *StateCache) BufferDataFloat32(target GLenum, data []float32, usage GLenum) {
+	s.backend.BufferDataFloat32(target, data, usage)
+}
+
+func (s *StateCache) BufferSubDataBytes(target GLenum, offset int, data []byte) {
+	s.backend.BufferSubDataBytes(target, offset, data)
+}
+
+func (s *StateCache) BufferSubDataUint16(target GLenum, offset int, data []uint16) {
+	s.backend.BufferSubDataUint16(target, offset, data)
+}
+
+func (s *StateCache) BufferSubDataFloat32(target GLenum, offset int, data []float32) {
+	s.backend.BufferSubDataFloat32(target, offset, data)
+}
+
+func (s *StateCache) CheckFramebufferStatus(target GLenum) GLenum {
+	return s.backend.CheckFramebufferStatus(target)
+}
+
+func (s *StateCache) Clear(flags GLenum) {
+	s.backend.Clear(flags)
+}
+
+func (s *StateCache) ClearColor(red, green, blue, alpha float32) {
+	s.backend.ClearColor(red, green, blue, alpha)
+}
+
+func (s *StateCache) ClearDepth(depth float64) {
+	s.backend.ClearDepth(depth)
+}
+
+func (s *StateCache) ClearStencil(stencil int) {
+	s.backend.ClearStencil(stencil)
+}
+
+func (s *StateCache) CompileShader(shader Shader) {
+	s.backend.CompileShader(shader)
+}
+
+func (s *StateCache) CopyTexImage2D(target GLenum, level int, internal GLenum, x, y, w, h, border int) {
+	s.backend.CopyTexImage2D(target, level, internal, x, y, w, h, border)
+}
+
+func (s *StateCache) CopyTexSubImage2D(target GLenum, level, xoffset, yoffset, x, y, w, h int) {
+	s.backend.CopyTexSubImage2D(target, level, xoffset, yoffset, x, y, w, h)
+}
+
+func (s *StateCache) CreateBuffer() Buffer {
+	return s.backend.CreateBuffer()
+}
+
+func (s *StateCache) CreateFramebuffer() Framebuffer {
+	return s.backend.CreateFramebuffer()
+}
+
+func (s *StateCache) CreateProgram() Program {
+	return s.backend.CreateProgram()
+}
+
+func (s *StateCache) CreateRenderbuffer() Renderbuffer {
+	return s.backend.CreateRenderbuffer()
+}
+
+func (s *StateCache) CreateShader(typ GLenum) Shader {
+	return s.backend.CreateShader(typ)
+}
+
+func (s *StateCache) CreateTexture() Texture {
+	return s.backend.CreateTexture()
+}
+
+func (s *StateCache) DeleteProgram(program Program) {
+	s.backend.DeleteProgram(program)
+}
+
+func (s *StateCache) DeleteShader(shader Shader) {
+	s.backend.DeleteShader(shader)
+}
+
+func (s *StateCache) DetachShader(program Program, shader Shader) {
+	s.backend.DetachShader(program, shader)
+}
+
+func (s *StateCache) DisableVertexAttribArray(index int) {
+	s.backend.DisableVertexAttribArray(index)
+}
+
+func (s *StateCache) DrawingBufferWidth() int {
+	return s.backend.DrawingBufferWidth()
+}
+
+func (s *StateCache) DrawingBufferHeight() int {
+	return s.backend.DrawingBufferHeight()
+}
+
+func (s *StateCache) DrawArrays(mode GLenum, first, count int) {
+	s.backend.DrawArrays(mode, first, count)
+}
+
+func (s *StateCache) DrawElements(mode GLenum, count int, typ GLenum, offset int) {
+	s.backend.DrawElements(mode, count, typ, offset)
+}
+
+func (s *StateCache) EnableVertexAttribArray(index int) {
+	s.backend.EnableVertexAttribArray(index)
+}
+
+func (s *StateCache) Finish() {
+	s.backend.Finish()
+}
+
+func (s *StateCache) Flush() {
+	s.backend.Flush()
+}
+
+func (s *StateCache) FramebufferRenderbuffer(target, attachment, renderbufferTarget GLenum, renderbuffer Renderbuffer) {
+	s.backend.FramebufferRenderbuffer(target, attachment, renderbufferTarget, renderbuffer)
+}
+
+func (s *StateCache) FramebufferTexture2D(target, attachment, textarget GLenum, texture Texture, level int) {
+	s.backend.FramebufferTexture2D(target, attachment, textarget, texture, level)
+}
+
+func (s *StateCache) GenerateMipmap(target GLenum) {
+	s.backend.GenerateMipmap(target)
+}
+
+func (s *StateCache) GetActiveAttribInfo(program Program, index int) ActiveInfo {
+	return s.backend.GetActiveAttribInfo(program, index)
+}
+
+func (s *StateCache) GetActiveUniformInfo(program Program, index int) ActiveInfo {
+	return s.backend.GetActiveUniformInfo(program, index)
+}
+
+func (s *StateCache) GetAttachedShaders(program Program) []Shader {
+	return s.backend.GetAttachedShaders(program)
+}
+
+func (s *StateCache) GetAttribLocation(program Program, name string) int {
+	return s.backend.GetAttribLocation(program, name)
+}
+
+func (s *StateCache) GetError() ErrorCode {
+	return s.backend.GetError()
+}
+
+func (s *StateCache) GetProgramParameteri(program Program, pname GLenum) int {
+	return s.backend.GetProgramParameteri(program, pname)
+}
+
+func (s *StateCache) GetProgramParameterb(program Program, pname GLenum) bool {
+	return s.backend.GetProgramParameterb(program, pname)
+}
+
+func (s *StateCache) GetProgramInfoLog(program Program) string {
+	return s.backend.GetProgramInfoLog(program)
+}
+
+func (s *StateCache) GetShaderParameterb(shader Shader, pname GLenum) bool {
+	return s.backend.GetShaderParameterb(shader, pname)
+}
+
+func (s *StateCache) GetShaderInfoLog(shader Shader) string {
+	return s.backend.GetShaderInfoLog(shader)
+}
+
+func (s *StateCache) GetShaderSource(shader Shader) string {
+	return s.backend.GetShaderSource(shader)
+}
+
+func (s *StateCache) GetStencilBits() int {
+	return s.backend.GetStencilBits()
+}
+
+func (s *StateCache) GetStencilClearValue() int {
+	return s.backend.GetStencilClearValue()
+}
+
+func (s *StateCache) GetStencilState(face GLenum) StencilState {
+	return s.backend.GetStencilState(face)
+}
+
+func (s *StateCache) GetSupportedExtensions() []string {
+	return s.backend.GetSupportedExtensions()
+}
+
+func (s *StateCache) GetUniformLocation(program Program, name string) UniformLocation {
+	return s.backend.GetUniformLocation(program, name)
+}
+
+func (s *StateCache) GetVertexAttribOffset(index int, pname GLenum) int {
+	return s.backend.GetVertexAttribOffset(index, pname)
+}
+
+func (s *StateCache) Hint(target, mode GLenum) {
+	s.backend.Hint(target, mode)
+}
+
+func (s *StateCache) IsBuffer(buffer Buffer) bool {
+	return s.backend.IsBuffer(buffer)
+}
+
+func (s *StateCache) IsContextLost() bool {
+	return s.backend.IsContextLost()
+}
+
+func (s *StateCache) IsFramebuffer(framebuffer Framebuffer) bool {
+	return s.backend.IsFramebuffer(framebuffer)
+}
+
+func (s *StateCache) IsProgram(program Program) bool {
+	return s.backend.IsProgram(program)
+}
+
+func (s *StateCache) IsRenderbuffer(renderbuffer Renderbuffer) bool {
+	return s.backend.IsRenderbuffer(renderbuffer)
+}
+
+func (s *StateCache) IsShader(shader Shader) bool {
+	return s.backend.IsShader(shader)
+}
+
+func (s *StateCache) IsTexture(texture Texture) bool {
+	return s.backend.IsTexture(texture)
+}
+
+func (s *StateCache) IsEnabled(capability GLenum) bool {
+	return s.backend.IsEnabled(capability)
+}
+
+func (s *StateCache) LineWidth(width float64) {
+	s.backend.LineWidth(width)
+}
+
+func (s *StateCache) LinkProgram(program Program) {
+	s.backend.LinkProgram(program)
+}
+
+func (s *StateCache) PixelStorei(pname GLenum, param int) {
+	s.backend.PixelStorei(pname, param)
+}
+
+func (s *StateCache) PolygonOffset(factor, units float64) {
+	s.backend.PolygonOffset(factor, units)
+}
+
+func (s *StateCache) ReadPixelsBytes(x, y, width, height int, format, typ GLenum, pixels []byte) {
+	s.backend.ReadPixelsBytes(x, y, width, height, format, typ, pixels)
+}
+
+func (s *StateCache) ReadPixelsFloat32(x, y, width, height int, format, typ GLenum, pixels []float32) {
+	s.backend.ReadPixelsFloat32(x, y, width, height, format, typ, pixels)
+}
+
+func (s *StateCache) RenderbufferStorage(target, internalFormat GLenum, width, height int) {
+	s.backend.RenderbufferStorage(target, internalFormat, width, height)
+}
+
+func (s *StateCache) SampleCoverage(value float64, invert bool) {
+	s.backend.SampleCoverage(value, invert)
+}
+
+func (s *StateCache) ShaderSource(shader Shader, source string) {
+	s.backend.ShaderSource(shader, source)
+}
+
+func (s *StateCache) TexImage2DBytes(target GLenum, level int, internalFormat GLenum, width, height, border int, format, typ GLenum, pixels []byte) {
+	s.backend.TexImage2DBytes(target, level, internalFormat, width, height, border, format, typ, pixels)
+}
+
+func (s *StateCache) TexImage2DUint16(target GLenum, level int, internalFormat GLenum, width, height, border int, format, typ GLenum, pixels []uint16) {
+	s.backend.TexImage2DUint16(target, level, internalFormat, width, height, border, format, typ, pixels)
+}
+
+func (s *StateCache) TexImage2DFloat32(target GLenum, level int, internalFormat GLenum, width, height, border int, format, typ GLenum, pixels []float32) {
+	s.backend.TexImage2DFloat32(target, level, internalFormat, width, height, border, format, typ, pixels)
+}
+
+func (s *StateCache) TexParameteri(target, pname GLenum, param int) {
+	s.backend.TexParameteri(target, pname, param)
+}
+
+func (s *StateCache) TexSubImage2DBytes(target GLenum, level, xoffset, yoffset, width, height int, format, typ GLenum, pixels []byte) {
+	s.backend.TexSubImage2DBytes(target, level, xoffset, yoffset, width, height, format, typ, pixels)
+}
+
+func (s *StateCache) TexSubImage2DUint16(target GLenum, level, xoffset, yoffset, width, height int, format, typ GLenum, pixels []uint16) {
+	s.backend.TexSubImage2DUint16(target, level, xoffset, yoffset, width, height, format, typ, pixels)
+}
+
+func (s *StateCache) TexSubImage2DFloat32(target GLenum, level, xoffset, yoffset, width, height int, format, typ GLenum, pixels []float32) {
+	s.backend.TexSubImage2DFloat32(target, level, xoffset, yoffset, width, height, format, typ, pixels)
+}
+
+func (s *StateCache) Uniform1f(location UniformLocation, x float32) {
+	s.backend.Uniform1f(location, x)
+}
+
+func (s *StateCache) Uniform1i(location UniformLocation, x int) {
+	s.backend.Uniform1i(location, x)
+}
+
+func (s *StateCache) Uniform2f(location UniformLocation, x, y float32) {
+	s.backend.Uniform2f(location, x, y)
+}
+
+func (s *StateCache) Uniform2i(location UniformLocation, x, y int) {
+	s.backend.Uniform2i(location, x, y)
+}
+
+func (s *StateCache) Uniform3f(location UniformLocation, x, y, z float32) {
+	s.backend.Uniform3f(location, x, y, z)
+}
+
+func (s *StateCache) Uniform3i(location UniformLocation, x, y, z int) {
+	s.backend.Uniform3i(location, x, y, z)
+}
+
+func (s *StateCache) Uniform4f(location UniformLocation, x, y, z, w float32) {
+	s.backend.Uniform4f(location, x, y, z, w)
+}
+
+func (s *StateCache) Uniform4i(location UniformLocation, x, y, z, w int) {
+	s.backend.Uniform4i(location, x, y, z, w)
+}
+
+func (s *StateCache) Uniform1fv(location UniformLocation, v []float32) {
+	s.backend.Uniform1fv(location, v)
+}
+
+func (s *StateCache) Uniform1iv(location UniformLocation, v []int32) {
+	s.backend.Uniform1iv(location, v)
+}
+
+func (s *StateCache) Uniform2fv(location UniformLocation, v []float32) {
+	s.backend.Uniform2fv(location, v)
+}
+
+func (s *StateCache) Uniform2iv(location UniformLocation, v []int32) {
+	s.backend.Uniform2iv(location, v)
+}
+
+func (s *StateCache) Uniform3fv(location UniformLocation, v []float32) {
+	s.backend.Uniform3fv(location, v)
+}
+
+func (s *StateCache) Uniform3iv(location UniformLocation, v []int32) {
+	s.backend.Uniform3iv(location, v)
+}
+
+func (s *StateCache) Uniform4fv(location UniformLocation, v []float32) {
+	s.backend.Uniform4fv(location, v)
+}
+
+func (s *StateCache) Uniform4iv(location UniformLocation, v []int32) {
+	s.backend.Uniform4iv(location, v)
+}
+
+func (s *StateCache) UniformMatrix2fv(location UniformLocation, transpose bool, value []float32) {
+	s.backend.UniformMatrix2fv(location, transpose, value)
+}
+
+func (s *StateCache) UniformMatrix3fv(location UniformLocation, transpose bool, value []float32) {
+	s.backend.UniformMatrix3fv(location, transpose, value)
+}
+
+func (s *StateCache) UniformMatrix4fv(location UniformLocation, transpose bool, value []float32) {
+	s.backend.UniformMatrix4fv(location, transpose, value)
+}
+
+func (s *StateCache) ValidateProgram(program Program) {
+	s.backend.ValidateProgram(program)
+}
+
+func (s *StateCache) VertexAttrib1f(index int, x float32) {
+	s.backend.VertexAttrib1f(index, x)
+}
+
+func (s *StateCache) VertexAttrib1fv(index int, v []float32) {
+	s.backend.VertexAttrib1fv(index, v)
+}
+
+func (s *StateCache) VertexAttrib2f(index int, x, y float32) {
+	s.backend.VertexAttrib2f(index, x, y)
+}
+
+func (s *StateCache) VertexAttrib2fv(index int, v []float32) {
+	s.backend.VertexAttrib2fv(index, v)
+}
+
+func (s *StateCache) VertexAttrib3f(index int, x, y, z float32) {
+	s.backend.VertexAttrib3f(index, x, y, z)
+}
+
+func (s *StateCache) VertexAttrib3fv(index int, v []float32) {
+	s.backend.VertexAttrib3fv(index, v)
+}
+
+func (s *StateCache) VertexAttrib4f(index int, x, y, z, w float32) {
+	s.backend.VertexAttrib4f(index, x, y, z, w)
+}
+
+func (s *StateCache) VertexAttrib4fv(index int, v []float32) {
+	s.backend.VertexAttrib4fv(index, v)
+}
+
+func (s *StateCache) VertexAttribPointer(index, size int, typ GLenum, normal bool, stride int, offset int) {
+	s.backend.VertexAttribPointer(index, size, typ, normal, stride, offset)
+}
+
+var _ Backend = (*StateCache)(nil)
@@ -0,0 +1,188 @@
+// Copyright 2014 Joseph Hager. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package webgl
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestStateCache(t *testing.T) {
+	tests := []struct {
+		name  string
+		calls func(s *StateCache)
+		trace []string // calls that reach the backend
+		saved int
+	}{
+		{
+			name: "redundant bind",
+			calls: func(s *StateCache) {
+				b := s.CreateBuffer()
+				s.BindBuffer(ARRAY_BUFFER, b)
+				s.BindBuffer(ARRAY_BUFFER, b)
+				s.BindBuffer(ELEMENT_ARRAY_BUFFER, b)
+			},
+			trace: []string{
+				"CreateBuffer() = buffer1",
+				"BindBuffer(ARRAY_BUFFER, buffer1)",
+				"BindBuffer(ELEMENT_ARRAY_BUFFER, buffer1)",
+			},
+			saved: 1,
+		},
+		{
+			name: "separate blend func",
+			calls: func(s *StateCache) {
+				s.BlendFunc(ONE, ZERO)
+				s.BlendFuncSeparate(ONE, ZERO, ONE, ZERO)
+				s.BlendFuncSeparate(ONE, ZERO, ZERO, ONE)
+			},
+			trace: []string{
+				"BlendFunc(ONE, ZERO)",
+				"BlendFuncSeparate(ONE, ZERO, ZERO, ONE)",
+			},
+			saved: 1,
+		},
+		{
+			name: "stencil func front then both",
+			calls: func(s *StateCache) {
+				s.StencilFuncSeparate(FRONT, EQUAL, 1, 0xff)
+				// The back face is still unknown.
+				s.StencilFunc(EQUAL, 1, 0xff)
+				s.StencilFunc(EQUAL, 1, 0xff)
+				s.StencilFuncSeparate(BACK, EQUAL, 1, 0xff)
+			},
+			trace: []string{
+				"StencilFuncSeparate(FRONT, EQUAL, 1, 0xFF)",
+				"StencilFunc(EQUAL, 1, 0xFF)",
+			},
+			saved: 2,
+		},
+		{
+			name: "stencil mask both then back",
+			calls: func(s *StateCache) {
+				s.StencilMask(0xff)
+				s.StencilMaskSeparate(FRONT, 0xff)
+				s.StencilMaskSeparate(BACK, 0x0f)
+				s.StencilMask(0xff)
+			},
+			trace: []string{
+				"StencilMask(0xFF)",
+				"StencilMaskSeparate(BACK, 0xF)",
+				"StencilMask(0xFF)",
+			},
+			saved: 1,
+		},
+		{
+			name: "delete while bound",
+			calls: func(s *StateCache) {
+				b := s.CreateBuffer()
+				s.BindBuffer(ARRAY_BUFFER, b)
+				s.DeleteBuffer(b)
+				// Deleting the buffer unbound it.
+				s.BindBuffer(ARRAY_BUFFER, Buffer{})
+				b = s.CreateBuffer()
+				s.BindBuffer(ARRAY_BUFFER, b)
+			},
+			trace: []string{
+				"CreateBuffer() = buffer1",
+				"BindBuffer(ARRAY_BUFFER, buffer1)",
+				"DeleteBuffer(buffer1)",
+				"CreateBuffer() = buffer2",
+				"BindBuffer(ARRAY_BUFFER, buffer2)",
+			},
+			saved: 1,
+		},
+		{
+			name: "delete texture on another unit",
+			calls: func(s *StateCache) {
+				tex := s.CreateTexture()
+				s.ActiveTexture(TEXTURE1)
+				s.BindTexture(TEXTURE_2D, tex)
+				s.ActiveTexture(TEXTURE0)
+				s.DeleteTexture(tex)
+				s.ActiveTexture(TEXTURE1)
+				s.BindTexture(TEXTURE_2D, Texture{})
+			},
+			trace: []string{
+				"CreateTexture() = texture1",
+				"ActiveTexture(TEXTURE1)",
+				"BindTexture(TEXTURE_2D, texture1)",
+				"ActiveTexture(TEXTURE0)",
+				"DeleteTexture(texture1)",
+				"ActiveTexture(TEXTURE1)",
+			},
+			saved: 1,
+		},
+		{
+			name: "bind texture before active texture",
+			calls: func(s *StateCache) {
+				tex := s.CreateTexture()
+				// The texture unit is unknown, so both binds are made.
+				s.BindTexture(TEXTURE_2D, tex)
+				s.BindTexture(TEXTURE_2D, tex)
+				s.ActiveTexture(TEXTURE0)
+				s.BindTexture(TEXTURE_2D, tex)
+				s.BindTexture(TEXTURE_2D, tex)
+				s.ActiveTexture(TEXTURE1)
+				s.BindTexture(TEXTURE_2D, tex)
+			},
+			trace: []string{
+				"CreateTexture() = texture1",
+				"BindTexture(TEXTURE_2D, texture1)",
+				"BindTexture(TEXTURE_2D, texture1)",
+				"ActiveTexture(TEXTURE0)",
+				"BindTexture(TEXTURE_2D, texture1)",
+				"ActiveTexture(TEXTURE1)",
+				"BindTexture(TEXTURE_2D, texture1)",
+			},
+			saved: 1,
+		},
+		{
+			name: "invalidate",
+			calls: func(s *StateCache) {
+				s.Enable(BLEND)
+				s.Enable(BLEND)
+				s.Viewport(0, 0, 8, 8)
+				s.Invalidate()
+				s.Enable(BLEND)
+				s.Viewport(0, 0, 8, 8)
+				s.Disable(BLEND)
+			},
+			trace: []string{
+				"Enable(BLEND)",
+				"Viewport(0, 0, 8, 8)",
+				"Enable(BLEND)",
+				"Viewport(0, 0, 8, 8)",
+				"Disable(BLEND)",
+			},
+			saved: 1,
+		},
+		{
+			name: "forwarded calls",
+			calls: func(s *StateCache) {
+				s.ClearColor(0, 0, 0, 1)
+				s.ClearColor(0, 0, 0, 1)
+				s.DrawArrays(TRIANGLES, 0, 3)
+			},
+			trace: []string{
+				"ClearColor(0, 0, 0, 1)",
+				"ClearColor(0, 0, 0, 1)",
+				"DrawArrays(TRIANGLES, 0, 3)",
+			},
+			saved: 0,
+		},
+	}
+	for _, test := range tests {
+		r := NewRecorder(nil)
+		s := NewStateCache(r)
+		test.calls(s)
+		if got, want := r.Trace(), strings.Join(test.trace, "\n")+"\n"; got != want {
+			t.Errorf("%s: trace =\n%swant\n%s", test.name, got, want)
+		}
+		if got := s.Saved(); got != test.saved {
+			t.Errorf("%s: Saved() = %d, want %d", test.name, got, test.saved)
+		}
+	}
+}
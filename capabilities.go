@@ -0,0 +1,152 @@
+// Copyright 2014 Joseph Hager. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package webgl
+
+// PrecisionFormat is the range and precision of a shader precision type,
+// as returned by GetShaderPrecisionFormat. The range is in log2 of the
+// absolute value, and the precision in bits; for int types the precision
+// is 0.
+type PrecisionFormat struct {
+	RangeMin  int `json:"rangeMin"`
+	RangeMax  int `json:"rangeMax"`
+	Precision int `json:"precision"`
+}
+
+// ShaderPrecision holds the PrecisionFormat of every precision type in one
+// shader type.
+type ShaderPrecision struct {
+	LowFloat    PrecisionFormat `json:"lowFloat"`
+	MediumFloat PrecisionFormat `json:"mediumFloat"`
+	HighFloat   PrecisionFormat `json:"highFloat"`
+	LowInt      PrecisionFormat `json:"lowInt"`
+	MediumInt   PrecisionFormat `json:"mediumInt"`
+	HighInt     PrecisionFormat `json:"highInt"`
+}
+
+// Capabilities describes what a WebGL 1.0 implementation supports: its
+// identification strings, implementation limits, shader precisions, the
+// bit depths of the drawing buffer and the supported extensions.
+type Capabilities struct {
+	Vendor                 string `json:"vendor"`
+	Renderer               string `json:"renderer"`
+	Version                string `json:"version"`
+	ShadingLanguageVersion string `json:"shadingLanguageVersion"`
+
+	// UnmaskedVendor and UnmaskedRenderer name the underlying graphics
+	// driver. They are only set if WEBGL_debug_renderer_info is
+	// supported.
+	UnmaskedVendor   string `json:"unmaskedVendor,omitempty"`
+	UnmaskedRenderer string `json:"unmaskedRenderer,omitempty"`
+
+	MaxCombinedTextureImageUnits int        `json:"maxCombinedTextureImageUnits"`
+	MaxCubeMapTextureSize        int        `json:"maxCubeMapTextureSize"`
+	MaxFragmentUniformVectors    int        `json:"maxFragmentUniformVectors"`
+	MaxRenderbufferSize          int        `json:"maxRenderbufferSize"`
+	MaxTextureImageUnits         int        `json:"maxTextureImageUnits"`
+	MaxTextureSize               int        `json:"maxTextureSize"`
+	MaxVaryingVectors            int        `json:"maxVaryingVectors"`
+	MaxVertexAttribs             int        `json:"maxVertexAttribs"`
+	MaxVertexTextureImageUnits   int        `json:"maxVertexTextureImageUnits"`
+	MaxVertexUniformVectors      int        `json:"maxVertexUniformVectors"`
+	MaxViewportDims              [2]int     `json:"maxViewportDims"`
+	AliasedLineWidthRange        [2]float32 `json:"aliasedLineWidthRange"`
+	AliasedPointSizeRange        [2]float32 `json:"aliasedPointSizeRange"`
+
+	// Bit depths of the drawing buffer, and its multisampling.
+	RedBits       int `json:"redBits"`
+	GreenBits     int `json:"greenBits"`
+	BlueBits      int `json:"blueBits"`
+	AlphaBits     int `json:"alphaBits"`
+	DepthBits     int `json:"depthBits"`
+	StencilBits   int `json:"stencilBits"`
+	SubpixelBits  int `json:"subpixelBits"`
+	SampleBuffers int `json:"sampleBuffers"`
+	Samples       int `json:"samples"`
+
+	VertexShaderPrecision   ShaderPrecision `json:"vertexShaderPrecision"`
+	FragmentShaderPrecision ShaderPrecision `json:"fragmentShaderPrecision"`
+
+	Extensions []string `json:"extensions"`
+}
+
+// HasExtension reports whether name is one of the supported extensions.
+func (caps *Capabilities) HasExtension(name string) bool {
+	for _, ext := range caps.Extensions {
+		if ext == name {
+			return true
+		}
+	}
+	return false
+}
+
+// Capabilities queries the limits and features of the context. The bit
+// depths are those of the framebuffer bound when it is called, which is
+// usually the drawing buffer. If WEBGL_debug_renderer_info is supported,
+// it is enabled to read the unmasked strings.
+func (c *Context) Capabilities() *Capabilities {
+	caps := &Capabilities{Extensions: c.GetSupportedExtensions()}
+
+	// The parameter names below are all in parameterTypes with the type of
+	// the getter, so the getters cannot fail.
+	str := func(pname GLenum) string {
+		s, _ := c.GetParameterString(pname)
+		return s
+	}
+	num := func(pname GLenum) int {
+		n, _ := c.GetParameterInt(pname)
+		return n
+	}
+	caps.Vendor = str(VENDOR)
+	caps.Renderer = str(RENDERER)
+	caps.Version = str(VERSION)
+	caps.ShadingLanguageVersion = str(SHADING_LANGUAGE_VERSION)
+	if caps.HasExtension("WEBGL_debug_renderer_info") {
+		c.GetExtension("WEBGL_debug_renderer_info")
+		caps.UnmaskedVendor = str(UNMASKED_VENDOR_WEBGL)
+		caps.UnmaskedRenderer = str(UNMASKED_RENDERER_WEBGL)
+	}
+
+	caps.MaxCombinedTextureImageUnits = num(MAX_COMBINED_TEXTURE_IMAGE_UNITS)
+	caps.MaxCubeMapTextureSize = num(MAX_CUBE_MAP_TEXTURE_SIZE)
+	caps.MaxFragmentUniformVectors = num(MAX_FRAGMENT_UNIFORM_VECTORS)
+	caps.MaxRenderbufferSize = num(MAX_RENDERBUFFER_SIZE)
+	caps.MaxTextureImageUnits = num(MAX_TEXTURE_IMAGE_UNITS)
+	caps.MaxTextureSize = num(MAX_TEXTURE_SIZE)
+	caps.MaxVaryingVectors = num(MAX_VARYING_VECTORS)
+	caps.MaxVertexAttribs = num(MAX_VERTEX_ATTRIBS)
+	caps.MaxVertexTextureImageUnits = num(MAX_VERTEX_TEXTURE_IMAGE_UNITS)
+	caps.MaxVertexUniformVectors = num(MAX_VERTEX_UNIFORM_VECTORS)
+	dims, _ := c.GetParameterInts(MAX_VIEWPORT_DIMS)
+	copy(caps.MaxViewportDims[:], dims)
+	lineWidths, _ := c.GetParameterFloats(ALIASED_LINE_WIDTH_RANGE)
+	copy(caps.AliasedLineWidthRange[:], lineWidths)
+	pointSizes, _ := c.GetParameterFloats(ALIASED_POINT_SIZE_RANGE)
+	copy(caps.AliasedPointSizeRange[:], pointSizes)
+
+	caps.RedBits = num(RED_BITS)
+	caps.GreenBits = num(GREEN_BITS)
+	caps.BlueBits = num(BLUE_BITS)
+	caps.AlphaBits = num(ALPHA_BITS)
+	caps.DepthBits = num(DEPTH_BITS)
+	caps.StencilBits = num(STENCIL_BITS)
+	caps.SubpixelBits = num(SUBPIXEL_BITS)
+	caps.SampleBuffers = num(SAMPLE_BUFFERS)
+	caps.Samples = num(SAMPLES)
+
+	caps.VertexShaderPrecision = c.shaderPrecision(VERTEX_SHADER)
+	caps.FragmentShaderPrecision = c.shaderPrecision(FRAGMENT_SHADER)
+	return caps
+}
+
+func (c *Context) shaderPrecision(shaderType GLenum) ShaderPrecision {
+	return ShaderPrecision{
+		LowFloat:    c.GetShaderPrecisionFormat(shaderType, LOW_FLOAT),
+		MediumFloat: c.GetShaderPrecisionFormat(shaderType, MEDIUM_FLOAT),
+		HighFloat:   c.GetShaderPrecisionFormat(shaderType, HIGH_FLOAT),
+		LowInt:      c.GetShaderPrecisionFormat(shaderType, LOW_INT),
+		MediumInt:   c.GetShaderPrecisionFormat(shaderType, MEDIUM_INT),
+		HighInt:     c.GetShaderPrecisionFormat(shaderType, HIGH_INT),
+	}
+}
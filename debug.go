@@ -0,0 +1,1143 @@
+// Copyright 2014 Joseph Hager. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package webgl
+
+import (
+	"fmt"
+	"log"
+	"runtime"
+)
+
+// DebugMode is what a Debug does with the errors it finds.
+type DebugMode int
+
+const (
+	// DebugLog logs each error with the standard logger.
+	DebugLog DebugMode = iota
+
+	// DebugPanic panics with the first error, as a *CallError.
+	DebugPanic
+
+	// DebugCollect keeps the errors, to be read with Errors.
+	DebugCollect
+)
+
+// CallError is a WebGL error found by a Debug, with the call that caused
+// it and the place in the Go code the call was made from.
+type CallError struct {
+	Code   ErrorCode
+	Method string
+	Args   []interface{}
+	File   string
+	Line   int
+}
+
+// Error formats the error as, for example,
+// `webgl: INVALID_OPERATION in DrawArrays(TRIANGLES, 0, 3) at main.go:42`.
+func (e *CallError) Error() string {
+	call := Call{Method: e.Method, Args: e.Args}
+	return fmt.Sprintf("webgl: %v in %v at %s:%d", e.Code, call, e.File, e.Line)
+}
+
+// Debug is a Backend that calls GetError after every call it forwards to
+// another Backend, and reports each error along with the call and its Go
+// caller, much like WebGLDebugUtils does for JavaScript. Checking every
+// call stalls the GPU pipeline, so Debug is meant to wrap a Context only
+// while looking for a bug; code that is not given a Debug pays nothing
+// for it.
+//
+// Since Debug takes the errors out of the wrapped Backend, its GetError
+// returns the first error it found since the last call to GetError.
+type Debug struct {
+	backend Backend
+	mode    DebugMode
+	errors  []*CallError
+	pending ErrorCode
+}
+
+// NewDebug returns a Debug that forwards calls to backend and handles the
+// errors they cause according to mode.
+func NewDebug(backend Backend, mode DebugMode) *Debug {
+	return &Debug{backend: backend, mode: mode}
+}
+
+// Errors returns the errors collected since the Debug was created or last
+// reset. It is always empty unless the mode is DebugCollect.
+func (d *Debug) Errors() []*CallError {
+	return d.errors
+}
+
+// Reset discards the collected errors.
+func (d *Debug) Reset() {
+	d.errors = nil
+}
+
+// report handles code, the first error caused by a call to method, and any
+// further errors the call caused. It must be called directly by the method
+// of Debug that made the call, for the caller to be found.
+func (d *Debug) report(code ErrorCode, method string, args ...interface{}) {
+	for i, arg := range args {
+		args[i] = copyArg(arg)
+	}
+	_, file, line, _ := runtime.Caller(2)
+	for ; code != NO_ERROR; code = d.backend.GetError() {
+		if d.pending == NO_ERROR {
+			d.pending = code
+		}
+		err := &CallError{Code: code, Method: method, Args: args, File: file, Line: line}
+		switch d.mode {
+		case DebugPanic:
+			panic(err)
+		case DebugCollect:
+			d.errors = append(d.errors, err)
+		default:
+			log.Print(err)
+		}
+	}
+}
+
+// debugRef names a handle in a reported call by its kind and, for
+// handles with an id, the id.
+func debugRef(kind string, o *object) ref {
+	switch {
+	case o == nil:
+		return "null"
+	case o.ID() != 0:
+		return ref(fmt.Sprintf("%s%d", kind, o.ID()))
+	}
+	return ref(kind)
+}
+
+func (d *Debug) GetContextAttributes() ContextAttributes {
+	v := d.backend.GetContextAttributes()
+	if code := d.backend.GetError(); code != NO_ERROR {
+		d.report(code, "GetContextAttributes")
+	}
+	return v
+}
+
+func (d *Debug) ActiveTexture(texture GLenum) {
+	d.backend.ActiveTexture(texture)
+	if code := d.backend.GetError(); code != NO_ERROR {
+		d.report(code, "ActiveTexture", texture)
+	}
+}
+
+func (d *Debug) AttachShader(program Program, shader Shader) {
+	d.backend.AttachShader(program, shader)
+	if code := d.backend.GetError(); code != NO_ERROR {
+		d.report(code, "AttachShader", debugRef("program", program.object), debugRef("shader", shader.object))
+	}
+}
+
+func (d *Debug) BindAttribLocation(program Program, index int, name string) {
+	d.backend.BindAttribLocation(program, index, name)
+	if code := d.backend.GetError(); code != NO_ERROR {
+		d.report(code, "BindAttribLocation", debugRef("program", program.object), index, name)
+	}
+}
+
+func (d *Debug) BindBuffer(target GLenum, buffer Buffer) {
+	d.backend.BindBuffer(target, buffer)
+	if code := d.backend.GetError(); code != NO_ERROR {
+		d.report(code, "BindBuffer", target, debugRef("buffer", buffer.object))
+	}
+}
+
+func (d *Debug) BindFramebuffer(target GLenum, framebuffer Framebuffer) {
+	d.backend.BindFramebuffer(target, framebuffer)
+	if code := d.backend.GetError(); code != NO_ERROR {
+		d.report(code, "BindFramebuffer", target, debugRef("framebuffer", framebuffer.object))
+	}
+}
+
+func (d *Debug) BindRenderbuffer(target GLenum, renderbuffer Renderbuffer) {
+	d.backend.BindRenderbuffer(target, renderbuffer)
+	if code := d.backend.GetError(); code != NO_ERROR {
+		d.report(code, "BindRenderbuffer", target, debugRef("renderbuffer", renderbuffer.object))
+	}
+}
+
+func (d *Debug) BindTexture(target GLenum, texture Texture) {
+	d.backend.BindTexture(target, texture)
+	if code := d.backend.GetError(); code != NO_ERROR {
+		d.report(code, "BindTexture", target, debugRef("texture", texture.object))
+	}
+}
+
+func (d *Debug) BlendColor(red, green, blue, alpha float64) {
+	d.backend.BlendColor(red, green, blue, alpha)
+	if code := d.backend.GetError(); code != NO_ERROR {
+		d.report(code, "BlendColor", red, green, blue, alpha)
+	}
+}
+
+func (d *Debug) BlendEquation(mode GLenum) {
+	d.backend.BlendEquation(mode)
+	if code := d.backend.GetError(); code != NO_ERROR {
+		d.report(code, "BlendEquation", mode)
+	}
+}
+
+func (d *Debug) BlendEquationSeparate(modeRGB, modeAlpha GLenum) {
+	d.backend.BlendEquationSeparate(modeRGB, modeAlpha)
+	if code := d.backend.GetError(); code != NO_ERROR {
+		d.report(code, "BlendEquationSeparate", modeRGB, modeAlpha)
+	}
+}
+
+func (d *Debug) BlendFunc(sfactor, dfactor GLenum) {
+	d.backend.BlendFunc(sfactor, dfactor)
+	if code := d.backend.GetError(); code != NO_ERROR {
+		d.report(code, "BlendFunc", blendFactor(sfactor), blendFactor(dfactor))
+	}
+}
+
+func (d *Debug) BlendFuncSeparate(srcRGB, dstRGB, srcAlpha, dstAlpha GLenum) {
+	d.backend.BlendFuncSeparate(srcRGB, dstRGB, srcAlpha, dstAlpha)
+	if code := d.backend.GetError(); code != NO_ERROR {
+		d.report(code, "BlendFuncSeparate", blendFactor(srcRGB), blendFactor(dstRGB), blendFactor(srcAlpha), blendFactor(dstAlpha))
+	}
+}
+
+func (d *Debug) BufferData(target GLenum, data interface{}, usage GLenum) {
+	d.backend.BufferData(target, data, usage)
+	if code := d.backend.GetError(); code != NO_ERROR {
+		d.report(code, "BufferData", target, data, usage)
+	}
+}
+
+func (d *Debug) BufferSubData(target GLenum, offset int, data interface{}) {
+	d.backend.BufferSubData(target, offset, data)
+	if code := d.backend.GetError(); code != NO_ERROR {
+		d.report(code, "BufferSubData", target, offset, data)
+	}
+}
+
+func (d *Debug) BufferDataSize(target GLenum, size int, usage GLenum) {
+	d.backend.BufferDataSize(target, size, usage)
+	if code := d.backend.GetError(); code != NO_ERROR {
+		d.report(code, "BufferDataSize", target, size, usage)
+	}
+}
+
+func (d *Debug) BufferDataBytes(target GLenum, data []byte, usage GLenum) {
+	d.backend.BufferDataBytes(target, data, usage)
+	if code := d.backend.GetError(); code != NO_ERROR {
+		d.report(code, "BufferDataBytes", target, data, usage)
+	}
+}
+
+func (d *Debug) BufferDataUint16(target GLenum, data []uint16, usage GLenum) {
+	d.backend.BufferDataUint16(target, data, usage)
+	if code := d.backend.GetError(); code != NO_ERROR {
+		d.report(code, "BufferDataUint16", target, data, usage)
+	}
+}
+
+func (d *Debug) BufferDataFloat32(target GLenum, data []float32, usage GLenum) {
+	d.backend.BufferDataFloat32(target, data, usage)
+	if code := d.backend.GetError(); code != NO_ERROR {
+		d.report(code, "BufferDataFloat32", target, data, usage)
+	}
+}
+
+func (d *Debug) BufferSubDataBytes(target GLenum, offset int, data []byte) {
+	d.backend.BufferSubDataBytes(target, offset, data)
+	if code := d.backend.GetError(); code != NO_ERROR {
+		d.report(code, "BufferSubDataBytes", target, offset, data)
+	}
+}
+
+func (d *Debug) BufferSubDataUint16(target GLenum, offset int, data []uint16) {
+	d.backend.BufferSubDataUint16(target, offset, data)
+	if code := d.backend.GetError(); code != NO_ERROR {
+		d.report(code, "BufferSubDataUint16", target, offset, data)
+	}
+}
+
+func (d *Debug) BufferSubDataFloat32(target GLenum, offset int, data []float32) {
+	d.backend.BufferSubDataFloat32(target, offset, data)
+	if code := d.backend.GetError(); code != NO_ERROR {
+		d.report(code, "BufferSubDataFloat32", target, offset, data)
+	}
+}
+
+func (d *Debug) CheckFramebufferStatus(target GLenum) GLenum {
+	v := d.backend.CheckFramebufferStatus(target)
+	if code := d.backend.GetError(); code != NO_ERROR {
+		d.report(code, "CheckFramebufferStatus", target)
+	}
+	return v
+}
+
+func (d *Debug) Clear(flags GLenum) {
+	d.backend.Clear(flags)
+	if code := d.backend.GetError(); code != NO_ERROR {
+		d.report(code, "Clear", clearFlags(flags))
+	}
+}
+
+func (d *Debug) ClearColor(red, green, blue, alpha float32) {
+	d.backend.ClearColor(red, green, blue, alpha)
+	if code := d.backend.GetError(); code != NO_ERROR {
+		d.report(code, "ClearColor", red, green, blue, alpha)
+	}
+}
+
+func (d *Debug) ClearDepth(depth float64) {
+	d.backend.ClearDepth(depth)
+	if code := d.backend.GetError(); code != NO_ERROR {
+		d.report(code, "ClearDepth", depth)
+	}
+}
+
+func (d *Debug) ClearStencil(s int) {
+	d.backend.ClearStencil(s)
+	if code := d.backend.GetError(); code != NO_ERROR {
+		d.report(code, "ClearStencil", s)
+	}
+}
+
+func (d *Debug) ColorMask(red, green, blue, alpha bool) {
+	d.backend.ColorMask(red, green, blue, alpha)
+	if code := d.backend.GetError(); code != NO_ERROR {
+		d.report(code, "ColorMask", red, green, blue, alpha)
+	}
+}
+
+func (d *Debug) CompileShader(shader Shader) {
+	d.backend.CompileShader(shader)
+	if code := d.backend.GetError(); code != NO_ERROR {
+		d.report(code, "CompileShader", debugRef("shader", shader.object))
+	}
+}
+
+func (d *Debug) CopyTexImage2D(target GLenum, level int, internal GLenum, x, y, w, h, border int) {
+	d.backend.CopyTexImage2D(target, level, internal, x, y, w, h, border)
+	if code := d.backend.GetError(); code != NO_ERROR {
+		d.report(code, "CopyTexImage2D", target, level, internal, x, y, w, h, border)
+	}
+}
+
+func (d *Debug) CopyTexSubImage2D(target GLenum, level, xoffset, yoffset, x, y, w, h int) {
+	d.backend.CopyTexSubImage2D(target, level, xoffset, yoffset, x, y, w, h)
+	if code := d.backend.GetError(); code != NO_ERROR {
+		d.report(code, "CopyTexSubImage2D", target, level, xoffset, yoffset, x, y, w, h)
+	}
+}
+
+func (d *Debug) CreateBuffer() Buffer {
+	v := d.backend.CreateBuffer()
+	if code := d.backend.GetError(); code != NO_ERROR {
+		d.report(code, "CreateBuffer")
+	}
+	return v
+}
+
+func (d *Debug) CreateFramebuffer() Framebuffer {
+	v := d.backend.CreateFramebuffer()
+	if code := d.backend.GetError(); code != NO_ERROR {
+		d.report(code, "CreateFramebuffer")
+	}
+	return v
+}
+
+func (d *Debug) CreateProgram() Program {
+	v := d.backend.CreateProgram()
+	if code := d.backend.GetError(); code != NO_ERROR {
+		d.report(code, "CreateProgram")
+	}
+	return v
+}
+
+func (d *Debug) CreateRenderbuffer() Renderbuffer {
+	v := d.backend.CreateRenderbuffer()
+	if code := d.backend.GetError(); code != NO_ERROR {
+		d.report(code, "CreateRenderbuffer")
+	}
+	return v
+}
+
+func (d *Debug) CreateShader(typ GLenum) Shader {
+	v := d.backend.CreateShader(typ)
+	if code := d.backend.GetError(); code != NO_ERROR {
+		d.report(code, "CreateShader", typ)
+	}
+	return v
+}
+
+func (d *Debug) CreateTexture() Texture {
+	v := d.backend.CreateTexture()
+	if code := d.backend.GetError(); code != NO_ERROR {
+		d.report(code, "CreateTexture")
+	}
+	return v
+}
+
+func (d *Debug) CullFace(mode GLenum) {
+	d.backend.CullFace(mode)
+	if code := d.backend.GetError(); code != NO_ERROR {
+		d.report(code, "CullFace", mode)
+	}
+}
+
+func (d *Debug) DeleteBuffer(buffer Buffer) {
+	d.backend.DeleteBuffer(buffer)
+	if code := d.backend.GetError(); code != NO_ERROR {
+		d.report(code, "DeleteBuffer", debugRef("buffer", buffer.object))
+	}
+}
+
+func (d *Debug) DeleteFramebuffer(framebuffer Framebuffer) {
+	d.backend.DeleteFramebuffer(framebuffer)
+	if code := d.backend.GetError(); code != NO_ERROR {
+		d.report(code, "DeleteFramebuffer", debugRef("framebuffer", framebuffer.object))
+	}
+}
+
+func (d *Debug) DeleteProgram(program Program) {
+	d.backend.DeleteProgram(program)
+	if code := d.backend.GetError(); code != NO_ERROR {
+		d.report(code, "DeleteProgram", debugRef("program", program.object))
+	}
+}
+
+func (d *Debug) DeleteRenderbuffer(renderbuffer Renderbuffer) {
+	d.backend.DeleteRenderbuffer(renderbuffer)
+	if code := d.backend.GetError(); code != NO_ERROR {
+		d.report(code, "DeleteRenderbuffer", debugRef("renderbuffer", renderbuffer.object))
+	}
+}
+
+func (d *Debug) DeleteShader(shader Shader) {
+	d.backend.DeleteShader(shader)
+	if code := d.backend.GetError(); code != NO_ERROR {
+		d.report(code, "DeleteShader", debugRef("shader", shader.object))
+	}
+}
+
+func (d *Debug) DeleteTexture(texture Texture) {
+	d.backend.DeleteTexture(texture)
+	if code := d.backend.GetError(); code != NO_ERROR {
+		d.report(code, "DeleteTexture", debugRef("texture", texture.object))
+	}
+}
+
+func (d *Debug) DepthFunc(fun GLenum) {
+	d.backend.DepthFunc(fun)
+	if code := d.backend.GetError(); code != NO_ERROR {
+		d.report(code, "DepthFunc", fun)
+	}
+}
+
+func (d *Debug) DepthMask(flag bool) {
+	d.backend.DepthMask(flag)
+	if code := d.backend.GetError(); code != NO_ERROR {
+		d.report(code, "DepthMask", flag)
+	}
+}
+
+func (d *Debug) DepthRange(zNear, zFar float64) {
+	d.backend.DepthRange(zNear, zFar)
+	if code := d.backend.GetError(); code != NO_ERROR {
+		d.report(code, "DepthRange", zNear, zFar)
+	}
+}
+
+func (d *Debug) DetachShader(program Program, shader Shader) {
+	d.backend.DetachShader(program, shader)
+	if code := d.backend.GetError(); code != NO_ERROR {
+		d.report(code, "DetachShader", debugRef("program", program.object), debugRef("shader", shader.object))
+	}
+}
+
+func (d *Debug) Disable(cap GLenum) {
+	d.backend.Disable(cap)
+	if code := d.backend.GetError(); code != NO_ERROR {
+		d.report(code, "Disable", cap)
+	}
+}
+
+func (d *Debug) DisableVertexAttribArray(index int) {
+	d.backend.DisableVertexAttribArray(index)
+	if code := d.backend.GetError(); code != NO_ERROR {
+		d.report(code, "DisableVertexAttribArray", index)
+	}
+}
+
+func (d *Debug) DrawingBufferWidth() int {
+	v := d.backend.DrawingBufferWidth()
+	if code := d.backend.GetError(); code != NO_ERROR {
+		d.report(code, "DrawingBufferWidth")
+	}
+	return v
+}
+
+func (d *Debug) DrawingBufferHeight() int {
+	v := d.backend.DrawingBufferHeight()
+	if code := d.backend.GetError(); code != NO_ERROR {
+		d.report(code, "DrawingBufferHeight")
+	}
+	return v
+}
+
+func (d *Debug) DrawArrays(mode GLenum, first, count int) {
+	d.backend.DrawArrays(mode, first, count)
+	if code := d.backend.GetError(); code != NO_ERROR {
+		d.report(code, "DrawArrays", mode, first, count)
+	}
+}
+
+func (d *Debug) DrawElements(mode GLenum, count int, typ GLenum, offset int) {
+	d.backend.DrawElements(mode, count, typ, offset)
+	if code := d.backend.GetError(); code != NO_ERROR {
+		d.report(code, "DrawElements", mode, count, typ, offset)
+	}
+}
+
+func (d *Debug) Enable(cap GLenum) {
+	d.backend.Enable(cap)
+	if code := d.backend.GetError(); code != NO_ERROR {
+		d.report(code, "Enable", cap)
+	}
+}
+
+func (d *Debug) EnableVertexAttribArray(index int) {
+	d.backend.EnableVertexAttribArray(index)
+	if code := d.backend.GetError(); code != NO_ERROR {
+		d.report(code, "EnableVertexAttribArray", index)
+	}
+}
+
+func (d *Debug) Finish() {
+	d.backend.Finish()
+	if code := d.backend.GetError(); code != NO_ERROR {
+		d.report(code, "Finish")
+	}
+}
+
+func (d *Debug) Flush() {
+	d.backend.Flush()
+	if code := d.backend.GetError(); code != NO_ERROR {
+		d.report(code, "Flush")
+	}
+}
+
+func (d *Debug) FramebufferRenderbuffer(target, attachment, renderbufferTarget GLenum, renderbuffer Renderbuffer) {
+	d.backend.FramebufferRenderbuffer(target, attachment, renderbufferTarget, renderbuffer)
+	if code := d.backend.GetError(); code != NO_ERROR {
+		d.report(code, "FramebufferRenderbuffer", target, attachment, renderbufferTarget, debugRef("renderbuffer", renderbuffer.object))
+	}
+}
+
+func (d *Debug) FramebufferTexture2D(target, attachment, textarget GLenum, texture Texture, level int) {
+	d.backend.FramebufferTexture2D(target, attachment, textarget, texture, level)
+	if code := d.backend.GetError(); code != NO_ERROR {
+		d.report(code, "FramebufferTexture2D", target, attachment, textarget, debugRef("texture", texture.object), level)
+	}
+}
+
+func (d *Debug) FrontFace(mode GLenum) {
+	d.backend.FrontFace(mode)
+	if code := d.backend.GetError(); code != NO_ERROR {
+		d.report(code, "FrontFace", mode)
+	}
+}
+
+func (d *Debug) GenerateMipmap(target GLenum) {
+	d.backend.GenerateMipmap(target)
+	if code := d.backend.GetError(); code != NO_ERROR {
+		d.report(code, "GenerateMipmap", target)
+	}
+}
+
+func (d *Debug) GetActiveAttribInfo(program Program, index int) ActiveInfo {
+	v := d.backend.GetActiveAttribInfo(program, index)
+	if code := d.backend.GetError(); code != NO_ERROR {
+		d.report(code, "GetActiveAttribInfo", debugRef("program", program.object), index)
+	}
+	return v
+}
+
+func (d *Debug) GetActiveUniformInfo(program Program, index int) ActiveInfo {
+	v := d.backend.GetActiveUniformInfo(program, index)
+	if code := d.backend.GetError(); code != NO_ERROR {
+		d.report(code, "GetActiveUniformInfo", debugRef("program", program.object), index)
+	}
+	return v
+}
+
+func (d *Debug) GetAttachedShaders(program Program) []Shader {
+	v := d.backend.GetAttachedShaders(program)
+	if code := d.backend.GetError(); code != NO_ERROR {
+		d.report(code, "GetAttachedShaders", debugRef("program", program.object))
+	}
+	return v
+}
+
+func (d *Debug) GetAttribLocation(program Program, name string) int {
+	v := d.backend.GetAttribLocation(program, name)
+	if code := d.backend.GetError(); code != NO_ERROR {
+		d.report(code, "GetAttribLocation", debugRef("program", program.object), name)
+	}
+	return v
+}
+
+func (d *Debug) GetError() ErrorCode {
+	code := d.pending
+	d.pending = NO_ERROR
+	if code == NO_ERROR {
+		code = d.backend.GetError()
+	}
+	return code
+}
+
+func (d *Debug) GetProgramParameteri(program Program, pname GLenum) int {
+	v := d.backend.GetProgramParameteri(program, pname)
+	if code := d.backend.GetError(); code != NO_ERROR {
+		d.report(code, "GetProgramParameteri", debugRef("program", program.object), pname)
+	}
+	return v
+}
+
+func (d *Debug) GetProgramParameterb(program Program, pname GLenum) bool {
+	v := d.backend.GetProgramParameterb(program, pname)
+	if code := d.backend.GetError(); code != NO_ERROR {
+		d.report(code, "GetProgramParameterb", debugRef("program", program.object), pname)
+	}
+	return v
+}
+
+func (d *Debug) GetProgramInfoLog(program Program) string {
+	v := d.backend.GetProgramInfoLog(program)
+	if code := d.backend.GetError(); code != NO_ERROR {
+		d.report(code, "GetProgramInfoLog", debugRef("program", program.object))
+	}
+	return v
+}
+
+func (d *Debug) GetShaderParameterb(shader Shader, pname GLenum) bool {
+	v := d.backend.GetShaderParameterb(shader, pname)
+	if code := d.backend.GetError(); code != NO_ERROR {
+		d.report(code, "GetShaderParameterb", debugRef("shader", shader.object), pname)
+	}
+	return v
+}
+
+func (d *Debug) GetShaderInfoLog(shader Shader) string {
+	v := d.backend.GetShaderInfoLog(shader)
+	if code := d.backend.GetError(); code != NO_ERROR {
+		d.report(code, "GetShaderInfoLog", debugRef("shader", shader.object))
+	}
+	return v
+}
+
+func (d *Debug) GetShaderSource(shader Shader) string {
+	v := d.backend.GetShaderSource(shader)
+	if code := d.backend.GetError(); code != NO_ERROR {
+		d.report(code, "GetShaderSource", debugRef("shader", shader.object))
+	}
+	return v
+}
+
+func (d *Debug) GetStencilBits() int {
+	v := d.backend.GetStencilBits()
+	if code := d.backend.GetError(); code != NO_ERROR {
+		d.report(code, "GetStencilBits")
+	}
+	return v
+}
+
+func (d *Debug) GetStencilClearValue() int {
+	v := d.backend.GetStencilClearValue()
+	if code := d.backend.GetError(); code != NO_ERROR {
+		d.report(code, "GetStencilClearValue")
+	}
+	return v
+}
+
+func (d *Debug) GetStencilState(face GLenum) StencilState {
+	v := d.backend.GetStencilState(face)
+	if code := d.backend.GetError(); code != NO_ERROR {
+		d.report(code, "GetStencilState", face)
+	}
+	return v
+}
+
+func (d *Debug) GetSupportedExtensions() []string {
+	v := d.backend.GetSupportedExtensions()
+	if code := d.backend.GetError(); code != NO_ERROR {
+		d.report(code, "GetSupportedExtensions")
+	}
+	return v
+}
+
+func (d *Debug) GetUniformLocation(program Program, name string) UniformLocation {
+	v := d.backend.GetUniformLocation(program, name)
+	if code := d.backend.GetError(); code != NO_ERROR {
+		d.report(code, "GetUniformLocation", debugRef("program", program.object), name)
+	}
+	return v
+}
+
+func (d *Debug) GetVertexAttribOffset(index int, pname GLenum) int {
+	v := d.backend.GetVertexAttribOffset(index, pname)
+	if code := d.backend.GetError(); code != NO_ERROR {
+		d.report(code, "GetVertexAttribOffset", index, pname)
+	}
+	return v
+}
+
+func (d *Debug) Hint(target, mode GLenum) {
+	d.backend.Hint(target, mode)
+	if code := d.backend.GetError(); code != NO_ERROR {
+		d.report(code, "Hint", target, mode)
+	}
+}
+
+func (d *Debug) IsBuffer(buffer Buffer) bool {
+	v := d.backend.IsBuffer(buffer)
+	if code := d.backend.GetError(); code != NO_ERROR {
+		d.report(code, "IsBuffer", debugRef("buffer", buffer.object))
+	}
+	return v
+}
+
+func (d *Debug) IsContextLost() bool {
+	v := d.backend.IsContextLost()
+	if code := d.backend.GetError(); code != NO_ERROR {
+		d.report(code, "IsContextLost")
+	}
+	return v
+}
+
+func (d *Debug) IsFramebuffer(framebuffer Framebuffer) bool {
+	v := d.backend.IsFramebuffer(framebuffer)
+	if code := d.backend.GetError(); code != NO_ERROR {
+		d.report(code, "IsFramebuffer", debugRef("framebuffer", framebuffer.object))
+	}
+	return v
+}
+
+func (d *Debug) IsProgram(program Program) bool {
+	v := d.backend.IsProgram(program)
+	if code := d.backend.GetError(); code != NO_ERROR {
+		d.report(code, "IsProgram", debugRef("program", program.object))
+	}
+	return v
+}
+
+func (d *Debug) IsRenderbuffer(renderbuffer Renderbuffer) bool {
+	v := d.backend.IsRenderbuffer(renderbuffer)
+	if code := d.backend.GetError(); code != NO_ERROR {
+		d.report(code, "IsRenderbuffer", debugRef("renderbuffer", renderbuffer.object))
+	}
+	return v
+}
+
+func (d *Debug) IsShader(shader Shader) bool {
+	v := d.backend.IsShader(shader)
+	if code := d.backend.GetError(); code != NO_ERROR {
+		d.report(code, "IsShader", debugRef("shader", shader.object))
+	}
+	return v
+}
+
+func (d *Debug) IsTexture(texture Texture) bool {
+	v := d.backend.IsTexture(texture)
+	if code := d.backend.GetError(); code != NO_ERROR {
+		d.report(code, "IsTexture", debugRef("texture", texture.object))
+	}
+	return v
+}
+
+func (d *Debug) IsEnabled(capability GLenum) bool {
+	v := d.backend.IsEnabled(capability)
+	if code := d.backend.GetError(); code != NO_ERROR {
+		d.report(code, "IsEnabled", capability)
+	}
+	return v
+}
+
+func (d *Debug) LineWidth(width float64) {
+	d.backend.LineWidth(width)
+	if code := d.backend.GetError(); code != NO_ERROR {
+		d.report(code, "LineWidth", width)
+	}
+}
+
+func (d *Debug) LinkProgram(program Program) {
+	d.backend.LinkProgram(program)
+	if code := d.backend.GetError(); code != NO_ERROR {
+		d.report(code, "LinkProgram", debugRef("program", program.object))
+	}
+}
+
+func (d *Debug) PixelStorei(pname GLenum, param int) {
+	d.backend.PixelStorei(pname, param)
+	if code := d.backend.GetError(); code != NO_ERROR {
+		d.report(code, "PixelStorei", pname, param)
+	}
+}
+
+func (d *Debug) PolygonOffset(factor, units float64) {
+	d.backend.PolygonOffset(factor, units)
+	if code := d.backend.GetError(); code != NO_ERROR {
+		d.report(code, "PolygonOffset", factor, units)
+	}
+}
+
+func (d *Debug) ReadPixelsBytes(x, y, width, height int, format, typ GLenum, pixels []byte) {
+	d.backend.ReadPixelsBytes(x, y, width, height, format, typ, pixels)
+	if code := d.backend.GetError(); code != NO_ERROR {
+		d.report(code, "ReadPixelsBytes", x, y, width, height, format, typ, output{"[]byte", len(pixels)})
+	}
+}
+
+func (d *Debug) ReadPixelsFloat32(x, y, width, height int, format, typ GLenum, pixels []float32) {
+	d.backend.ReadPixelsFloat32(x, y, width, height, format, typ, pixels)
+	if code := d.backend.GetError(); code != NO_ERROR {
+		d.report(code, "ReadPixelsFloat32", x, y, width, height, format, typ, output{"[]float32", len(pixels)})
+	}
+}
+
+func (d *Debug) RenderbufferStorage(target, internalFormat GLenum, width, height int) {
+	d.backend.RenderbufferStorage(target, internalFormat, width, height)
+	if code := d.backend.GetError(); code != NO_ERROR {
+		d.report(code, "RenderbufferStorage", target, internalFormat, width, height)
+	}
+}
+
+func (d *Debug) SampleCoverage(value float64, invert bool) {
+	d.backend.SampleCoverage(value, invert)
+	if code := d.backend.GetError(); code != NO_ERROR {
+		d.report(code, "SampleCoverage", value, invert)
+	}
+}
+
+func (d *Debug) Scissor(x, y, width, height int) {
+	d.backend.Scissor(x, y, width, height)
+	if code := d.backend.GetError(); code != NO_ERROR {
+		d.report(code, "Scissor", x, y, width, height)
+	}
+}
+
+func (d *Debug) ShaderSource(shader Shader, source string) {
+	d.backend.ShaderSource(shader, source)
+	if code := d.backend.GetError(); code != NO_ERROR {
+		d.report(code, "ShaderSource", debugRef("shader", shader.object), source)
+	}
+}
+
+func (d *Debug) StencilFunc(fun GLenum, ref int, mask uint32) {
+	d.backend.StencilFunc(fun, ref, mask)
+	if code := d.backend.GetError(); code != NO_ERROR {
+		d.report(code, "StencilFunc", fun, ref, bitmask(mask))
+	}
+}
+
+func (d *Debug) StencilFuncSeparate(face, fun GLenum, ref int, mask uint32) {
+	d.backend.StencilFuncSeparate(face, fun, ref, mask)
+	if code := d.backend.GetError(); code != NO_ERROR {
+		d.report(code, "StencilFuncSeparate", face, fun, ref, bitmask(mask))
+	}
+}
+
+func (d *Debug) StencilMask(mask uint32) {
+	d.backend.StencilMask(mask)
+	if code := d.backend.GetError(); code != NO_ERROR {
+		d.report(code, "StencilMask", bitmask(mask))
+	}
+}
+
+func (d *Debug) StencilMaskSeparate(face GLenum, mask uint32) {
+	d.backend.StencilMaskSeparate(face, mask)
+	if code := d.backend.GetError(); code != NO_ERROR {
+		d.report(code, "StencilMaskSeparate", face, bitmask(mask))
+	}
+}
+
+func (d *Debug) StencilOp(fail, zfail, zpass GLenum) {
+	d.backend.StencilOp(fail, zfail, zpass)
+	if code := d.backend.GetError(); code != NO_ERROR {
+		d.report(code, "StencilOp", fail, zfail, zpass)
+	}
+}
+
+func (d *Debug) StencilOpSeparate(face, fail, zfail, zpass GLenum) {
+	d.backend.StencilOpSeparate(face, fail, zfail, zpass)
+	if code := d.backend.GetError(); code != NO_ERROR {
+		d.report(code, "StencilOpSeparate", face, fail, zfail, zpass)
+	}
+}
+
+func (d *Debug) TexImage2DBytes(target GLenum, level int, internalFormat GLenum, width, height, border int, format, typ GLenum, pixels []byte) {
+	d.backend.TexImage2DBytes(target, level, internalFormat, width, height, border, format, typ, pixels)
+	if code := d.backend.GetError(); code != NO_ERROR {
+		d.report(code, "TexImage2DBytes", target, level, internalFormat, width, height, border, format, typ, pixels)
+	}
+}
+
+func (d *Debug) TexImage2DUint16(target GLenum, level int, internalFormat GLenum, width, height, border int, format, typ GLenum, pixels []uint16) {
+	d.backend.TexImage2DUint16(target, level, internalFormat, width, height, border, format, typ, pixels)
+	if code := d.backend.GetError(); code != NO_ERROR {
+		d.report(code, "TexImage2DUint16", target, level, internalFormat, width, height, border, format, typ, pixels)
+	}
+}
+
+func (d *Debug) TexImage2DFloat32(target GLenum, level int, internalFormat GLenum, width, height, border int, format, typ GLenum, pixels []float32) {
+	d.backend.TexImage2DFloat32(target, level, internalFormat, width, height, border, format, typ, pixels)
+	if code := d.backend.GetError(); code != NO_ERROR {
+		d.report(code, "TexImage2DFloat32", target, level, internalFormat, width, height, border, format, typ, pixels)
+	}
+}
+
+func (d *Debug) TexParameteri(target, pname GLenum, param int) {
+	d.backend.TexParameteri(target, pname, param)
+	if code := d.backend.GetError(); code != NO_ERROR {
+		d.report(code, "TexParameteri", target, pname, GLenum(param))
+	}
+}
+
+func (d *Debug) TexSubImage2DBytes(target GLenum, level, xoffset, yoffset, width, height int, format, typ GLenum, pixels []byte) {
+	d.backend.TexSubImage2DBytes(target, level, xoffset, yoffset, width, height, format, typ, pixels)
+	if code := d.backend.GetError(); code != NO_ERROR {
+		d.report(code, "TexSubImage2DBytes", target, level, xoffset, yoffset, width, height, format, typ, pixels)
+	}
+}
+
+func (d *Debug) TexSubImage2DUint16(target GLenum, level, xoffset, yoffset, width, height int, format, typ GLenum, pixels []uint16) {
+	d.backend.TexSubImage2DUint16(target, level, xoffset, yoffset, width, height, format, typ, pixels)
+	if code := d.backend.GetError(); code != NO_ERROR {
+		d.report(code, "TexSubImage2DUint16", target, level, xoffset, yoffset, width, height, format, typ, pixels)
+	}
+}
+
+func (d *Debug) TexSubImage2DFloat32(target GLenum, level, xoffset, yoffset, width, height int, format, typ GLenum, pixels []float32) {
+	d.backend.TexSubImage2DFloat32(target, level, xoffset, yoffset, width, height, format, typ, pixels)
+	if code := d.backend.GetError(); code != NO_ERROR {
+		d.report(code, "TexSubImage2DFloat32", target, level, xoffset, yoffset, width, height, format, typ, pixels)
+	}
+}
+
+func (d *Debug) Uniform1f(location UniformLocation, x float32) {
+	d.backend.Uniform1f(location, x)
+	if code := d.backend.GetError(); code != NO_ERROR {
+		d.report(code, "Uniform1f", debugRef("location", location.object), x)
+	}
+}
+
+func (d *Debug) Uniform1i(location UniformLocation, x int) {
+	d.backend.Uniform1i(location, x)
+	if code := d.backend.GetError(); code != NO_ERROR {
+		d.report(code, "Uniform1i", debugRef("location", location.object), x)
+	}
+}
+
+func (d *Debug) Uniform2f(location UniformLocation, x, y float32) {
+	d.backend.Uniform2f(location, x, y)
+	if code := d.backend.GetError(); code != NO_ERROR {
+		d.report(code, "Uniform2f", debugRef("location", location.object), x, y)
+	}
+}
+
+func (d *Debug) Uniform2i(location UniformLocation, x, y int) {
+	d.backend.Uniform2i(location, x, y)
+	if code := d.backend.GetError(); code != NO_ERROR {
+		d.report(code, "Uniform2i", debugRef("location", location.object), x, y)
+	}
+}
+
+func (d *Debug) Uniform3f(location UniformLocation, x, y, z float32) {
+	d.backend.Uniform3f(location, x, y, z)
+	if code := d.backend.GetError(); code != NO_ERROR {
+		d.report(code, "Uniform3f", debugRef("location", location.object), x, y, z)
+	}
+}
+
+func (d *Debug) Uniform3i(location UniformLocation, x, y, z int) {
+	d.backend.Uniform3i(location, x, y, z)
+	if code := d.backend.GetError(); code != NO_ERROR {
+		d.report(code, "Uniform3i", debugRef("location", location.object), x, y, z)
+	}
+}
+
+func (d *Debug) Uniform4f(location UniformLocation, x, y, z, w float32) {
+	d.backend.Uniform4f(location, x, y, z, w)
+	if code := d.backend.GetError(); code != NO_ERROR {
+		d.report(code, "Uniform4f", debugRef("location", location.object), x, y, z, w)
+	}
+}
+
+func (d *Debug) Uniform4i(location UniformLocation, x, y, z, w int) {
+	d.backend.Uniform4i(location, x, y, z, w)
+	if code := d.backend.GetError(); code != NO_ERROR {
+		d.report(code, "Uniform4i", debugRef("location", location.object), x, y, z, w)
+	}
+}
+
+func (d *Debug) Uniform1fv(location UniformLocation, v []float32) {
+	d.backend.Uniform1fv(location, v)
+	if code := d.backend.GetError(); code != NO_ERROR {
+		d.report(code, "Uniform1fv", debugRef("location", location.object), v)
+	}
+}
+
+func (d *Debug) Uniform1iv(location UniformLocation, v []int32) {
+	d.backend.Uniform1iv(location, v)
+	if code := d.backend.GetError(); code != NO_ERROR {
+		d.report(code, "Uniform1iv", debugRef("location", location.object), v)
+	}
+}
+
+func (d *Debug) Uniform2fv(location UniformLocation, v []float32) {
+	d.backend.Uniform2fv(location, v)
+	if code := d.backend.GetError(); code != NO_ERROR {
+		d.report(code, "Uniform2fv", debugRef("location", location.object), v)
+	}
+}
+
+func (d *Debug) Uniform2iv(location UniformLocation, v []int32) {
+	d.backend.Uniform2iv(location, v)
+	if code := d.backend.GetError(); code != NO_ERROR {
+		d.report(code, "Uniform2iv", debugRef("location", location.object), v)
+	}
+}
+
+func (d *Debug) Uniform3fv(location UniformLocation, v []float32) {
+	d.backend.Uniform3fv(location, v)
+	if code := d.backend.GetError(); code != NO_ERROR {
+		d.report(code, "Uniform3fv", debugRef("location", location.object), v)
+	}
+}
+
+func (d *Debug) Uniform3iv(location UniformLocation, v []int32) {
+	d.backend.Uniform3iv(location, v)
+	if code := d.backend.GetError(); code != NO_ERROR {
+		d.report(code, "Uniform3iv", debugRef("location", location.object), v)
+	}
+}
+
+func (d *Debug) Uniform4fv(location UniformLocation, v []float32) {
+	d.backend.Uniform4fv(location, v)
+	if code := d.backend.GetError(); code != NO_ERROR {
+		d.report(code, "Uniform4fv", debugRef("location", location.object), v)
+	}
+}
+
+func (d *Debug) Uniform4iv(location UniformLocation, v []int32) {
+	d.backend.Uniform4iv(location, v)
+	if code := d.backend.GetError(); code != NO_ERROR {
+		d.report(code, "Uniform4iv", debugRef("location", location.object), v)
+	}
+}
+
+func (d *Debug) UniformMatrix2fv(location UniformLocation, transpose bool, value []float32) {
+	d.backend.UniformMatrix2fv(location, transpose, value)
+	if code := d.backend.GetError(); code != NO_ERROR {
+		d.report(code, "UniformMatrix2fv", debugRef("location", location.object), transpose, value)
+	}
+}
+
+func (d *Debug) UniformMatrix3fv(location UniformLocation, transpose bool, value []float32) {
+	d.backend.UniformMatrix3fv(location, transpose, value)
+	if code := d.backend.GetError(); code != NO_ERROR {
+		d.report(code, "UniformMatrix3fv", debugRef("location", location.object), transpose, value)
+	}
+}
+
+func (d *Debug) UniformMatrix4fv(location UniformLocation, transpose bool, value []float32) {
+	d.backend.UniformMatrix4fv(location, transpose, value)
+	if code := d.backend.GetError(); code != NO_ERROR {
+		d.report(code, "UniformMatrix4fv", debugRef("location", location.object), transpose, value)
+	}
+}
+
+func (d *Debug) UseProgram(program Program) {
+	d.backend.UseProgram(program)
+	if code := d.backend.GetError(); code != NO_ERROR {
+		d.report(code, "UseProgram", debugRef("program", program.object))
+	}
+}
+
+func (d *Debug) ValidateProgram(program Program) {
+	d.backend.ValidateProgram(program)
+	if code := d.backend.GetError(); code != NO_ERROR {
+		d.report(code, "ValidateProgram", debugRef("program", program.object))
+	}
+}
+
+func (d *Debug) VertexAttrib1f(index int, x float32) {
+	d.backend.VertexAttrib1f(index, x)
+	if code := d.backend.GetError(); code != NO_ERROR {
+		d.report(code, "VertexAttrib1f", index, x)
+	}
+}
+
+func (d *Debug) VertexAttrib1fv(index int, v []float32) {
+	d.backend.VertexAttrib1fv(index, v)
+	if code := d.backend.GetError(); code != NO_ERROR {
+		d.report(code, "VertexAttrib1fv", index, v)
+	}
+}
+
+func (d *Debug) VertexAttrib2f(index int, x, y float32) {
+	d.backend.VertexAttrib2f(index, x, y)
+	if code := d.backend.GetError(); code != NO_ERROR {
+		d.report(code, "VertexAttrib2f", index, x, y)
+	}
+}
+
+func (d *Debug) VertexAttrib2fv(index int, v []float32) {
+	d.backend.VertexAttrib2fv(index, v)
+	if code := d.backend.GetError(); code != NO_ERROR {
+		d.report(code, "VertexAttrib2fv", index, v)
+	}
+}
+
+func (d *Debug) VertexAttrib3f(index int, x, y, z float32) {
+	d.backend.VertexAttrib3f(index, x, y, z)
+	if code := d.backend.GetError(); code != NO_ERROR {
+		d.report(code, "VertexAttrib3f", index, x, y, z)
+	}
+}
+
+func (d *Debug) VertexAttrib3fv(index int, v []float32) {
+	d.backend.VertexAttrib3fv(index, v)
+	if code := d.backend.GetError(); code != NO_ERROR {
+		d.report(code, "VertexAttrib3fv", index, v)
+	}
+}
+
+func (d *Debug) VertexAttrib4f(index int, x, y, z, w float32) {
+	d.backend.VertexAttrib4f(index, x, y, z, w)
+	if code := d.backend.GetError(); code != NO_ERROR {
+		d.report(code, "VertexAttrib4f", index, x, y, z, w)
+	}
+}
+
+func (d *Debug) VertexAttrib4fv(index int, v []float32) {
+	d.backend.VertexAttrib4fv(index, v)
+	if code := d.backend.GetError(); code != NO_ERROR {
+		d.report(code, "VertexAttrib4fv", index, v)
+	}
+}
+
+func (d *Debug) VertexAttribPointer(index, size int, typ GLenum, normal bool, stride int, offset int) {
+	d.backend.VertexAttribPointer(index, size, typ, normal, stride, offset)
+	if code := d.backend.GetError(); code != NO_ERROR {
+		d.report(code, "VertexAttribPointer", index, size, typ, normal, stride, offset)
+	}
+}
+
+func (d *Debug) Viewport(x, y, width, height int) {
+	d.backend.Viewport(x, y, width, height)
+	if code := d.backend.GetError(); code != NO_ERROR {
+		d.report(code, "Viewport", x, y, width, height)
+	}
+}
+
+var _ Backend = (*Debug)(nil)
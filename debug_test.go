@@ -0,0 +1,150 @@
+// Copyright 2014 Joseph Hager. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package webgl
+
+import (
+	"bytes"
+	"fmt"
+	"log"
+	"path/filepath"
+	"reflect"
+	"runtime"
+	"strings"
+	"testing"
+)
+
+// errorBackend is a standalone Recorder whose GetError returns the errors
+// queued by the test, one per call.
+type errorBackend struct {
+	*Recorder
+	errs []ErrorCode
+}
+
+func (b *errorBackend) GetError() ErrorCode {
+	if len(b.errs) == 0 {
+		return NO_ERROR
+	}
+	code := b.errs[0]
+	b.errs = b.errs[1:]
+	return code
+}
+
+func TestDebugCollect(t *testing.T) {
+	b := &errorBackend{Recorder: NewRecorder(nil)}
+	d := NewDebug(b, DebugCollect)
+
+	d.DrawArrays(TRIANGLES, 0, 3)
+	if errs := d.Errors(); len(errs) != 0 {
+		t.Fatalf("errors %v after a call without errors", errs)
+	}
+
+	b.errs = []ErrorCode{INVALID_ENUM, INVALID_OPERATION}
+	_, file, line, _ := runtime.Caller(0)
+	d.DrawArrays(TRIANGLES, 0, 3)
+	line++
+
+	// The call caused both errors, and they are reported at the call site.
+	want := []*CallError{
+		{INVALID_ENUM, "DrawArrays", []interface{}{TRIANGLES, 0, 3}, file, line},
+		{INVALID_OPERATION, "DrawArrays", []interface{}{TRIANGLES, 0, 3}, file, line},
+	}
+	if errs := d.Errors(); !reflect.DeepEqual(errs, want) {
+		t.Errorf("errors %v, want %v", errs, want)
+	}
+	msg := fmt.Sprintf("webgl: INVALID_ENUM in DrawArrays(TRIANGLES, 0, 3) at %s:%d", file, line)
+	if errs := d.Errors(); len(errs) > 0 && errs[0].Error() != msg {
+		t.Errorf("Error() = %q, want %q", errs[0].Error(), msg)
+	}
+
+	b.errs = []ErrorCode{INVALID_VALUE}
+	buf := b.CreateBuffer()
+	d.BindBuffer(ARRAY_BUFFER, buf)
+	errs := d.Errors()
+	if len(errs) != 3 {
+		t.Fatalf("%d errors, want 3", len(errs))
+	}
+	if got, want := fmt.Sprint(errs[2].Args), fmt.Sprintf("[%v buffer%d]", ARRAY_BUFFER, buf.ID()); got != want {
+		t.Errorf("args %s, want %s", got, want)
+	}
+	if filepath.Base(errs[2].File) != "debug_test.go" {
+		t.Errorf("error reported at %s:%d, want debug_test.go", errs[2].File, errs[2].Line)
+	}
+
+	d.Reset()
+	if errs := d.Errors(); len(errs) != 0 {
+		t.Errorf("errors %v after Reset", errs)
+	}
+}
+
+func TestDebugGetError(t *testing.T) {
+	b := &errorBackend{Recorder: NewRecorder(nil)}
+	d := NewDebug(b, DebugCollect)
+
+	// The first error found since the last GetError is returned once.
+	b.errs = []ErrorCode{INVALID_ENUM, INVALID_VALUE}
+	d.Enable(BLEND)
+	b.errs = []ErrorCode{INVALID_OPERATION}
+	d.Enable(BLEND)
+	if code := d.GetError(); code != INVALID_ENUM {
+		t.Errorf("GetError = %v, want INVALID_ENUM", code)
+	}
+	if code := d.GetError(); code != NO_ERROR {
+		t.Errorf("second GetError = %v, want NO_ERROR", code)
+	}
+
+	// Without a pending error, GetError asks the backend.
+	b.errs = []ErrorCode{OUT_OF_MEMORY}
+	if code := d.GetError(); code != OUT_OF_MEMORY {
+		t.Errorf("GetError = %v, want the OUT_OF_MEMORY of the backend", code)
+	}
+	if n := len(d.Errors()); n != 3 {
+		t.Errorf("%d errors collected, want 3", n)
+	}
+}
+
+func TestDebugPanic(t *testing.T) {
+	b := &errorBackend{Recorder: NewRecorder(nil)}
+	d := NewDebug(b, DebugPanic)
+	b.errs = []ErrorCode{INVALID_FRAMEBUFFER_OPERATION, INVALID_OPERATION}
+
+	var line int
+	defer func() {
+		err, ok := recover().(*CallError)
+		if !ok {
+			t.Fatalf("recovered %v, want a *CallError", err)
+		}
+		if err.Code != INVALID_FRAMEBUFFER_OPERATION || err.Method != "Clear" || err.Line != line+1 {
+			t.Errorf("panicked with %v, want INVALID_FRAMEBUFFER_OPERATION in Clear at line %d", err, line+1)
+		}
+		if len(d.Errors()) != 0 {
+			t.Errorf("errors %v collected in DebugPanic mode", d.Errors())
+		}
+	}()
+	_, _, line, _ = runtime.Caller(0)
+	d.Clear(COLOR_BUFFER_BIT)
+	t.Error("Clear did not panic")
+}
+
+func TestDebugLog(t *testing.T) {
+	var buf bytes.Buffer
+	w := log.Writer()
+	log.SetOutput(&buf)
+	defer log.SetOutput(w)
+
+	b := &errorBackend{Recorder: NewRecorder(nil)}
+	d := NewDebug(b, DebugLog)
+	b.errs = []ErrorCode{INVALID_VALUE, INVALID_ENUM}
+	d.LineWidth(-1)
+
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	if len(lines) != 2 ||
+		!strings.Contains(lines[0], "webgl: INVALID_VALUE in LineWidth(-1) at ") ||
+		!strings.Contains(lines[1], "webgl: INVALID_ENUM in LineWidth(-1) at ") {
+		t.Errorf("logged\n%s", buf.String())
+	}
+	if len(d.Errors()) != 0 {
+		t.Errorf("errors %v collected in DebugLog mode", d.Errors())
+	}
+}
@@ -0,0 +1,90 @@
+// Copyright 2014 Joseph Hager. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package webgl
+
+import "fmt"
+
+// ColorAttachment returns the color attachment point i of a framebuffer,
+// COLOR_ATTACHMENT0_WEBGL + i. Points above 0 need WEBGL_draw_buffers.
+func ColorAttachment(i int) GLenum {
+	return COLOR_ATTACHMENT0_WEBGL + GLenum(i)
+}
+
+// AttachColorTextures attaches level 0 of each 2D texture, in order, to the
+// color attachment points of the framebuffer bound to FRAMEBUFFER, from
+// COLOR_ATTACHMENT0_WEBGL on. It returns the attachment points, to be
+// passed to DrawBuffers.
+func AttachColorTextures(b Backend, textures ...Texture) []GLenum {
+	attachments := make([]GLenum, len(textures))
+	for i, t := range textures {
+		attachments[i] = ColorAttachment(i)
+		b.FramebufferTexture2D(FRAMEBUFFER, attachments[i], TEXTURE_2D, t, 0)
+	}
+	return attachments
+}
+
+// MaxColorAttachments returns the number of color attachment points of a
+// framebuffer.
+func (ext *DrawBuffers) MaxColorAttachments() int {
+	n, _ := ext.c.GetParameterInt(MAX_COLOR_ATTACHMENTS_WEBGL)
+	return n
+}
+
+// MaxDrawBuffers returns the number of fragment shader outputs, the length
+// of gl_FragData.
+func (ext *DrawBuffers) MaxDrawBuffers() int {
+	n, _ := ext.c.GetParameterInt(MAX_DRAW_BUFFERS_WEBGL)
+	return n
+}
+
+// CreateFramebuffer creates a framebuffer with n RGBA textures of width by
+// height pixels as its color attachments, and directs gl_FragData[i] to
+// textures[i]. typ is the type of the texture components, such as
+// UNSIGNED_BYTE, or FLOAT with OES_texture_float. The textures are sampled
+// with NEAREST filtering and clamped to their edges.
+//
+// The framebuffer is left bound to FRAMEBUFFER, and TEXTURE_2D of the
+// active unit is unbound. If n is more than MaxDrawBuffers or
+// MaxColorAttachments, an error is returned before anything is created or
+// bound. If the framebuffer is incomplete, everything created is deleted,
+// the framebuffer that was bound before is bound again, and an error is
+// returned.
+func (ext *DrawBuffers) CreateFramebuffer(width, height, n int, typ GLenum) (Framebuffer, []Texture, error) {
+	c := ext.c
+	max := ext.MaxDrawBuffers()
+	if attachments := ext.MaxColorAttachments(); attachments < max {
+		max = attachments
+	}
+	if n < 1 || n > max {
+		return Framebuffer{}, nil, fmt.Errorf("webgl: CreateFramebuffer of %d color attachments, want 1 to %d", n, max)
+	}
+
+	prev, _ := c.GetParameterFramebuffer(FRAMEBUFFER_BINDING)
+	fb := c.CreateFramebuffer()
+	c.BindFramebuffer(FRAMEBUFFER, fb)
+	textures := make([]Texture, n)
+	for i := range textures {
+		t := c.CreateTexture()
+		c.BindTexture(TEXTURE_2D, t)
+		c.TexParameteri(TEXTURE_2D, TEXTURE_MIN_FILTER, int(NEAREST))
+		c.TexParameteri(TEXTURE_2D, TEXTURE_MAG_FILTER, int(NEAREST))
+		c.TexParameteri(TEXTURE_2D, TEXTURE_WRAP_S, int(CLAMP_TO_EDGE))
+		c.TexParameteri(TEXTURE_2D, TEXTURE_WRAP_T, int(CLAMP_TO_EDGE))
+		c.TexImage2DBytes(TEXTURE_2D, 0, RGBA, width, height, 0, RGBA, typ, nil)
+		textures[i] = t
+	}
+	c.BindTexture(TEXTURE_2D, Texture{})
+	ext.DrawBuffers(AttachColorTextures(c, textures...))
+
+	if status := c.CheckFramebufferStatus(FRAMEBUFFER); status != FRAMEBUFFER_COMPLETE {
+		c.BindFramebuffer(FRAMEBUFFER, prev)
+		c.DeleteFramebuffer(fb)
+		for _, t := range textures {
+			c.DeleteTexture(t)
+		}
+		return Framebuffer{}, nil, fmt.Errorf("webgl: framebuffer of %d %v textures is incomplete: %v", n, typ, status)
+	}
+	return fb, textures, nil
+}
@@ -0,0 +1,2372 @@
+// Copyright 2014 Joseph Hager. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package webgl
+
+import "fmt"
+
+// GLenum is the type of the WebGL enum and bitfield constants. Unlike the
+// enum fields of Context, which mirror the JavaScript context object, the
+// constants below are known at compile time and identical on every target.
+type GLenum int
+
+// WebGL 1.0 enums.
+const (
+	DEPTH_BUFFER_BIT                             GLenum = 0x00000100
+	STENCIL_BUFFER_BIT                           GLenum = 0x00000400
+	COLOR_BUFFER_BIT                             GLenum = 0x00004000
+	POINTS                                       GLenum = 0x0000
+	LINES                                        GLenum = 0x0001
+	LINE_LOOP                                    GLenum = 0x0002
+	LINE_STRIP                                   GLenum = 0x0003
+	TRIANGLES                                    GLenum = 0x0004
+	TRIANGLE_STRIP                               GLenum = 0x0005
+	TRIANGLE_FAN                                 GLenum = 0x0006
+	ZERO                                         GLenum = 0
+	ONE                                          GLenum = 1
+	SRC_COLOR                                    GLenum = 0x0300
+	ONE_MINUS_SRC_COLOR                          GLenum = 0x0301
+	SRC_ALPHA                                    GLenum = 0x0302
+	ONE_MINUS_SRC_ALPHA                          GLenum = 0x0303
+	DST_ALPHA                                    GLenum = 0x0304
+	ONE_MINUS_DST_ALPHA                          GLenum = 0x0305
+	DST_COLOR                                    GLenum = 0x0306
+	ONE_MINUS_DST_COLOR                          GLenum = 0x0307
+	SRC_ALPHA_SATURATE                           GLenum = 0x0308
+	FUNC_ADD                                     GLenum = 0x8006
+	BLEND_EQUATION                               GLenum = 0x8009
+	BLEND_EQUATION_RGB                           GLenum = 0x8009
+	BLEND_EQUATION_ALPHA                         GLenum = 0x883D
+	FUNC_SUBTRACT                                GLenum = 0x800A
+	FUNC_REVERSE_SUBTRACT                        GLenum = 0x800B
+	BLEND_DST_RGB                                GLenum = 0x80C8
+	BLEND_SRC_RGB                                GLenum = 0x80C9
+	BLEND_DST_ALPHA                              GLenum = 0x80CA
+	BLEND_SRC_ALPHA                              GLenum = 0x80CB
+	CONSTANT_COLOR                               GLenum = 0x8001
+	ONE_MINUS_CONSTANT_COLOR                     GLenum = 0x8002
+	CONSTANT_ALPHA                               GLenum = 0x8003
+	ONE_MINUS_CONSTANT_ALPHA                     GLenum = 0x8004
+	BLEND_COLOR                                  GLenum = 0x8005
+	ARRAY_BUFFER                                 GLenum = 0x8892
+	ELEMENT_ARRAY_BUFFER                         GLenum = 0x8893
+	ARRAY_BUFFER_BINDING                         GLenum = 0x8894
+	ELEMENT_ARRAY_BUFFER_BINDING                 GLenum = 0x8895
+	STREAM_DRAW                                  GLenum = 0x88E0
+	STATIC_DRAW                                  GLenum = 0x88E4
+	DYNAMIC_DRAW                                 GLenum = 0x88E8
+	BUFFER_SIZE                                  GLenum = 0x8764
+	BUFFER_USAGE                                 GLenum = 0x8765
+	CURRENT_VERTEX_ATTRIB                        GLenum = 0x8626
+	FRONT                                        GLenum = 0x0404
+	BACK                                         GLenum = 0x0405
+	FRONT_AND_BACK                               GLenum = 0x0408
+	CULL_FACE                                    GLenum = 0x0B44
+	BLEND                                        GLenum = 0x0BE2
+	DITHER                                       GLenum = 0x0BD0
+	STENCIL_TEST                                 GLenum = 0x0B90
+	DEPTH_TEST                                   GLenum = 0x0B71
+	SCISSOR_TEST                                 GLenum = 0x0C11
+	POLYGON_OFFSET_FILL                          GLenum = 0x8037
+	SAMPLE_ALPHA_TO_COVERAGE                     GLenum = 0x809E
+	SAMPLE_COVERAGE                              GLenum = 0x80A0
+	CW                                           GLenum = 0x0900
+	CCW                                          GLenum = 0x0901
+	LINE_WIDTH                                   GLenum = 0x0B21
+	ALIASED_POINT_SIZE_RANGE                     GLenum = 0x846D
+	ALIASED_LINE_WIDTH_RANGE                     GLenum = 0x846E
+	CULL_FACE_MODE                               GLenum = 0x0B45
+	FRONT_FACE                                   GLenum = 0x0B46
+	DEPTH_RANGE                                  GLenum = 0x0B70
+	DEPTH_WRITEMASK                              GLenum = 0x0B72
+	DEPTH_CLEAR_VALUE                            GLenum = 0x0B73
+	DEPTH_FUNC                                   GLenum = 0x0B74
+	STENCIL_CLEAR_VALUE                          GLenum = 0x0B91
+	STENCIL_FUNC                                 GLenum = 0x0B92
+	STENCIL_FAIL                                 GLenum = 0x0B94
+	STENCIL_PASS_DEPTH_FAIL                      GLenum = 0x0B95
+	STENCIL_PASS_DEPTH_PASS                      GLenum = 0x0B96
+	STENCIL_REF                                  GLenum = 0x0B97
+	STENCIL_VALUE_MASK                           GLenum = 0x0B93
+	STENCIL_WRITEMASK                            GLenum = 0x0B98
+	STENCIL_BACK_FUNC                            GLenum = 0x8800
+	STENCIL_BACK_FAIL                            GLenum = 0x8801
+	STENCIL_BACK_PASS_DEPTH_FAIL                 GLenum = 0x8802
+	STENCIL_BACK_PASS_DEPTH_PASS                 GLenum = 0x8803
+	STENCIL_BACK_REF                             GLenum = 0x8CA3
+	STENCIL_BACK_VALUE_MASK                      GLenum = 0x8CA4
+	STENCIL_BACK_WRITEMASK                       GLenum = 0x8CA5
+	VIEWPORT                                     GLenum = 0x0BA2
+	SCISSOR_BOX                                  GLenum = 0x0C10
+	COLOR_CLEAR_VALUE                            GLenum = 0x0C22
+	COLOR_WRITEMASK                              GLenum = 0x0C23
+	UNPACK_ALIGNMENT                             GLenum = 0x0CF5
+	PACK_ALIGNMENT                               GLenum = 0x0D05
+	MAX_TEXTURE_SIZE                             GLenum = 0x0D33
+	MAX_VIEWPORT_DIMS                            GLenum = 0x0D3A
+	SUBPIXEL_BITS                                GLenum = 0x0D50
+	RED_BITS                                     GLenum = 0x0D52
+	GREEN_BITS                                   GLenum = 0x0D53
+	BLUE_BITS                                    GLenum = 0x0D54
+	ALPHA_BITS                                   GLenum = 0x0D55
+	DEPTH_BITS                                   GLenum = 0x0D56
+	STENCIL_BITS                                 GLenum = 0x0D57
+	POLYGON_OFFSET_UNITS                         GLenum = 0x2A00
+	POLYGON_OFFSET_FACTOR                        GLenum = 0x8038
+	TEXTURE_BINDING_2D                           GLenum = 0x8069
+	SAMPLE_BUFFERS                               GLenum = 0x80A8
+	SAMPLES                                      GLenum = 0x80A9
+	SAMPLE_COVERAGE_VALUE                        GLenum = 0x80AA
+	SAMPLE_COVERAGE_INVERT                       GLenum = 0x80AB
+	NUM_COMPRESSED_TEXTURE_FORMATS               GLenum = 0x86A2
+	COMPRESSED_TEXTURE_FORMATS                   GLenum = 0x86A3
+	DONT_CARE                                    GLenum = 0x1100
+	FASTEST                                      GLenum = 0x1101
+	NICEST                                       GLenum = 0x1102
+	GENERATE_MIPMAP_HINT                         GLenum = 0x8192
+	BYTE                                         GLenum = 0x1400
+	UNSIGNED_BYTE                                GLenum = 0x1401
+	SHORT                                        GLenum = 0x1402
+	UNSIGNED_SHORT                               GLenum = 0x1403
+	INT                                          GLenum = 0x1404
+	UNSIGNED_INT                                 GLenum = 0x1405
+	FLOAT                                        GLenum = 0x1406
+	DEPTH_COMPONENT                              GLenum = 0x1902
+	ALPHA                                        GLenum = 0x1906
+	RGB                                          GLenum = 0x1907
+	RGBA                                         GLenum = 0x1908
+	LUMINANCE                                    GLenum = 0x1909
+	LUMINANCE_ALPHA                              GLenum = 0x190A
+	UNSIGNED_SHORT_4_4_4_4                       GLenum = 0x8033
+	UNSIGNED_SHORT_5_5_5_1                       GLenum = 0x8034
+	UNSIGNED_SHORT_5_6_5                         GLenum = 0x8363
+	FRAGMENT_SHADER                              GLenum = 0x8B30
+	VERTEX_SHADER                                GLenum = 0x8B31
+	MAX_VERTEX_ATTRIBS                           GLenum = 0x8869
+	MAX_VERTEX_UNIFORM_VECTORS                   GLenum = 0x8DFB
+	MAX_VARYING_VECTORS                          GLenum = 0x8DFC
+	MAX_COMBINED_TEXTURE_IMAGE_UNITS             GLenum = 0x8B4D
+	MAX_VERTEX_TEXTURE_IMAGE_UNITS               GLenum = 0x8B4C
+	MAX_TEXTURE_IMAGE_UNITS                      GLenum = 0x8872
+	MAX_FRAGMENT_UNIFORM_VECTORS                 GLenum = 0x8DFD
+	SHADER_TYPE                                  GLenum = 0x8B4F
+	DELETE_STATUS                                GLenum = 0x8B80
+	COMPILE_STATUS                               GLenum = 0x8B81
+	LINK_STATUS                                  GLenum = 0x8B82
+	VALIDATE_STATUS                              GLenum = 0x8B83
+	INFO_LOG_LENGTH                              GLenum = 0x8B84
+	ATTACHED_SHADERS                             GLenum = 0x8B85
+	ACTIVE_UNIFORMS                              GLenum = 0x8B86
+	SHADER_SOURCE_LENGTH                         GLenum = 0x8B88
+	ACTIVE_ATTRIBUTES                            GLenum = 0x8B89
+	SHADING_LANGUAGE_VERSION                     GLenum = 0x8B8C
+	CURRENT_PROGRAM                              GLenum = 0x8B8D
+	SHADER_COMPILER                              GLenum = 0x8DFA
+	NEVER                                        GLenum = 0x0200
+	LESS                                         GLenum = 0x0201
+	EQUAL                                        GLenum = 0x0202
+	LEQUAL                                       GLenum = 0x0203
+	GREATER                                      GLenum = 0x0204
+	NOTEQUAL                                     GLenum = 0x0205
+	GEQUAL                                       GLenum = 0x0206
+	ALWAYS                                       GLenum = 0x0207
+	KEEP                                         GLenum = 0x1E00
+	REPLACE                                      GLenum = 0x1E01
+	INCR                                         GLenum = 0x1E02
+	DECR                                         GLenum = 0x1E03
+	INVERT                                       GLenum = 0x150A
+	INCR_WRAP                                    GLenum = 0x8507
+	DECR_WRAP                                    GLenum = 0x8508
+	VENDOR                                       GLenum = 0x1F00
+	RENDERER                                     GLenum = 0x1F01
+	VERSION                                      GLenum = 0x1F02
+	NEAREST                                      GLenum = 0x2600
+	LINEAR                                       GLenum = 0x2601
+	NEAREST_MIPMAP_NEAREST                       GLenum = 0x2700
+	LINEAR_MIPMAP_NEAREST                        GLenum = 0x2701
+	NEAREST_MIPMAP_LINEAR                        GLenum = 0x2702
+	LINEAR_MIPMAP_LINEAR                         GLenum = 0x2703
+	TEXTURE_MAG_FILTER                           GLenum = 0x2800
+	TEXTURE_MIN_FILTER                           GLenum = 0x2801
+	TEXTURE_WRAP_S                               GLenum = 0x2802
+	TEXTURE_WRAP_T                               GLenum = 0x2803
+	TEXTURE_2D                                   GLenum = 0x0DE1
+	TEXTURE                                      GLenum = 0x1702
+	TEXTURE_CUBE_MAP                             GLenum = 0x8513
+	TEXTURE_BINDING_CUBE_MAP                     GLenum = 0x8514
+	TEXTURE_CUBE_MAP_POSITIVE_X                  GLenum = 0x8515
+	TEXTURE_CUBE_MAP_NEGATIVE_X                  GLenum = 0x8516
+	TEXTURE_CUBE_MAP_POSITIVE_Y                  GLenum = 0x8517
+	TEXTURE_CUBE_MAP_NEGATIVE_Y                  GLenum = 0x8518
+	TEXTURE_CUBE_MAP_POSITIVE_Z                  GLenum = 0x8519
+	TEXTURE_CUBE_MAP_NEGATIVE_Z                  GLenum = 0x851A
+	MAX_CUBE_MAP_TEXTURE_SIZE                    GLenum = 0x851C
+	TEXTURE0                                     GLenum = 0x84C0
+	TEXTURE1                                     GLenum = 0x84C1
+	TEXTURE2                                     GLenum = 0x84C2
+	TEXTURE3                                     GLenum = 0x84C3
+	TEXTURE4                                     GLenum = 0x84C4
+	TEXTURE5                                     GLenum = 0x84C5
+	TEXTURE6                                     GLenum = 0x84C6
+	TEXTURE7                                     GLenum = 0x84C7
+	TEXTURE8                                     GLenum = 0x84C8
+	TEXTURE9                                     GLenum = 0x84C9
+	TEXTURE10                                    GLenum = 0x84CA
+	TEXTURE11                                    GLenum = 0x84CB
+	TEXTURE12                                    GLenum = 0x84CC
+	TEXTURE13                                    GLenum = 0x84CD
+	TEXTURE14                                    GLenum = 0x84CE
+	TEXTURE15                                    GLenum = 0x84CF
+	TEXTURE16                                    GLenum = 0x84D0
+	TEXTURE17                                    GLenum = 0x84D1
+	TEXTURE18                                    GLenum = 0x84D2
+	TEXTURE19                                    GLenum = 0x84D3
+	TEXTURE20                                    GLenum = 0x84D4
+	TEXTURE21                                    GLenum = 0x84D5
+	TEXTURE22                                    GLenum = 0x84D6
+	TEXTURE23                                    GLenum = 0x84D7
+	TEXTURE24                                    GLenum = 0x84D8
+	TEXTURE25                                    GLenum = 0x84D9
+	TEXTURE26                                    GLenum = 0x84DA
+	TEXTURE27                                    GLenum = 0x84DB
+	TEXTURE28                                    GLenum = 0x84DC
+	TEXTURE29                                    GLenum = 0x84DD
+	TEXTURE30                                    GLenum = 0x84DE
+	TEXTURE31                                    GLenum = 0x84DF
+	ACTIVE_TEXTURE                               GLenum = 0x84E0
+	REPEAT                                       GLenum = 0x2901
+	CLAMP_TO_EDGE                                GLenum = 0x812F
+	MIRRORED_REPEAT                              GLenum = 0x8370
+	FLOAT_VEC2                                   GLenum = 0x8B50
+	FLOAT_VEC3                                   GLenum = 0x8B51
+	FLOAT_VEC4                                   GLenum = 0x8B52
+	INT_VEC2                                     GLenum = 0x8B53
+	INT_VEC3                                     GLenum = 0x8B54
+	INT_VEC4                                     GLenum = 0x8B55
+	BOOL                                         GLenum = 0x8B56
+	BOOL_VEC2                                    GLenum = 0x8B57
+	BOOL_VEC3                                    GLenum = 0x8B58
+	BOOL_VEC4                                    GLenum = 0x8B59
+	FLOAT_MAT2                                   GLenum = 0x8B5A
+	FLOAT_MAT3                                   GLenum = 0x8B5B
+	FLOAT_MAT4                                   GLenum = 0x8B5C
+	SAMPLER_2D                                   GLenum = 0x8B5E
+	SAMPLER_CUBE                                 GLenum = 0x8B60
+	VERTEX_ATTRIB_ARRAY_ENABLED                  GLenum = 0x8622
+	VERTEX_ATTRIB_ARRAY_SIZE                     GLenum = 0x8623
+	VERTEX_ATTRIB_ARRAY_STRIDE                   GLenum = 0x8624
+	VERTEX_ATTRIB_ARRAY_TYPE                     GLenum = 0x8625
+	VERTEX_ATTRIB_ARRAY_NORMALIZED               GLenum = 0x886A
+	VERTEX_ATTRIB_ARRAY_POINTER                  GLenum = 0x8645
+	VERTEX_ATTRIB_ARRAY_BUFFER_BINDING           GLenum = 0x889F
+	IMPLEMENTATION_COLOR_READ_TYPE               GLenum = 0x8B9A
+	IMPLEMENTATION_COLOR_READ_FORMAT             GLenum = 0x8B9B
+	LOW_FLOAT                                    GLenum = 0x8DF0
+	MEDIUM_FLOAT                                 GLenum = 0x8DF1
+	HIGH_FLOAT                                   GLenum = 0x8DF2
+	LOW_INT                                      GLenum = 0x8DF3
+	MEDIUM_INT                                   GLenum = 0x8DF4
+	HIGH_INT                                     GLenum = 0x8DF5
+	FRAMEBUFFER                                  GLenum = 0x8D40
+	RENDERBUFFER                                 GLenum = 0x8D41
+	RGBA4                                        GLenum = 0x8056
+	RGB5_A1                                      GLenum = 0x8057
+	RGB565                                       GLenum = 0x8D62
+	DEPTH_COMPONENT16                            GLenum = 0x81A5
+	STENCIL_INDEX                                GLenum = 0x1901
+	STENCIL_INDEX8                               GLenum = 0x8D48
+	DEPTH_STENCIL                                GLenum = 0x84F9
+	RENDERBUFFER_WIDTH                           GLenum = 0x8D42
+	RENDERBUFFER_HEIGHT                          GLenum = 0x8D43
+	RENDERBUFFER_INTERNAL_FORMAT                 GLenum = 0x8D44
+	RENDERBUFFER_RED_SIZE                        GLenum = 0x8D50
+	RENDERBUFFER_GREEN_SIZE                      GLenum = 0x8D51
+	RENDERBUFFER_BLUE_SIZE                       GLenum = 0x8D52
+	RENDERBUFFER_ALPHA_SIZE                      GLenum = 0x8D53
+	RENDERBUFFER_DEPTH_SIZE                      GLenum = 0x8D54
+	RENDERBUFFER_STENCIL_SIZE                    GLenum = 0x8D55
+	FRAMEBUFFER_ATTACHMENT_OBJECT_TYPE           GLenum = 0x8CD0
+	FRAMEBUFFER_ATTACHMENT_OBJECT_NAME           GLenum = 0x8CD1
+	FRAMEBUFFER_ATTACHMENT_TEXTURE_LEVEL         GLenum = 0x8CD2
+	FRAMEBUFFER_ATTACHMENT_TEXTURE_CUBE_MAP_FACE GLenum = 0x8CD3
+	COLOR_ATTACHMENT0                            GLenum = 0x8CE0
+	DEPTH_ATTACHMENT                             GLenum = 0x8D00
+	STENCIL_ATTACHMENT                           GLenum = 0x8D20
+	DEPTH_STENCIL_ATTACHMENT                     GLenum = 0x821A
+	NONE                                         GLenum = 0
+	FRAMEBUFFER_COMPLETE                         GLenum = 0x8CD5
+	FRAMEBUFFER_INCOMPLETE_ATTACHMENT            GLenum = 0x8CD6
+	FRAMEBUFFER_INCOMPLETE_MISSING_ATTACHMENT    GLenum = 0x8CD7
+	FRAMEBUFFER_INCOMPLETE_DIMENSIONS            GLenum = 0x8CD9
+	FRAMEBUFFER_UNSUPPORTED                      GLenum = 0x8CDD
+	FRAMEBUFFER_BINDING                          GLenum = 0x8CA6
+	RENDERBUFFER_BINDING                         GLenum = 0x8CA7
+	MAX_RENDERBUFFER_SIZE                        GLenum = 0x84E8
+	UNPACK_FLIP_Y_WEBGL                          GLenum = 0x9240
+	UNPACK_PREMULTIPLY_ALPHA_WEBGL               GLenum = 0x9241
+	UNPACK_COLORSPACE_CONVERSION_WEBGL           GLenum = 0x9243
+	BROWSER_DEFAULT_WEBGL                        GLenum = 0x9244
+)
+
+// WebGL 2.0 enums.
+const (
+	READ_BUFFER                                   GLenum = 0x0C02
+	UNPACK_ROW_LENGTH                             GLenum = 0x0CF2
+	UNPACK_SKIP_ROWS                              GLenum = 0x0CF3
+	UNPACK_SKIP_PIXELS                            GLenum = 0x0CF4
+	PACK_ROW_LENGTH                               GLenum = 0x0D02
+	PACK_SKIP_ROWS                                GLenum = 0x0D03
+	PACK_SKIP_PIXELS                              GLenum = 0x0D04
+	COLOR                                         GLenum = 0x1800
+	DEPTH                                         GLenum = 0x1801
+	STENCIL                                       GLenum = 0x1802
+	RED                                           GLenum = 0x1903
+	RGB8                                          GLenum = 0x8051
+	RGBA8                                         GLenum = 0x8058
+	RGB10_A2                                      GLenum = 0x8059
+	TEXTURE_BINDING_3D                            GLenum = 0x806A
+	UNPACK_SKIP_IMAGES                            GLenum = 0x806D
+	UNPACK_IMAGE_HEIGHT                           GLenum = 0x806E
+	TEXTURE_3D                                    GLenum = 0x806F
+	TEXTURE_WRAP_R                                GLenum = 0x8072
+	MAX_3D_TEXTURE_SIZE                           GLenum = 0x8073
+	UNSIGNED_INT_2_10_10_10_REV                   GLenum = 0x8368
+	MAX_ELEMENTS_VERTICES                         GLenum = 0x80E8
+	MAX_ELEMENTS_INDICES                          GLenum = 0x80E9
+	TEXTURE_MIN_LOD                               GLenum = 0x813A
+	TEXTURE_MAX_LOD                               GLenum = 0x813B
+	TEXTURE_BASE_LEVEL                            GLenum = 0x813C
+	TEXTURE_MAX_LEVEL                             GLenum = 0x813D
+	MIN                                           GLenum = 0x8007
+	MAX                                           GLenum = 0x8008
+	DEPTH_COMPONENT24                             GLenum = 0x81A6
+	MAX_TEXTURE_LOD_BIAS                          GLenum = 0x84FD
+	TEXTURE_COMPARE_MODE                          GLenum = 0x884C
+	TEXTURE_COMPARE_FUNC                          GLenum = 0x884D
+	CURRENT_QUERY                                 GLenum = 0x8865
+	QUERY_RESULT                                  GLenum = 0x8866
+	QUERY_RESULT_AVAILABLE                        GLenum = 0x8867
+	STREAM_READ                                   GLenum = 0x88E1
+	STREAM_COPY                                   GLenum = 0x88E2
+	STATIC_READ                                   GLenum = 0x88E5
+	STATIC_COPY                                   GLenum = 0x88E6
+	DYNAMIC_READ                                  GLenum = 0x88E9
+	DYNAMIC_COPY                                  GLenum = 0x88EA
+	MAX_DRAW_BUFFERS                              GLenum = 0x8824
+	DRAW_BUFFER0                                  GLenum = 0x8825
+	DRAW_BUFFER1                                  GLenum = 0x8826
+	DRAW_BUFFER2                                  GLenum = 0x8827
+	DRAW_BUFFER3                                  GLenum = 0x8828
+	DRAW_BUFFER4                                  GLenum = 0x8829
+	DRAW_BUFFER5                                  GLenum = 0x882A
+	DRAW_BUFFER6                                  GLenum = 0x882B
+	DRAW_BUFFER7                                  GLenum = 0x882C
+	DRAW_BUFFER8                                  GLenum = 0x882D
+	DRAW_BUFFER9                                  GLenum = 0x882E
+	DRAW_BUFFER10                                 GLenum = 0x882F
+	DRAW_BUFFER11                                 GLenum = 0x8830
+	DRAW_BUFFER12                                 GLenum = 0x8831
+	DRAW_BUFFER13                                 GLenum = 0x8832
+	DRAW_BUFFER14                                 GLenum = 0x8833
+	DRAW_BUFFER15                                 GLenum = 0x8834
+	MAX_FRAGMENT_UNIFORM_COMPONENTS               GLenum = 0x8B49
+	MAX_VERTEX_UNIFORM_COMPONENTS                 GLenum = 0x8B4A
+	SAMPLER_3D                                    GLenum = 0x8B5F
+	SAMPLER_2D_SHADOW                             GLenum = 0x8B62
+	FRAGMENT_SHADER_DERIVATIVE_HINT               GLenum = 0x8B8B
+	PIXEL_PACK_BUFFER                             GLenum = 0x88EB
+	PIXEL_UNPACK_BUFFER                           GLenum = 0x88EC
+	PIXEL_PACK_BUFFER_BINDING                     GLenum = 0x88ED
+	PIXEL_UNPACK_BUFFER_BINDING                   GLenum = 0x88EF
+	FLOAT_MAT2x3                                  GLenum = 0x8B65
+	FLOAT_MAT2x4                                  GLenum = 0x8B66
+	FLOAT_MAT3x2                                  GLenum = 0x8B67
+	FLOAT_MAT3x4                                  GLenum = 0x8B68
+	FLOAT_MAT4x2                                  GLenum = 0x8B69
+	FLOAT_MAT4x3                                  GLenum = 0x8B6A
+	SRGB                                          GLenum = 0x8C40
+	SRGB8                                         GLenum = 0x8C41
+	SRGB8_ALPHA8                                  GLenum = 0x8C43
+	COMPARE_REF_TO_TEXTURE                        GLenum = 0x884E
+	RGBA32F                                       GLenum = 0x8814
+	RGB32F                                        GLenum = 0x8815
+	RGBA16F                                       GLenum = 0x881A
+	RGB16F                                        GLenum = 0x881B
+	VERTEX_ATTRIB_ARRAY_INTEGER                   GLenum = 0x88FD
+	MAX_ARRAY_TEXTURE_LAYERS                      GLenum = 0x88FF
+	MIN_PROGRAM_TEXEL_OFFSET                      GLenum = 0x8904
+	MAX_PROGRAM_TEXEL_OFFSET                      GLenum = 0x8905
+	MAX_VARYING_COMPONENTS                        GLenum = 0x8B4B
+	TEXTURE_2D_ARRAY                              GLenum = 0x8C1A
+	TEXTURE_BINDING_2D_ARRAY                      GLenum = 0x8C1D
+	R11F_G11F_B10F                                GLenum = 0x8C3A
+	UNSIGNED_INT_10F_11F_11F_REV                  GLenum = 0x8C3B
+	RGB9_E5                                       GLenum = 0x8C3D
+	UNSIGNED_INT_5_9_9_9_REV                      GLenum = 0x8C3E
+	TRANSFORM_FEEDBACK_BUFFER_MODE                GLenum = 0x8C7F
+	MAX_TRANSFORM_FEEDBACK_SEPARATE_COMPONENTS    GLenum = 0x8C80
+	TRANSFORM_FEEDBACK_VARYINGS                   GLenum = 0x8C83
+	TRANSFORM_FEEDBACK_BUFFER_START               GLenum = 0x8C84
+	TRANSFORM_FEEDBACK_BUFFER_SIZE                GLenum = 0x8C85
+	TRANSFORM_FEEDBACK_PRIMITIVES_WRITTEN         GLenum = 0x8C88
+	RASTERIZER_DISCARD                            GLenum = 0x8C89
+	MAX_TRANSFORM_FEEDBACK_INTERLEAVED_COMPONENTS GLenum = 0x8C8A
+	MAX_TRANSFORM_FEEDBACK_SEPARATE_ATTRIBS       GLenum = 0x8C8B
+	INTERLEAVED_ATTRIBS                           GLenum = 0x8C8C
+	SEPARATE_ATTRIBS                              GLenum = 0x8C8D
+	TRANSFORM_FEEDBACK_BUFFER                     GLenum = 0x8C8E
+	TRANSFORM_FEEDBACK_BUFFER_BINDING             GLenum = 0x8C8F
+	RGBA32UI                                      GLenum = 0x8D70
+	RGB32UI                                       GLenum = 0x8D71
+	RGBA16UI                                      GLenum = 0x8D76
+	RGB16UI                                       GLenum = 0x8D77
+	RGBA8UI                                       GLenum = 0x8D7C
+	RGB8UI                                        GLenum = 0x8D7D
+	RGBA32I                                       GLenum = 0x8D82
+	RGB32I                                        GLenum = 0x8D83
+	RGBA16I                                       GLenum = 0x8D88
+	RGB16I                                        GLenum = 0x8D89
+	RGBA8I                                        GLenum = 0x8D8E
+	RGB8I                                         GLenum = 0x8D8F
+	RED_INTEGER                                   GLenum = 0x8D94
+	RGB_INTEGER                                   GLenum = 0x8D98
+	RGBA_INTEGER                                  GLenum = 0x8D99
+	SAMPLER_2D_ARRAY                              GLenum = 0x8DC1
+	SAMPLER_2D_ARRAY_SHADOW                       GLenum = 0x8DC4
+	SAMPLER_CUBE_SHADOW                           GLenum = 0x8DC5
+	UNSIGNED_INT_VEC2                             GLenum = 0x8DC6
+	UNSIGNED_INT_VEC3                             GLenum = 0x8DC7
+	UNSIGNED_INT_VEC4                             GLenum = 0x8DC8
+	INT_SAMPLER_2D                                GLenum = 0x8DCA
+	INT_SAMPLER_3D                                GLenum = 0x8DCB
+	INT_SAMPLER_CUBE                              GLenum = 0x8DCC
+	INT_SAMPLER_2D_ARRAY                          GLenum = 0x8DCF
+	UNSIGNED_INT_SAMPLER_2D                       GLenum = 0x8DD2
+	UNSIGNED_INT_SAMPLER_3D                       GLenum = 0x8DD3
+	UNSIGNED_INT_SAMPLER_CUBE                     GLenum = 0x8DD4
+	UNSIGNED_INT_SAMPLER_2D_ARRAY                 GLenum = 0x8DD7
+	DEPTH_COMPONENT32F                            GLenum = 0x8CAC
+	DEPTH32F_STENCIL8                             GLenum = 0x8CAD
+	FLOAT_32_UNSIGNED_INT_24_8_REV                GLenum = 0x8DAD
+	FRAMEBUFFER_ATTACHMENT_COLOR_ENCODING         GLenum = 0x8210
+	FRAMEBUFFER_ATTACHMENT_COMPONENT_TYPE         GLenum = 0x8211
+	FRAMEBUFFER_ATTACHMENT_RED_SIZE               GLenum = 0x8212
+	FRAMEBUFFER_ATTACHMENT_GREEN_SIZE             GLenum = 0x8213
+	FRAMEBUFFER_ATTACHMENT_BLUE_SIZE              GLenum = 0x8214
+	FRAMEBUFFER_ATTACHMENT_ALPHA_SIZE             GLenum = 0x8215
+	FRAMEBUFFER_ATTACHMENT_DEPTH_SIZE             GLenum = 0x8216
+	FRAMEBUFFER_ATTACHMENT_STENCIL_SIZE           GLenum = 0x8217
+	FRAMEBUFFER_DEFAULT                           GLenum = 0x8218
+	UNSIGNED_INT_24_8                             GLenum = 0x84FA
+	DEPTH24_STENCIL8                              GLenum = 0x88F0
+	UNSIGNED_NORMALIZED                           GLenum = 0x8C17
+	DRAW_FRAMEBUFFER_BINDING                      GLenum = 0x8CA6
+	READ_FRAMEBUFFER                              GLenum = 0x8CA8
+	DRAW_FRAMEBUFFER                              GLenum = 0x8CA9
+	READ_FRAMEBUFFER_BINDING                      GLenum = 0x8CAA
+	RENDERBUFFER_SAMPLES                          GLenum = 0x8CAB
+	FRAMEBUFFER_ATTACHMENT_TEXTURE_LAYER          GLenum = 0x8CD4
+	MAX_COLOR_ATTACHMENTS                         GLenum = 0x8CDF
+	COLOR_ATTACHMENT1                             GLenum = 0x8CE1
+	COLOR_ATTACHMENT2                             GLenum = 0x8CE2
+	COLOR_ATTACHMENT3                             GLenum = 0x8CE3
+	COLOR_ATTACHMENT4                             GLenum = 0x8CE4
+	COLOR_ATTACHMENT5                             GLenum = 0x8CE5
+	COLOR_ATTACHMENT6                             GLenum = 0x8CE6
+	COLOR_ATTACHMENT7                             GLenum = 0x8CE7
+	COLOR_ATTACHMENT8                             GLenum = 0x8CE8
+	COLOR_ATTACHMENT9                             GLenum = 0x8CE9
+	COLOR_ATTACHMENT10                            GLenum = 0x8CEA
+	COLOR_ATTACHMENT11                            GLenum = 0x8CEB
+	COLOR_ATTACHMENT12                            GLenum = 0x8CEC
+	COLOR_ATTACHMENT13                            GLenum = 0x8CED
+	COLOR_ATTACHMENT14                            GLenum = 0x8CEE
+	COLOR_ATTACHMENT15                            GLenum = 0x8CEF
+	FRAMEBUFFER_INCOMPLETE_MULTISAMPLE            GLenum = 0x8D56
+	MAX_SAMPLES                                   GLenum = 0x8D57
+	HALF_FLOAT                                    GLenum = 0x140B
+	RG                                            GLenum = 0x8227
+	RG_INTEGER                                    GLenum = 0x8228
+	R8                                            GLenum = 0x8229
+	RG8                                           GLenum = 0x822B
+	R16F                                          GLenum = 0x822D
+	R32F                                          GLenum = 0x822E
+	RG16F                                         GLenum = 0x822F
+	RG32F                                         GLenum = 0x8230
+	R8I                                           GLenum = 0x8231
+	R8UI                                          GLenum = 0x8232
+	R16I                                          GLenum = 0x8233
+	R16UI                                         GLenum = 0x8234
+	R32I                                          GLenum = 0x8235
+	R32UI                                         GLenum = 0x8236
+	RG8I                                          GLenum = 0x8237
+	RG8UI                                         GLenum = 0x8238
+	RG16I                                         GLenum = 0x8239
+	RG16UI                                        GLenum = 0x823A
+	RG32I                                         GLenum = 0x823B
+	RG32UI                                        GLenum = 0x823C
+	VERTEX_ARRAY_BINDING                          GLenum = 0x85B5
+	R8_SNORM                                      GLenum = 0x8F94
+	RG8_SNORM                                     GLenum = 0x8F95
+	RGB8_SNORM                                    GLenum = 0x8F96
+	RGBA8_SNORM                                   GLenum = 0x8F97
+	SIGNED_NORMALIZED                             GLenum = 0x8F9C
+	COPY_READ_BUFFER                              GLenum = 0x8F36
+	COPY_WRITE_BUFFER                             GLenum = 0x8F37
+	COPY_READ_BUFFER_BINDING                      GLenum = 0x8F36
+	COPY_WRITE_BUFFER_BINDING                     GLenum = 0x8F37
+	UNIFORM_BUFFER                                GLenum = 0x8A11
+	UNIFORM_BUFFER_BINDING                        GLenum = 0x8A28
+	UNIFORM_BUFFER_START                          GLenum = 0x8A29
+	UNIFORM_BUFFER_SIZE                           GLenum = 0x8A2A
+	MAX_VERTEX_UNIFORM_BLOCKS                     GLenum = 0x8A2B
+	MAX_FRAGMENT_UNIFORM_BLOCKS                   GLenum = 0x8A2D
+	MAX_COMBINED_UNIFORM_BLOCKS                   GLenum = 0x8A2E
+	MAX_UNIFORM_BUFFER_BINDINGS                   GLenum = 0x8A2F
+	MAX_UNIFORM_BLOCK_SIZE                        GLenum = 0x8A30
+	MAX_COMBINED_VERTEX_UNIFORM_COMPONENTS        GLenum = 0x8A31
+	MAX_COMBINED_FRAGMENT_UNIFORM_COMPONENTS      GLenum = 0x8A33
+	UNIFORM_BUFFER_OFFSET_ALIGNMENT               GLenum = 0x8A34
+	ACTIVE_UNIFORM_BLOCKS                         GLenum = 0x8A36
+	UNIFORM_TYPE                                  GLenum = 0x8A37
+	UNIFORM_SIZE                                  GLenum = 0x8A38
+	UNIFORM_BLOCK_INDEX                           GLenum = 0x8A3A
+	UNIFORM_OFFSET                                GLenum = 0x8A3B
+	UNIFORM_ARRAY_STRIDE                          GLenum = 0x8A3C
+	UNIFORM_MATRIX_STRIDE                         GLenum = 0x8A3D
+	UNIFORM_IS_ROW_MAJOR                          GLenum = 0x8A3E
+	UNIFORM_BLOCK_BINDING                         GLenum = 0x8A3F
+	UNIFORM_BLOCK_DATA_SIZE                       GLenum = 0x8A40
+	UNIFORM_BLOCK_ACTIVE_UNIFORMS                 GLenum = 0x8A42
+	UNIFORM_BLOCK_ACTIVE_UNIFORM_INDICES          GLenum = 0x8A43
+	UNIFORM_BLOCK_REFERENCED_BY_VERTEX_SHADER     GLenum = 0x8A44
+	UNIFORM_BLOCK_REFERENCED_BY_FRAGMENT_SHADER   GLenum = 0x8A46
+	MAX_VERTEX_OUTPUT_COMPONENTS                  GLenum = 0x9122
+	MAX_FRAGMENT_INPUT_COMPONENTS                 GLenum = 0x9125
+	MAX_SERVER_WAIT_TIMEOUT                       GLenum = 0x9111
+	OBJECT_TYPE                                   GLenum = 0x9112
+	SYNC_CONDITION                                GLenum = 0x9113
+	SYNC_STATUS                                   GLenum = 0x9114
+	SYNC_FLAGS                                    GLenum = 0x9115
+	SYNC_FENCE                                    GLenum = 0x9116
+	SYNC_GPU_COMMANDS_COMPLETE                    GLenum = 0x9117
+	UNSIGNALED                                    GLenum = 0x9118
+	SIGNALED                                      GLenum = 0x9119
+	ALREADY_SIGNALED                              GLenum = 0x911A
+	TIMEOUT_EXPIRED                               GLenum = 0x911B
+	CONDITION_SATISFIED                           GLenum = 0x911C
+	WAIT_FAILED                                   GLenum = 0x911D
+	SYNC_FLUSH_COMMANDS_BIT                       GLenum = 0x00000001
+	VERTEX_ATTRIB_ARRAY_DIVISOR                   GLenum = 0x88FE
+	ANY_SAMPLES_PASSED                            GLenum = 0x8C2F
+	ANY_SAMPLES_PASSED_CONSERVATIVE               GLenum = 0x8D6A
+	SAMPLER_BINDING                               GLenum = 0x8919
+	RGB10_A2UI                                    GLenum = 0x906F
+	INT_2_10_10_10_REV                            GLenum = 0x8D9F
+	TRANSFORM_FEEDBACK                            GLenum = 0x8E22
+	TRANSFORM_FEEDBACK_PAUSED                     GLenum = 0x8E23
+	TRANSFORM_FEEDBACK_ACTIVE                     GLenum = 0x8E24
+	TRANSFORM_FEEDBACK_BINDING                    GLenum = 0x8E25
+	TEXTURE_IMMUTABLE_FORMAT                      GLenum = 0x912F
+	MAX_ELEMENT_INDEX                             GLenum = 0x8D6B
+	TEXTURE_IMMUTABLE_LEVELS                      GLenum = 0x82DF
+	MAX_CLIENT_WAIT_TIMEOUT_WEBGL                 GLenum = 0x9247
+)
+
+// Special values used by WebGL 2.0 that are not enums.
+const (
+	// INVALID_INDEX is returned by GetUniformBlockIndex and GetUniformIndices
+	// for names that do not identify an active uniform block or uniform.
+	INVALID_INDEX uint32 = 0xFFFFFFFF
+
+	// TIMEOUT_IGNORED can be passed to WaitSync to wait indefinitely.
+	TIMEOUT_IGNORED = -1
+)
+
+// Extension enums. They are only accepted once the extension has been
+// enabled with GetExtension.
+const (
+	// WEBGL_debug_renderer_info
+	UNMASKED_VENDOR_WEBGL   GLenum = 0x9245
+	UNMASKED_RENDERER_WEBGL GLenum = 0x9246
+
+	// ANGLE_instanced_arrays
+	VERTEX_ATTRIB_ARRAY_DIVISOR_ANGLE GLenum = 0x88FE
+
+	// OES_standard_derivatives
+	FRAGMENT_SHADER_DERIVATIVE_HINT_OES GLenum = 0x8B8B
+
+	// OES_vertex_array_object
+	VERTEX_ARRAY_BINDING_OES GLenum = 0x85B5
+
+	// WEBGL_draw_buffers
+	COLOR_ATTACHMENT0_WEBGL     GLenum = 0x8CE0
+	COLOR_ATTACHMENT1_WEBGL     GLenum = 0x8CE1
+	COLOR_ATTACHMENT2_WEBGL     GLenum = 0x8CE2
+	COLOR_ATTACHMENT3_WEBGL     GLenum = 0x8CE3
+	COLOR_ATTACHMENT4_WEBGL     GLenum = 0x8CE4
+	COLOR_ATTACHMENT5_WEBGL     GLenum = 0x8CE5
+	COLOR_ATTACHMENT6_WEBGL     GLenum = 0x8CE6
+	COLOR_ATTACHMENT7_WEBGL     GLenum = 0x8CE7
+	COLOR_ATTACHMENT8_WEBGL     GLenum = 0x8CE8
+	COLOR_ATTACHMENT9_WEBGL     GLenum = 0x8CE9
+	COLOR_ATTACHMENT10_WEBGL    GLenum = 0x8CEA
+	COLOR_ATTACHMENT11_WEBGL    GLenum = 0x8CEB
+	COLOR_ATTACHMENT12_WEBGL    GLenum = 0x8CEC
+	COLOR_ATTACHMENT13_WEBGL    GLenum = 0x8CED
+	COLOR_ATTACHMENT14_WEBGL    GLenum = 0x8CEE
+	COLOR_ATTACHMENT15_WEBGL    GLenum = 0x8CEF
+	DRAW_BUFFER0_WEBGL          GLenum = 0x8825
+	DRAW_BUFFER1_WEBGL          GLenum = 0x8826
+	DRAW_BUFFER2_WEBGL          GLenum = 0x8827
+	DRAW_BUFFER3_WEBGL          GLenum = 0x8828
+	DRAW_BUFFER4_WEBGL          GLenum = 0x8829
+	DRAW_BUFFER5_WEBGL          GLenum = 0x882A
+	DRAW_BUFFER6_WEBGL          GLenum = 0x882B
+	DRAW_BUFFER7_WEBGL          GLenum = 0x882C
+	DRAW_BUFFER8_WEBGL          GLenum = 0x882D
+	DRAW_BUFFER9_WEBGL          GLenum = 0x882E
+	DRAW_BUFFER10_WEBGL         GLenum = 0x882F
+	DRAW_BUFFER11_WEBGL         GLenum = 0x8830
+	DRAW_BUFFER12_WEBGL         GLenum = 0x8831
+	DRAW_BUFFER13_WEBGL         GLenum = 0x8832
+	DRAW_BUFFER14_WEBGL         GLenum = 0x8833
+	DRAW_BUFFER15_WEBGL         GLenum = 0x8834
+	MAX_COLOR_ATTACHMENTS_WEBGL GLenum = 0x8CDF
+	MAX_DRAW_BUFFERS_WEBGL      GLenum = 0x8824
+)
+
+// String returns the name of the enum. Values shared by several enums,
+// such as 0 for POINTS, ZERO and NONE, return the name that comes first
+// in the specification. Unknown values are formatted in hexadecimal.
+func (e GLenum) String() string {
+	if name, ok := enumNames[e]; ok {
+		return name
+	}
+	return fmt.Sprintf("0x%X", int(e))
+}
+
+// ErrorCode is the type of the values returned by GetError. It has its
+// own type so that 0 is formatted as NO_ERROR rather than POINTS.
+type ErrorCode GLenum
+
+// String returns the name of the error code. Unknown values are formatted
+// in hexadecimal.
+func (e ErrorCode) String() string {
+	if name, ok := errorNames[e]; ok {
+		return name
+	}
+	return fmt.Sprintf("0x%X", int(e))
+}
+
+// Error codes returned by GetError.
+const (
+	NO_ERROR                      ErrorCode = 0
+	INVALID_ENUM                  ErrorCode = 0x0500
+	INVALID_VALUE                 ErrorCode = 0x0501
+	INVALID_OPERATION             ErrorCode = 0x0502
+	OUT_OF_MEMORY                 ErrorCode = 0x0505
+	INVALID_FRAMEBUFFER_OPERATION ErrorCode = 0x0506
+	CONTEXT_LOST_WEBGL            ErrorCode = 0x9242
+)
+
+var errorNames = map[ErrorCode]string{
+	NO_ERROR:                      "NO_ERROR",
+	INVALID_ENUM:                  "INVALID_ENUM",
+	INVALID_VALUE:                 "INVALID_VALUE",
+	INVALID_OPERATION:             "INVALID_OPERATION",
+	OUT_OF_MEMORY:                 "OUT_OF_MEMORY",
+	INVALID_FRAMEBUFFER_OPERATION: "INVALID_FRAMEBUFFER_OPERATION",
+	CONTEXT_LOST_WEBGL:            "CONTEXT_LOST_WEBGL",
+}
+
+var enumNames = map[GLenum]string{
+	DEPTH_BUFFER_BIT:                     "DEPTH_BUFFER_BIT",
+	STENCIL_BUFFER_BIT:                   "STENCIL_BUFFER_BIT",
+	COLOR_BUFFER_BIT:                     "COLOR_BUFFER_BIT",
+	POINTS:                               "POINTS",
+	LINES:                                "LINES",
+	LINE_LOOP:                            "LINE_LOOP",
+	LINE_STRIP:                           "LINE_STRIP",
+	TRIANGLES:                            "TRIANGLES",
+	TRIANGLE_STRIP:                       "TRIANGLE_STRIP",
+	TRIANGLE_FAN:                         "TRIANGLE_FAN",
+	SRC_COLOR:                            "SRC_COLOR",
+	ONE_MINUS_SRC_COLOR:                  "ONE_MINUS_SRC_COLOR",
+	SRC_ALPHA:                            "SRC_ALPHA",
+	ONE_MINUS_SRC_ALPHA:                  "ONE_MINUS_SRC_ALPHA",
+	DST_ALPHA:                            "DST_ALPHA",
+	ONE_MINUS_DST_ALPHA:                  "ONE_MINUS_DST_ALPHA",
+	DST_COLOR:                            "DST_COLOR",
+	ONE_MINUS_DST_COLOR:                  "ONE_MINUS_DST_COLOR",
+	SRC_ALPHA_SATURATE:                   "SRC_ALPHA_SATURATE",
+	FUNC_ADD:                             "FUNC_ADD",
+	BLEND_EQUATION:                       "BLEND_EQUATION",
+	BLEND_EQUATION_ALPHA:                 "BLEND_EQUATION_ALPHA",
+	FUNC_SUBTRACT:                        "FUNC_SUBTRACT",
+	FUNC_REVERSE_SUBTRACT:                "FUNC_REVERSE_SUBTRACT",
+	BLEND_DST_RGB:                        "BLEND_DST_RGB",
+	BLEND_SRC_RGB:                        "BLEND_SRC_RGB",
+	BLEND_DST_ALPHA:                      "BLEND_DST_ALPHA",
+	BLEND_SRC_ALPHA:                      "BLEND_SRC_ALPHA",
+	CONSTANT_COLOR:                       "CONSTANT_COLOR",
+	ONE_MINUS_CONSTANT_COLOR:             "ONE_MINUS_CONSTANT_COLOR",
+	CONSTANT_ALPHA:                       "CONSTANT_ALPHA",
+	ONE_MINUS_CONSTANT_ALPHA:             "ONE_MINUS_CONSTANT_ALPHA",
+	BLEND_COLOR:                          "BLEND_COLOR",
+	ARRAY_BUFFER:                         "ARRAY_BUFFER",
+	ELEMENT_ARRAY_BUFFER:                 "ELEMENT_ARRAY_BUFFER",
+	ARRAY_BUFFER_BINDING:                 "ARRAY_BUFFER_BINDING",
+	ELEMENT_ARRAY_BUFFER_BINDING:         "ELEMENT_ARRAY_BUFFER_BINDING",
+	STREAM_DRAW:                          "STREAM_DRAW",
+	STATIC_DRAW:                          "STATIC_DRAW",
+	DYNAMIC_DRAW:                         "DYNAMIC_DRAW",
+	BUFFER_SIZE:                          "BUFFER_SIZE",
+	BUFFER_USAGE:                         "BUFFER_USAGE",
+	CURRENT_VERTEX_ATTRIB:                "CURRENT_VERTEX_ATTRIB",
+	FRONT:                                "FRONT",
+	BACK:                                 "BACK",
+	FRONT_AND_BACK:                       "FRONT_AND_BACK",
+	CULL_FACE:                            "CULL_FACE",
+	BLEND:                                "BLEND",
+	DITHER:                               "DITHER",
+	STENCIL_TEST:                         "STENCIL_TEST",
+	DEPTH_TEST:                           "DEPTH_TEST",
+	SCISSOR_TEST:                         "SCISSOR_TEST",
+	POLYGON_OFFSET_FILL:                  "POLYGON_OFFSET_FILL",
+	SAMPLE_ALPHA_TO_COVERAGE:             "SAMPLE_ALPHA_TO_COVERAGE",
+	SAMPLE_COVERAGE:                      "SAMPLE_COVERAGE",
+	CW:                                   "CW",
+	CCW:                                  "CCW",
+	LINE_WIDTH:                           "LINE_WIDTH",
+	ALIASED_POINT_SIZE_RANGE:             "ALIASED_POINT_SIZE_RANGE",
+	ALIASED_LINE_WIDTH_RANGE:             "ALIASED_LINE_WIDTH_RANGE",
+	CULL_FACE_MODE:                       "CULL_FACE_MODE",
+	FRONT_FACE:                           "FRONT_FACE",
+	DEPTH_RANGE:                          "DEPTH_RANGE",
+	DEPTH_WRITEMASK:                      "DEPTH_WRITEMASK",
+	DEPTH_CLEAR_VALUE:                    "DEPTH_CLEAR_VALUE",
+	DEPTH_FUNC:                           "DEPTH_FUNC",
+	STENCIL_CLEAR_VALUE:                  "STENCIL_CLEAR_VALUE",
+	STENCIL_FUNC:                         "STENCIL_FUNC",
+	STENCIL_FAIL:                         "STENCIL_FAIL",
+	STENCIL_PASS_DEPTH_FAIL:              "STENCIL_PASS_DEPTH_FAIL",
+	STENCIL_PASS_DEPTH_PASS:              "STENCIL_PASS_DEPTH_PASS",
+	STENCIL_REF:                          "STENCIL_REF",
+	STENCIL_VALUE_MASK:                   "STENCIL_VALUE_MASK",
+	STENCIL_WRITEMASK:                    "STENCIL_WRITEMASK",
+	STENCIL_BACK_FUNC:                    "STENCIL_BACK_FUNC",
+	STENCIL_BACK_FAIL:                    "STENCIL_BACK_FAIL",
+	STENCIL_BACK_PASS_DEPTH_FAIL:         "STENCIL_BACK_PASS_DEPTH_FAIL",
+	STENCIL_BACK_PASS_DEPTH_PASS:         "STENCIL_BACK_PASS_DEPTH_PASS",
+	STENCIL_BACK_REF:                     "STENCIL_BACK_REF",
+	STENCIL_BACK_VALUE_MASK:              "STENCIL_BACK_VALUE_MASK",
+	STENCIL_BACK_WRITEMASK:               "STENCIL_BACK_WRITEMASK",
+	VIEWPORT:                             "VIEWPORT",
+	SCISSOR_BOX:                          "SCISSOR_BOX",
+	COLOR_CLEAR_VALUE:                    "COLOR_CLEAR_VALUE",
+	COLOR_WRITEMASK:                      "COLOR_WRITEMASK",
+	UNPACK_ALIGNMENT:                     "UNPACK_ALIGNMENT",
+	PACK_ALIGNMENT:                       "PACK_ALIGNMENT",
+	MAX_TEXTURE_SIZE:                     "MAX_TEXTURE_SIZE",
+	MAX_VIEWPORT_DIMS:                    "MAX_VIEWPORT_DIMS",
+	SUBPIXEL_BITS:                        "SUBPIXEL_BITS",
+	RED_BITS:                             "RED_BITS",
+	GREEN_BITS:                           "GREEN_BITS",
+	BLUE_BITS:                            "BLUE_BITS",
+	ALPHA_BITS:                           "ALPHA_BITS",
+	DEPTH_BITS:                           "DEPTH_BITS",
+	STENCIL_BITS:                         "STENCIL_BITS",
+	POLYGON_OFFSET_UNITS:                 "POLYGON_OFFSET_UNITS",
+	POLYGON_OFFSET_FACTOR:                "POLYGON_OFFSET_FACTOR",
+	TEXTURE_BINDING_2D:                   "TEXTURE_BINDING_2D",
+	SAMPLE_BUFFERS:                       "SAMPLE_BUFFERS",
+	SAMPLES:                              "SAMPLES",
+	SAMPLE_COVERAGE_VALUE:                "SAMPLE_COVERAGE_VALUE",
+	SAMPLE_COVERAGE_INVERT:               "SAMPLE_COVERAGE_INVERT",
+	NUM_COMPRESSED_TEXTURE_FORMATS:       "NUM_COMPRESSED_TEXTURE_FORMATS",
+	COMPRESSED_TEXTURE_FORMATS:           "COMPRESSED_TEXTURE_FORMATS",
+	DONT_CARE:                            "DONT_CARE",
+	FASTEST:                              "FASTEST",
+	NICEST:                               "NICEST",
+	GENERATE_MIPMAP_HINT:                 "GENERATE_MIPMAP_HINT",
+	BYTE:                                 "BYTE",
+	UNSIGNED_BYTE:                        "UNSIGNED_BYTE",
+	SHORT:                                "SHORT",
+	UNSIGNED_SHORT:                       "UNSIGNED_SHORT",
+	INT:                                  "INT",
+	UNSIGNED_INT:                         "UNSIGNED_INT",
+	FLOAT:                                "FLOAT",
+	DEPTH_COMPONENT:                      "DEPTH_COMPONENT",
+	ALPHA:                                "ALPHA",
+	RGB:                                  "RGB",
+	RGBA:                                 "RGBA",
+	LUMINANCE:                            "LUMINANCE",
+	LUMINANCE_ALPHA:                      "LUMINANCE_ALPHA",
+	UNSIGNED_SHORT_4_4_4_4:               "UNSIGNED_SHORT_4_4_4_4",
+	UNSIGNED_SHORT_5_5_5_1:               "UNSIGNED_SHORT_5_5_5_1",
+	UNSIGNED_SHORT_5_6_5:                 "UNSIGNED_SHORT_5_6_5",
+	FRAGMENT_SHADER:                      "FRAGMENT_SHADER",
+	VERTEX_SHADER:                        "VERTEX_SHADER",
+	MAX_VERTEX_ATTRIBS:                   "MAX_VERTEX_ATTRIBS",
+	MAX_VERTEX_UNIFORM_VECTORS:           "MAX_VERTEX_UNIFORM_VECTORS",
+	MAX_VARYING_VECTORS:                  "MAX_VARYING_VECTORS",
+	MAX_COMBINED_TEXTURE_IMAGE_UNITS:     "MAX_COMBINED_TEXTURE_IMAGE_UNITS",
+	MAX_VERTEX_TEXTURE_IMAGE_UNITS:       "MAX_VERTEX_TEXTURE_IMAGE_UNITS",
+	MAX_TEXTURE_IMAGE_UNITS:              "MAX_TEXTURE_IMAGE_UNITS",
+	MAX_FRAGMENT_UNIFORM_VECTORS:         "MAX_FRAGMENT_UNIFORM_VECTORS",
+	SHADER_TYPE:                          "SHADER_TYPE",
+	DELETE_STATUS:                        "DELETE_STATUS",
+	COMPILE_STATUS:                       "COMPILE_STATUS",
+	LINK_STATUS:                          "LINK_STATUS",
+	VALIDATE_STATUS:                      "VALIDATE_STATUS",
+	INFO_LOG_LENGTH:                      "INFO_LOG_LENGTH",
+	ATTACHED_SHADERS:                     "ATTACHED_SHADERS",
+	ACTIVE_UNIFORMS:                      "ACTIVE_UNIFORMS",
+	SHADER_SOURCE_LENGTH:                 "SHADER_SOURCE_LENGTH",
+	ACTIVE_ATTRIBUTES:                    "ACTIVE_ATTRIBUTES",
+	SHADING_LANGUAGE_VERSION:             "SHADING_LANGUAGE_VERSION",
+	CURRENT_PROGRAM:                      "CURRENT_PROGRAM",
+	SHADER_COMPILER:                      "SHADER_COMPILER",
+	NEVER:                                "NEVER",
+	LESS:                                 "LESS",
+	EQUAL:                                "EQUAL",
+	LEQUAL:                               "LEQUAL",
+	GREATER:                              "GREATER",
+	NOTEQUAL:                             "NOTEQUAL",
+	GEQUAL:                               "GEQUAL",
+	ALWAYS:                               "ALWAYS",
+	KEEP:                                 "KEEP",
+	REPLACE:                              "REPLACE",
+	INCR:                                 "INCR",
+	DECR:                                 "DECR",
+	INVERT:                               "INVERT",
+	INCR_WRAP:                            "INCR_WRAP",
+	DECR_WRAP:                            "DECR_WRAP",
+	VENDOR:                               "VENDOR",
+	RENDERER:                             "RENDERER",
+	VERSION:                              "VERSION",
+	NEAREST:                              "NEAREST",
+	LINEAR:                               "LINEAR",
+	NEAREST_MIPMAP_NEAREST:               "NEAREST_MIPMAP_NEAREST",
+	LINEAR_MIPMAP_NEAREST:                "LINEAR_MIPMAP_NEAREST",
+	NEAREST_MIPMAP_LINEAR:                "NEAREST_MIPMAP_LINEAR",
+	LINEAR_MIPMAP_LINEAR:                 "LINEAR_MIPMAP_LINEAR",
+	TEXTURE_MAG_FILTER:                   "TEXTURE_MAG_FILTER",
+	TEXTURE_MIN_FILTER:                   "TEXTURE_MIN_FILTER",
+	TEXTURE_WRAP_S:                       "TEXTURE_WRAP_S",
+	TEXTURE_WRAP_T:                       "TEXTURE_WRAP_T",
+	TEXTURE_2D:                           "TEXTURE_2D",
+	TEXTURE:                              "TEXTURE",
+	TEXTURE_CUBE_MAP:                     "TEXTURE_CUBE_MAP",
+	TEXTURE_BINDING_CUBE_MAP:             "TEXTURE_BINDING_CUBE_MAP",
+	TEXTURE_CUBE_MAP_POSITIVE_X:          "TEXTURE_CUBE_MAP_POSITIVE_X",
+	TEXTURE_CUBE_MAP_NEGATIVE_X:          "TEXTURE_CUBE_MAP_NEGATIVE_X",
+	TEXTURE_CUBE_MAP_POSITIVE_Y:          "TEXTURE_CUBE_MAP_POSITIVE_Y",
+	TEXTURE_CUBE_MAP_NEGATIVE_Y:          "TEXTURE_CUBE_MAP_NEGATIVE_Y",
+	TEXTURE_CUBE_MAP_POSITIVE_Z:          "TEXTURE_CUBE_MAP_POSITIVE_Z",
+	TEXTURE_CUBE_MAP_NEGATIVE_Z:          "TEXTURE_CUBE_MAP_NEGATIVE_Z",
+	MAX_CUBE_MAP_TEXTURE_SIZE:            "MAX_CUBE_MAP_TEXTURE_SIZE",
+	TEXTURE0:                             "TEXTURE0",
+	TEXTURE1:                             "TEXTURE1",
+	TEXTURE2:                             "TEXTURE2",
+	TEXTURE3:                             "TEXTURE3",
+	TEXTURE4:                             "TEXTURE4",
+	TEXTURE5:                             "TEXTURE5",
+	TEXTURE6:                             "TEXTURE6",
+	TEXTURE7:                             "TEXTURE7",
+	TEXTURE8:                             "TEXTURE8",
+	TEXTURE9:                             "TEXTURE9",
+	TEXTURE10:                            "TEXTURE10",
+	TEXTURE11:                            "TEXTURE11",
+	TEXTURE12:                            "TEXTURE12",
+	TEXTURE13:                            "TEXTURE13",
+	TEXTURE14:                            "TEXTURE14",
+	TEXTURE15:                            "TEXTURE15",
+	TEXTURE16:                            "TEXTURE16",
+	TEXTURE17:                            "TEXTURE17",
+	TEXTURE18:                            "TEXTURE18",
+	TEXTURE19:                            "TEXTURE19",
+	TEXTURE20:                            "TEXTURE20",
+	TEXTURE21:                            "TEXTURE21",
+	TEXTURE22:                            "TEXTURE22",
+	TEXTURE23:                            "TEXTURE23",
+	TEXTURE24:                            "TEXTURE24",
+	TEXTURE25:                            "TEXTURE25",
+	TEXTURE26:                            "TEXTURE26",
+	TEXTURE27:                            "TEXTURE27",
+	TEXTURE28:                            "TEXTURE28",
+	TEXTURE29:                            "TEXTURE29",
+	TEXTURE30:                            "TEXTURE30",
+	TEXTURE31:                            "TEXTURE31",
+	ACTIVE_TEXTURE:                       "ACTIVE_TEXTURE",
+	REPEAT:                               "REPEAT",
+	CLAMP_TO_EDGE:                        "CLAMP_TO_EDGE",
+	MIRRORED_REPEAT:                      "MIRRORED_REPEAT",
+	FLOAT_VEC2:                           "FLOAT_VEC2",
+	FLOAT_VEC3:                           "FLOAT_VEC3",
+	FLOAT_VEC4:                           "FLOAT_VEC4",
+	INT_VEC2:                             "INT_VEC2",
+	INT_VEC3:                             "INT_VEC3",
+	INT_VEC4:                             "INT_VEC4",
+	BOOL:                                 "BOOL",
+	BOOL_VEC2:                            "BOOL_VEC2",
+	BOOL_VEC3:                            "BOOL_VEC3",
+	BOOL_VEC4:                            "BOOL_VEC4",
+	FLOAT_MAT2:                           "FLOAT_MAT2",
+	FLOAT_MAT3:                           "FLOAT_MAT3",
+	FLOAT_MAT4:                           "FLOAT_MAT4",
+	SAMPLER_2D:                           "SAMPLER_2D",
+	SAMPLER_CUBE:                         "SAMPLER_CUBE",
+	VERTEX_ATTRIB_ARRAY_ENABLED:          "VERTEX_ATTRIB_ARRAY_ENABLED",
+	VERTEX_ATTRIB_ARRAY_SIZE:             "VERTEX_ATTRIB_ARRAY_SIZE",
+	VERTEX_ATTRIB_ARRAY_STRIDE:           "VERTEX_ATTRIB_ARRAY_STRIDE",
+	VERTEX_ATTRIB_ARRAY_TYPE:             "VERTEX_ATTRIB_ARRAY_TYPE",
+	VERTEX_ATTRIB_ARRAY_NORMALIZED:       "VERTEX_ATTRIB_ARRAY_NORMALIZED",
+	VERTEX_ATTRIB_ARRAY_POINTER:          "VERTEX_ATTRIB_ARRAY_POINTER",
+	VERTEX_ATTRIB_ARRAY_BUFFER_BINDING:   "VERTEX_ATTRIB_ARRAY_BUFFER_BINDING",
+	IMPLEMENTATION_COLOR_READ_TYPE:       "IMPLEMENTATION_COLOR_READ_TYPE",
+	IMPLEMENTATION_COLOR_READ_FORMAT:     "IMPLEMENTATION_COLOR_READ_FORMAT",
+	LOW_FLOAT:                            "LOW_FLOAT",
+	MEDIUM_FLOAT:                         "MEDIUM_FLOAT",
+	HIGH_FLOAT:                           "HIGH_FLOAT",
+	LOW_INT:                              "LOW_INT",
+	MEDIUM_INT:                           "MEDIUM_INT",
+	HIGH_INT:                             "HIGH_INT",
+	FRAMEBUFFER:                          "FRAMEBUFFER",
+	RENDERBUFFER:                         "RENDERBUFFER",
+	RGBA4:                                "RGBA4",
+	RGB5_A1:                              "RGB5_A1",
+	RGB565:                               "RGB565",
+	DEPTH_COMPONENT16:                    "DEPTH_COMPONENT16",
+	STENCIL_INDEX:                        "STENCIL_INDEX",
+	STENCIL_INDEX8:                       "STENCIL_INDEX8",
+	DEPTH_STENCIL:                        "DEPTH_STENCIL",
+	RENDERBUFFER_WIDTH:                   "RENDERBUFFER_WIDTH",
+	RENDERBUFFER_HEIGHT:                  "RENDERBUFFER_HEIGHT",
+	RENDERBUFFER_INTERNAL_FORMAT:         "RENDERBUFFER_INTERNAL_FORMAT",
+	RENDERBUFFER_RED_SIZE:                "RENDERBUFFER_RED_SIZE",
+	RENDERBUFFER_GREEN_SIZE:              "RENDERBUFFER_GREEN_SIZE",
+	RENDERBUFFER_BLUE_SIZE:               "RENDERBUFFER_BLUE_SIZE",
+	RENDERBUFFER_ALPHA_SIZE:              "RENDERBUFFER_ALPHA_SIZE",
+	RENDERBUFFER_DEPTH_SIZE:              "RENDERBUFFER_DEPTH_SIZE",
+	RENDERBUFFER_STENCIL_SIZE:            "RENDERBUFFER_STENCIL_SIZE",
+	FRAMEBUFFER_ATTACHMENT_OBJECT_TYPE:   "FRAMEBUFFER_ATTACHMENT_OBJECT_TYPE",
+	FRAMEBUFFER_ATTACHMENT_OBJECT_NAME:   "FRAMEBUFFER_ATTACHMENT_OBJECT_NAME",
+	FRAMEBUFFER_ATTACHMENT_TEXTURE_LEVEL: "FRAMEBUFFER_ATTACHMENT_TEXTURE_LEVEL",
+	FRAMEBUFFER_ATTACHMENT_TEXTURE_CUBE_MAP_FACE: "FRAMEBUFFER_ATTACHMENT_TEXTURE_CUBE_MAP_FACE",
+	COLOR_ATTACHMENT0:                         "COLOR_ATTACHMENT0",
+	DEPTH_ATTACHMENT:                          "DEPTH_ATTACHMENT",
+	STENCIL_ATTACHMENT:                        "STENCIL_ATTACHMENT",
+	DEPTH_STENCIL_ATTACHMENT:                  "DEPTH_STENCIL_ATTACHMENT",
+	FRAMEBUFFER_COMPLETE:                      "FRAMEBUFFER_COMPLETE",
+	FRAMEBUFFER_INCOMPLETE_ATTACHMENT:         "FRAMEBUFFER_INCOMPLETE_ATTACHMENT",
+	FRAMEBUFFER_INCOMPLETE_MISSING_ATTACHMENT: "FRAMEBUFFER_INCOMPLETE_MISSING_ATTACHMENT",
+	FRAMEBUFFER_INCOMPLETE_DIMENSIONS:         "FRAMEBUFFER_INCOMPLETE_DIMENSIONS",
+	FRAMEBUFFER_UNSUPPORTED:                   "FRAMEBUFFER_UNSUPPORTED",
+	FRAMEBUFFER_BINDING:                       "FRAMEBUFFER_BINDING",
+	RENDERBUFFER_BINDING:                      "RENDERBUFFER_BINDING",
+	MAX_RENDERBUFFER_SIZE:                     "MAX_RENDERBUFFER_SIZE",
+	UNPACK_FLIP_Y_WEBGL:                       "UNPACK_FLIP_Y_WEBGL",
+	UNPACK_PREMULTIPLY_ALPHA_WEBGL:            "UNPACK_PREMULTIPLY_ALPHA_WEBGL",
+	UNPACK_COLORSPACE_CONVERSION_WEBGL:        "UNPACK_COLORSPACE_CONVERSION_WEBGL",
+	BROWSER_DEFAULT_WEBGL:                     "BROWSER_DEFAULT_WEBGL",
+	READ_BUFFER:                               "READ_BUFFER",
+	UNPACK_ROW_LENGTH:                         "UNPACK_ROW_LENGTH",
+	UNPACK_SKIP_ROWS:                          "UNPACK_SKIP_ROWS",
+	UNPACK_SKIP_PIXELS:                        "UNPACK_SKIP_PIXELS",
+	PACK_ROW_LENGTH:                           "PACK_ROW_LENGTH",
+	PACK_SKIP_ROWS:                            "PACK_SKIP_ROWS",
+	PACK_SKIP_PIXELS:                          "PACK_SKIP_PIXELS",
+	COLOR:                                     "COLOR",
+	DEPTH:                                     "DEPTH",
+	STENCIL:                                   "STENCIL",
+	RED:                                       "RED",
+	RGB8:                                      "RGB8",
+	RGBA8:                                     "RGBA8",
+	RGB10_A2:                                  "RGB10_A2",
+	TEXTURE_BINDING_3D:                        "TEXTURE_BINDING_3D",
+	UNPACK_SKIP_IMAGES:                        "UNPACK_SKIP_IMAGES",
+	UNPACK_IMAGE_HEIGHT:                       "UNPACK_IMAGE_HEIGHT",
+	TEXTURE_3D:                                "TEXTURE_3D",
+	TEXTURE_WRAP_R:                            "TEXTURE_WRAP_R",
+	MAX_3D_TEXTURE_SIZE:                       "MAX_3D_TEXTURE_SIZE",
+	UNSIGNED_INT_2_10_10_10_REV:               "UNSIGNED_INT_2_10_10_10_REV",
+	MAX_ELEMENTS_VERTICES:                     "MAX_ELEMENTS_VERTICES",
+	MAX_ELEMENTS_INDICES:                      "MAX_ELEMENTS_INDICES",
+	TEXTURE_MIN_LOD:                           "TEXTURE_MIN_LOD",
+	TEXTURE_MAX_LOD:                           "TEXTURE_MAX_LOD",
+	TEXTURE_BASE_LEVEL:                        "TEXTURE_BASE_LEVEL",
+	TEXTURE_MAX_LEVEL:                         "TEXTURE_MAX_LEVEL",
+	MIN:                                       "MIN",
+	MAX:                                       "MAX",
+	DEPTH_COMPONENT24:                         "DEPTH_COMPONENT24",
+	MAX_TEXTURE_LOD_BIAS:                      "MAX_TEXTURE_LOD_BIAS",
+	TEXTURE_COMPARE_MODE:                      "TEXTURE_COMPARE_MODE",
+	TEXTURE_COMPARE_FUNC:                      "TEXTURE_COMPARE_FUNC",
+	CURRENT_QUERY:                             "CURRENT_QUERY",
+	QUERY_RESULT:                              "QUERY_RESULT",
+	QUERY_RESULT_AVAILABLE:                    "QUERY_RESULT_AVAILABLE",
+	STREAM_READ:                               "STREAM_READ",
+	STREAM_COPY:                               "STREAM_COPY",
+	STATIC_READ:                               "STATIC_READ",
+	STATIC_COPY:                               "STATIC_COPY",
+	DYNAMIC_READ:                              "DYNAMIC_READ",
+	DYNAMIC_COPY:                              "DYNAMIC_COPY",
+	MAX_DRAW_BUFFERS:                          "MAX_DRAW_BUFFERS",
+	DRAW_BUFFER0:                              "DRAW_BUFFER0",
+	DRAW_BUFFER1:                              "DRAW_BUFFER1",
+	DRAW_BUFFER2:                              "DRAW_BUFFER2",
+	DRAW_BUFFER3:                              "DRAW_BUFFER3",
+	DRAW_BUFFER4:                              "DRAW_BUFFER4",
+	DRAW_BUFFER5:                              "DRAW_BUFFER5",
+	DRAW_BUFFER6:                              "DRAW_BUFFER6",
+	DRAW_BUFFER7:                              "DRAW_BUFFER7",
+	DRAW_BUFFER8:                              "DRAW_BUFFER8",
+	DRAW_BUFFER9:                              "DRAW_BUFFER9",
+	DRAW_BUFFER10:                             "DRAW_BUFFER10",
+	DRAW_BUFFER11:                             "DRAW_BUFFER11",
+	DRAW_BUFFER12:                             "DRAW_BUFFER12",
+	DRAW_BUFFER13:                             "DRAW_BUFFER13",
+	DRAW_BUFFER14:                             "DRAW_BUFFER14",
+	DRAW_BUFFER15:                             "DRAW_BUFFER15",
+	MAX_FRAGMENT_UNIFORM_COMPONENTS:           "MAX_FRAGMENT_UNIFORM_COMPONENTS",
+	MAX_VERTEX_UNIFORM_COMPONENTS:             "MAX_VERTEX_UNIFORM_COMPONENTS",
+	SAMPLER_3D:                                "SAMPLER_3D",
+	SAMPLER_2D_SHADOW:                         "SAMPLER_2D_SHADOW",
+	FRAGMENT_SHADER_DERIVATIVE_HINT:           "FRAGMENT_SHADER_DERIVATIVE_HINT",
+	PIXEL_PACK_BUFFER:                         "PIXEL_PACK_BUFFER",
+	PIXEL_UNPACK_BUFFER:                       "PIXEL_UNPACK_BUFFER",
+	PIXEL_PACK_BUFFER_BINDING:                 "PIXEL_PACK_BUFFER_BINDING",
+	PIXEL_UNPACK_BUFFER_BINDING:               "PIXEL_UNPACK_BUFFER_BINDING",
+	FLOAT_MAT2x3:                              "FLOAT_MAT2x3",
+	FLOAT_MAT2x4:                              "FLOAT_MAT2x4",
+	FLOAT_MAT3x2:                              "FLOAT_MAT3x2",
+	FLOAT_MAT3x4:                              "FLOAT_MAT3x4",
+	FLOAT_MAT4x2:                              "FLOAT_MAT4x2",
+	FLOAT_MAT4x3:                              "FLOAT_MAT4x3",
+	SRGB:                                      "SRGB",
+	SRGB8:                                     "SRGB8",
+	SRGB8_ALPHA8:                              "SRGB8_ALPHA8",
+	COMPARE_REF_TO_TEXTURE:                    "COMPARE_REF_TO_TEXTURE",
+	RGBA32F:                                   "RGBA32F",
+	RGB32F:                                    "RGB32F",
+	RGBA16F:                                   "RGBA16F",
+	RGB16F:                                    "RGB16F",
+	VERTEX_ATTRIB_ARRAY_INTEGER:               "VERTEX_ATTRIB_ARRAY_INTEGER",
+	MAX_ARRAY_TEXTURE_LAYERS:                  "MAX_ARRAY_TEXTURE_LAYERS",
+	MIN_PROGRAM_TEXEL_OFFSET:                  "MIN_PROGRAM_TEXEL_OFFSET",
+	MAX_PROGRAM_TEXEL_OFFSET:                  "MAX_PROGRAM_TEXEL_OFFSET",
+	MAX_VARYING_COMPONENTS:                    "MAX_VARYING_COMPONENTS",
+	TEXTURE_2D_ARRAY:                          "TEXTURE_2D_ARRAY",
+	TEXTURE_BINDING_2D_ARRAY:                  "TEXTURE_BINDING_2D_ARRAY",
+	R11F_G11F_B10F:                            "R11F_G11F_B10F",
+	UNSIGNED_INT_10F_11F_11F_REV:              "UNSIGNED_INT_10F_11F_11F_REV",
+	RGB9_E5:                                   "RGB9_E5",
+	UNSIGNED_INT_5_9_9_9_REV:                  "UNSIGNED_INT_5_9_9_9_REV",
+	TRANSFORM_FEEDBACK_BUFFER_MODE:            "TRANSFORM_FEEDBACK_BUFFER_MODE",
+	MAX_TRANSFORM_FEEDBACK_SEPARATE_COMPONENTS:    "MAX_TRANSFORM_FEEDBACK_SEPARATE_COMPONENTS",
+	TRANSFORM_FEEDBACK_VARYINGS:                   "TRANSFORM_FEEDBACK_VARYINGS",
+	TRANSFORM_FEEDBACK_BUFFER_START:               "TRANSFORM_FEEDBACK_BUFFER_START",
+	TRANSFORM_FEEDBACK_BUFFER_SIZE:                "TRANSFORM_FEEDBACK_BUFFER_SIZE",
+	TRANSFORM_FEEDBACK_PRIMITIVES_WRITTEN:         "TRANSFORM_FEEDBACK_PRIMITIVES_WRITTEN",
+	RASTERIZER_DISCARD:                            "RASTERIZER_DISCARD",
+	MAX_TRANSFORM_FEEDBACK_INTERLEAVED_COMPONENTS: "MAX_TRANSFORM_FEEDBACK_INTERLEAVED_COMPONENTS",
+	MAX_TRANSFORM_FEEDBACK_SEPARATE_ATTRIBS:       "MAX_TRANSFORM_FEEDBACK_SEPARATE_ATTRIBS",
+	INTERLEAVED_ATTRIBS:                           "INTERLEAVED_ATTRIBS",
+	SEPARATE_ATTRIBS:                              "SEPARATE_ATTRIBS",
+	TRANSFORM_FEEDBACK_BUFFER:                     "TRANSFORM_FEEDBACK_BUFFER",
+	TRANSFORM_FEEDBACK_BUFFER_BINDING:             "TRANSFORM_FEEDBACK_BUFFER_BINDING",
+	RGBA32UI:                                      "RGBA32UI",
+	RGB32UI:                                       "RGB32UI",
+	RGBA16UI:                                      "RGBA16UI",
+	RGB16UI:                                       "RGB16UI",
+	RGBA8UI:                                       "RGBA8UI",
+	RGB8UI:                                        "RGB8UI",
+	RGBA32I:                                       "RGBA32I",
+	RGB32I:                                        "RGB32I",
+	RGBA16I:                                       "RGBA16I",
+	RGB16I:                                        "RGB16I",
+	RGBA8I:                                        "RGBA8I",
+	RGB8I:                                         "RGB8I",
+	RED_INTEGER:                                   "RED_INTEGER",
+	RGB_INTEGER:                                   "RGB_INTEGER",
+	RGBA_INTEGER:                                  "RGBA_INTEGER",
+	SAMPLER_2D_ARRAY:                              "SAMPLER_2D_ARRAY",
+	SAMPLER_2D_ARRAY_SHADOW:                       "SAMPLER_2D_ARRAY_SHADOW",
+	SAMPLER_CUBE_SHADOW:                           "SAMPLER_CUBE_SHADOW",
+	UNSIGNED_INT_VEC2:                             "UNSIGNED_INT_VEC2",
+	UNSIGNED_INT_VEC3:                             "UNSIGNED_INT_VEC3",
+	UNSIGNED_INT_VEC4:                             "UNSIGNED_INT_VEC4",
+	INT_SAMPLER_2D:                                "INT_SAMPLER_2D",
+	INT_SAMPLER_3D:                                "INT_SAMPLER_3D",
+	INT_SAMPLER_CUBE:                              "INT_SAMPLER_CUBE",
+	INT_SAMPLER_2D_ARRAY:                          "INT_SAMPLER_2D_ARRAY",
+	UNSIGNED_INT_SAMPLER_2D:                       "UNSIGNED_INT_SAMPLER_2D",
+	UNSIGNED_INT_SAMPLER_3D:                       "UNSIGNED_INT_SAMPLER_3D",
+	UNSIGNED_INT_SAMPLER_CUBE:                     "UNSIGNED_INT_SAMPLER_CUBE",
+	UNSIGNED_INT_SAMPLER_2D_ARRAY:                 "UNSIGNED_INT_SAMPLER_2D_ARRAY",
+	DEPTH_COMPONENT32F:                            "DEPTH_COMPONENT32F",
+	DEPTH32F_STENCIL8:                             "DEPTH32F_STENCIL8",
+	FLOAT_32_UNSIGNED_INT_24_8_REV:                "FLOAT_32_UNSIGNED_INT_24_8_REV",
+	FRAMEBUFFER_ATTACHMENT_COLOR_ENCODING:         "FRAMEBUFFER_ATTACHMENT_COLOR_ENCODING",
+	FRAMEBUFFER_ATTACHMENT_COMPONENT_TYPE:         "FRAMEBUFFER_ATTACHMENT_COMPONENT_TYPE",
+	FRAMEBUFFER_ATTACHMENT_RED_SIZE:               "FRAMEBUFFER_ATTACHMENT_RED_SIZE",
+	FRAMEBUFFER_ATTACHMENT_GREEN_SIZE:             "FRAMEBUFFER_ATTACHMENT_GREEN_SIZE",
+	FRAMEBUFFER_ATTACHMENT_BLUE_SIZE:              "FRAMEBUFFER_ATTACHMENT_BLUE_SIZE",
+	FRAMEBUFFER_ATTACHMENT_ALPHA_SIZE:             "FRAMEBUFFER_ATTACHMENT_ALPHA_SIZE",
+	FRAMEBUFFER_ATTACHMENT_DEPTH_SIZE:             "FRAMEBUFFER_ATTACHMENT_DEPTH_SIZE",
+	FRAMEBUFFER_ATTACHMENT_STENCIL_SIZE:           "FRAMEBUFFER_ATTACHMENT_STENCIL_SIZE",
+	FRAMEBUFFER_DEFAULT:                           "FRAMEBUFFER_DEFAULT",
+	UNSIGNED_INT_24_8:                             "UNSIGNED_INT_24_8",
+	DEPTH24_STENCIL8:                              "DEPTH24_STENCIL8",
+	UNSIGNED_NORMALIZED:                           "UNSIGNED_NORMALIZED",
+	READ_FRAMEBUFFER:                              "READ_FRAMEBUFFER",
+	DRAW_FRAMEBUFFER:                              "DRAW_FRAMEBUFFER",
+	READ_FRAMEBUFFER_BINDING:                      "READ_FRAMEBUFFER_BINDING",
+	RENDERBUFFER_SAMPLES:                          "RENDERBUFFER_SAMPLES",
+	FRAMEBUFFER_ATTACHMENT_TEXTURE_LAYER:          "FRAMEBUFFER_ATTACHMENT_TEXTURE_LAYER",
+	MAX_COLOR_ATTACHMENTS:                         "MAX_COLOR_ATTACHMENTS",
+	COLOR_ATTACHMENT1:                             "COLOR_ATTACHMENT1",
+	COLOR_ATTACHMENT2:                             "COLOR_ATTACHMENT2",
+	COLOR_ATTACHMENT3:                             "COLOR_ATTACHMENT3",
+	COLOR_ATTACHMENT4:                             "COLOR_ATTACHMENT4",
+	COLOR_ATTACHMENT5:                             "COLOR_ATTACHMENT5",
+	COLOR_ATTACHMENT6:                             "COLOR_ATTACHMENT6",
+	COLOR_ATTACHMENT7:                             "COLOR_ATTACHMENT7",
+	COLOR_ATTACHMENT8:                             "COLOR_ATTACHMENT8",
+	COLOR_ATTACHMENT9:                             "COLOR_ATTACHMENT9",
+	COLOR_ATTACHMENT10:                            "COLOR_ATTACHMENT10",
+	COLOR_ATTACHMENT11:                            "COLOR_ATTACHMENT11",
+	COLOR_ATTACHMENT12:                            "COLOR_ATTACHMENT12",
+	COLOR_ATTACHMENT13:                            "COLOR_ATTACHMENT13",
+	COLOR_ATTACHMENT14:                            "COLOR_ATTACHMENT14",
+	COLOR_ATTACHMENT15:                            "COLOR_ATTACHMENT15",
+	FRAMEBUFFER_INCOMPLETE_MULTISAMPLE:            "FRAMEBUFFER_INCOMPLETE_MULTISAMPLE",
+	MAX_SAMPLES:                                   "MAX_SAMPLES",
+	HALF_FLOAT:                                    "HALF_FLOAT",
+	RG:                                            "RG",
+	RG_INTEGER:                                    "RG_INTEGER",
+	R8:                                            "R8",
+	RG8:                                           "RG8",
+	R16F:                                          "R16F",
+	R32F:                                          "R32F",
+	RG16F:                                         "RG16F",
+	RG32F:                                         "RG32F",
+	R8I:                                           "R8I",
+	R8UI:                                          "R8UI",
+	R16I:                                          "R16I",
+	R16UI:                                         "R16UI",
+	R32I:                                          "R32I",
+	R32UI:                                         "R32UI",
+	RG8I:                                          "RG8I",
+	RG8UI:                                         "RG8UI",
+	RG16I:                                         "RG16I",
+	RG16UI:                                        "RG16UI",
+	RG32I:                                         "RG32I",
+	RG32UI:                                        "RG32UI",
+	VERTEX_ARRAY_BINDING:                          "VERTEX_ARRAY_BINDING",
+	R8_SNORM:                                      "R8_SNORM",
+	RG8_SNORM:                                     "RG8_SNORM",
+	RGB8_SNORM:                                    "RGB8_SNORM",
+	RGBA8_SNORM:                                   "RGBA8_SNORM",
+	SIGNED_NORMALIZED:                             "SIGNED_NORMALIZED",
+	COPY_READ_BUFFER:                              "COPY_READ_BUFFER",
+	COPY_WRITE_BUFFER:                             "COPY_WRITE_BUFFER",
+	UNIFORM_BUFFER:                                "UNIFORM_BUFFER",
+	UNIFORM_BUFFER_BINDING:                        "UNIFORM_BUFFER_BINDING",
+	UNIFORM_BUFFER_START:                          "UNIFORM_BUFFER_START",
+	UNIFORM_BUFFER_SIZE:                           "UNIFORM_BUFFER_SIZE",
+	MAX_VERTEX_UNIFORM_BLOCKS:                     "MAX_VERTEX_UNIFORM_BLOCKS",
+	MAX_FRAGMENT_UNIFORM_BLOCKS:                   "MAX_FRAGMENT_UNIFORM_BLOCKS",
+	MAX_COMBINED_UNIFORM_BLOCKS:                   "MAX_COMBINED_UNIFORM_BLOCKS",
+	MAX_UNIFORM_BUFFER_BINDINGS:                   "MAX_UNIFORM_BUFFER_BINDINGS",
+	MAX_UNIFORM_BLOCK_SIZE:                        "MAX_UNIFORM_BLOCK_SIZE",
+	MAX_COMBINED_VERTEX_UNIFORM_COMPONENTS:        "MAX_COMBINED_VERTEX_UNIFORM_COMPONENTS",
+	MAX_COMBINED_FRAGMENT_UNIFORM_COMPONENTS:      "MAX_COMBINED_FRAGMENT_UNIFORM_COMPONENTS",
+	UNIFORM_BUFFER_OFFSET_ALIGNMENT:               "UNIFORM_BUFFER_OFFSET_ALIGNMENT",
+	ACTIVE_UNIFORM_BLOCKS:                         "ACTIVE_UNIFORM_BLOCKS",
+	UNIFORM_TYPE:                                  "UNIFORM_TYPE",
+	UNIFORM_SIZE:                                  "UNIFORM_SIZE",
+	UNIFORM_BLOCK_INDEX:                           "UNIFORM_BLOCK_INDEX",
+	UNIFORM_OFFSET:                                "UNIFORM_OFFSET",
+	UNIFORM_ARRAY_STRIDE:                          "UNIFORM_ARRAY_STRIDE",
+	UNIFORM_MATRIX_STRIDE:                         "UNIFORM_MATRIX_STRIDE",
+	UNIFORM_IS_ROW_MAJOR:                          "UNIFORM_IS_ROW_MAJOR",
+	UNIFORM_BLOCK_BINDING:                         "UNIFORM_BLOCK_BINDING",
+	UNIFORM_BLOCK_DATA_SIZE:                       "UNIFORM_BLOCK_DATA_SIZE",
+	UNIFORM_BLOCK_ACTIVE_UNIFORMS:                 "UNIFORM_BLOCK_ACTIVE_UNIFORMS",
+	UNIFORM_BLOCK_ACTIVE_UNIFORM_INDICES:          "UNIFORM_BLOCK_ACTIVE_UNIFORM_INDICES",
+	UNIFORM_BLOCK_REFERENCED_BY_VERTEX_SHADER:   "UNIFORM_BLOCK_REFERENCED_BY_VERTEX_SHADER",
+	UNIFORM_BLOCK_REFERENCED_BY_FRAGMENT_SHADER: "UNIFORM_BLOCK_REFERENCED_BY_FRAGMENT_SHADER",
+	MAX_VERTEX_OUTPUT_COMPONENTS:                "MAX_VERTEX_OUTPUT_COMPONENTS",
+	MAX_FRAGMENT_INPUT_COMPONENTS:               "MAX_FRAGMENT_INPUT_COMPONENTS",
+	MAX_SERVER_WAIT_TIMEOUT:                     "MAX_SERVER_WAIT_TIMEOUT",
+	OBJECT_TYPE:                                 "OBJECT_TYPE",
+	SYNC_CONDITION:                              "SYNC_CONDITION",
+	SYNC_STATUS:                                 "SYNC_STATUS",
+	SYNC_FLAGS:                                  "SYNC_FLAGS",
+	SYNC_FENCE:                                  "SYNC_FENCE",
+	SYNC_GPU_COMMANDS_COMPLETE:                  "SYNC_GPU_COMMANDS_COMPLETE",
+	UNSIGNALED:                                  "UNSIGNALED",
+	SIGNALED:                                    "SIGNALED",
+	ALREADY_SIGNALED:                            "ALREADY_SIGNALED",
+	TIMEOUT_EXPIRED:                             "TIMEOUT_EXPIRED",
+	CONDITION_SATISFIED:                         "CONDITION_SATISFIED",
+	WAIT_FAILED:                                 "WAIT_FAILED",
+	VERTEX_ATTRIB_ARRAY_DIVISOR:                 "VERTEX_ATTRIB_ARRAY_DIVISOR",
+	ANY_SAMPLES_PASSED:                          "ANY_SAMPLES_PASSED",
+	ANY_SAMPLES_PASSED_CONSERVATIVE:             "ANY_SAMPLES_PASSED_CONSERVATIVE",
+	SAMPLER_BINDING:                             "SAMPLER_BINDING",
+	RGB10_A2UI:                                  "RGB10_A2UI",
+	INT_2_10_10_10_REV:                          "INT_2_10_10_10_REV",
+	TRANSFORM_FEEDBACK:                          "TRANSFORM_FEEDBACK",
+	TRANSFORM_FEEDBACK_PAUSED:                   "TRANSFORM_FEEDBACK_PAUSED",
+	TRANSFORM_FEEDBACK_ACTIVE:                   "TRANSFORM_FEEDBACK_ACTIVE",
+	TRANSFORM_FEEDBACK_BINDING:                  "TRANSFORM_FEEDBACK_BINDING",
+	TEXTURE_IMMUTABLE_FORMAT:                    "TEXTURE_IMMUTABLE_FORMAT",
+	MAX_ELEMENT_INDEX:                           "MAX_ELEMENT_INDEX",
+	TEXTURE_IMMUTABLE_LEVELS:                    "TEXTURE_IMMUTABLE_LEVELS",
+	MAX_CLIENT_WAIT_TIMEOUT_WEBGL:               "MAX_CLIENT_WAIT_TIMEOUT_WEBGL",
+	UNMASKED_VENDOR_WEBGL:                       "UNMASKED_VENDOR_WEBGL",
+	UNMASKED_RENDERER_WEBGL:                     "UNMASKED_RENDERER_WEBGL",
+}
+
+// enums holds the WebGL 1.0 enums that are exposed as fields of Context.
+type enums struct {
+	ACTIVE_ATTRIBUTES                            GLenum
+	ACTIVE_TEXTURE                               GLenum
+	ACTIVE_UNIFORMS                              GLenum
+	ALIASED_LINE_WIDTH_RANGE                     GLenum
+	ALIASED_POINT_SIZE_RANGE                     GLenum
+	ALPHA                                        GLenum
+	ALPHA_BITS                                   GLenum
+	ALWAYS                                       GLenum
+	ARRAY_BUFFER                                 GLenum
+	ARRAY_BUFFER_BINDING                         GLenum
+	ATTACHED_SHADERS                             GLenum
+	BACK                                         GLenum
+	BLEND                                        GLenum
+	BLEND_COLOR                                  GLenum
+	BLEND_DST_ALPHA                              GLenum
+	BLEND_DST_RGB                                GLenum
+	BLEND_EQUATION                               GLenum
+	BLEND_EQUATION_ALPHA                         GLenum
+	BLEND_EQUATION_RGB                           GLenum
+	BLEND_SRC_ALPHA                              GLenum
+	BLEND_SRC_RGB                                GLenum
+	BLUE_BITS                                    GLenum
+	BOOL                                         GLenum
+	BOOL_VEC2                                    GLenum
+	BOOL_VEC3                                    GLenum
+	BOOL_VEC4                                    GLenum
+	BROWSER_DEFAULT_WEBGL                        GLenum
+	BUFFER_SIZE                                  GLenum
+	BUFFER_USAGE                                 GLenum
+	BYTE                                         GLenum
+	CCW                                          GLenum
+	CLAMP_TO_EDGE                                GLenum
+	COLOR_ATTACHMENT0                            GLenum
+	COLOR_BUFFER_BIT                             GLenum
+	COLOR_CLEAR_VALUE                            GLenum
+	COLOR_WRITEMASK                              GLenum
+	COMPILE_STATUS                               GLenum
+	COMPRESSED_TEXTURE_FORMATS                   GLenum
+	CONSTANT_ALPHA                               GLenum
+	CONSTANT_COLOR                               GLenum
+	CONTEXT_LOST_WEBGL                           ErrorCode
+	CULL_FACE                                    GLenum
+	CULL_FACE_MODE                               GLenum
+	CURRENT_PROGRAM                              GLenum
+	CURRENT_VERTEX_ATTRIB                        GLenum
+	CW                                           GLenum
+	DECR                                         GLenum
+	DECR_WRAP                                    GLenum
+	DELETE_STATUS                                GLenum
+	DEPTH_ATTACHMENT                             GLenum
+	DEPTH_BITS                                   GLenum
+	DEPTH_BUFFER_BIT                             GLenum
+	DEPTH_CLEAR_VALUE                            GLenum
+	DEPTH_COMPONENT                              GLenum
+	DEPTH_COMPONENT16                            GLenum
+	DEPTH_FUNC                                   GLenum
+	DEPTH_RANGE                                  GLenum
+	DEPTH_STENCIL                                GLenum
+	DEPTH_STENCIL_ATTACHMENT                     GLenum
+	DEPTH_TEST                                   GLenum
+	DEPTH_WRITEMASK                              GLenum
+	DITHER                                       GLenum
+	DONT_CARE                                    GLenum
+	DST_ALPHA                                    GLenum
+	DST_COLOR                                    GLenum
+	DYNAMIC_DRAW                                 GLenum
+	ELEMENT_ARRAY_BUFFER                         GLenum
+	ELEMENT_ARRAY_BUFFER_BINDING                 GLenum
+	EQUAL                                        GLenum
+	FASTEST                                      GLenum
+	FLOAT                                        GLenum
+	FLOAT_MAT2                                   GLenum
+	FLOAT_MAT3                                   GLenum
+	FLOAT_MAT4                                   GLenum
+	FLOAT_VEC2                                   GLenum
+	FLOAT_VEC3                                   GLenum
+	FLOAT_VEC4                                   GLenum
+	FRAGMENT_SHADER                              GLenum
+	FRAMEBUFFER                                  GLenum
+	FRAMEBUFFER_ATTACHMENT_OBJECT_NAME           GLenum
+	FRAMEBUFFER_ATTACHMENT_OBJECT_TYPE           GLenum
+	FRAMEBUFFER_ATTACHMENT_TEXTURE_CUBE_MAP_FACE GLenum
+	FRAMEBUFFER_ATTACHMENT_TEXTURE_LEVEL         GLenum
+	FRAMEBUFFER_BINDING                          GLenum
+	FRAMEBUFFER_COMPLETE                         GLenum
+	FRAMEBUFFER_INCOMPLETE_ATTACHMENT            GLenum
+	FRAMEBUFFER_INCOMPLETE_DIMENSIONS            GLenum
+	FRAMEBUFFER_INCOMPLETE_MISSING_ATTACHMENT    GLenum
+	FRAMEBUFFER_UNSUPPORTED                      GLenum
+	FRONT                                        GLenum
+	FRONT_AND_BACK                               GLenum
+	FRONT_FACE                                   GLenum
+	FUNC_ADD                                     GLenum
+	FUNC_REVERSE_SUBTRACT                        GLenum
+	FUNC_SUBTRACT                                GLenum
+	GENERATE_MIPMAP_HINT                         GLenum
+	GEQUAL                                       GLenum
+	GREATER                                      GLenum
+	GREEN_BITS                                   GLenum
+	HIGH_FLOAT                                   GLenum
+	HIGH_INT                                     GLenum
+	IMPLEMENTATION_COLOR_READ_FORMAT             GLenum
+	IMPLEMENTATION_COLOR_READ_TYPE               GLenum
+	INCR                                         GLenum
+	INCR_WRAP                                    GLenum
+	INFO_LOG_LENGTH                              GLenum
+	INT                                          GLenum
+	INT_VEC2                                     GLenum
+	INT_VEC3                                     GLenum
+	INT_VEC4                                     GLenum
+	INVALID_ENUM                                 ErrorCode
+	INVALID_FRAMEBUFFER_OPERATION                ErrorCode
+	INVALID_OPERATION                            ErrorCode
+	INVALID_VALUE                                ErrorCode
+	INVERT                                       GLenum
+	KEEP                                         GLenum
+	LEQUAL                                       GLenum
+	LESS                                         GLenum
+	LINEAR                                       GLenum
+	LINEAR_MIPMAP_LINEAR                         GLenum
+	LINEAR_MIPMAP_NEAREST                        GLenum
+	LINES                                        GLenum
+	LINE_LOOP                                    GLenum
+	LINE_STRIP                                   GLenum
+	LINE_WIDTH                                   GLenum
+	LINK_STATUS                                  GLenum
+	LOW_FLOAT                                    GLenum
+	LOW_INT                                      GLenum
+	LUMINANCE                                    GLenum
+	LUMINANCE_ALPHA                              GLenum
+	MAX_COMBINED_TEXTURE_IMAGE_UNITS             GLenum
+	MAX_CUBE_MAP_TEXTURE_SIZE                    GLenum
+	MAX_FRAGMENT_UNIFORM_VECTORS                 GLenum
+	MAX_RENDERBUFFER_SIZE                        GLenum
+	MAX_TEXTURE_IMAGE_UNITS                      GLenum
+	MAX_TEXTURE_SIZE                             GLenum
+	MAX_VARYING_VECTORS                          GLenum
+	MAX_VERTEX_ATTRIBS                           GLenum
+	MAX_VERTEX_TEXTURE_IMAGE_UNITS               GLenum
+	MAX_VERTEX_UNIFORM_VECTORS                   GLenum
+	MAX_VIEWPORT_DIMS                            GLenum
+	MEDIUM_FLOAT                                 GLenum
+	MEDIUM_INT                                   GLenum
+	MIRRORED_REPEAT                              GLenum
+	NEAREST                                      GLenum
+	NEAREST_MIPMAP_LINEAR                        GLenum
+	NEAREST_MIPMAP_NEAREST                       GLenum
+	NEVER                                        GLenum
+	NICEST                                       GLenum
+	NONE                                         GLenum
+	NOTEQUAL                                     GLenum
+	NO_ERROR                                     ErrorCode
+	NUM_COMPRESSED_TEXTURE_FORMATS               GLenum
+	ONE                                          GLenum
+	ONE_MINUS_CONSTANT_ALPHA                     GLenum
+	ONE_MINUS_CONSTANT_COLOR                     GLenum
+	ONE_MINUS_DST_ALPHA                          GLenum
+	ONE_MINUS_DST_COLOR                          GLenum
+	ONE_MINUS_SRC_ALPHA                          GLenum
+	ONE_MINUS_SRC_COLOR                          GLenum
+	OUT_OF_MEMORY                                ErrorCode
+	PACK_ALIGNMENT                               GLenum
+	POINTS                                       GLenum
+	POLYGON_OFFSET_FACTOR                        GLenum
+	POLYGON_OFFSET_FILL                          GLenum
+	POLYGON_OFFSET_UNITS                         GLenum
+	RED_BITS                                     GLenum
+	RENDERBUFFER                                 GLenum
+	RENDERBUFFER_ALPHA_SIZE                      GLenum
+	RENDERBUFFER_BINDING                         GLenum
+	RENDERBUFFER_BLUE_SIZE                       GLenum
+	RENDERBUFFER_DEPTH_SIZE                      GLenum
+	RENDERBUFFER_GREEN_SIZE                      GLenum
+	RENDERBUFFER_HEIGHT                          GLenum
+	RENDERBUFFER_INTERNAL_FORMAT                 GLenum
+	RENDERBUFFER_RED_SIZE                        GLenum
+	RENDERBUFFER_STENCIL_SIZE                    GLenum
+	RENDERBUFFER_WIDTH                           GLenum
+	RENDERER                                     GLenum
+	REPEAT                                       GLenum
+	REPLACE                                      GLenum
+	RGB                                          GLenum
+	RGB565                                       GLenum
+	RGB5_A1                                      GLenum
+	RGBA                                         GLenum
+	RGBA4                                        GLenum
+	SAMPLER_2D                                   GLenum
+	SAMPLER_CUBE                                 GLenum
+	SAMPLES                                      GLenum
+	SAMPLE_ALPHA_TO_COVERAGE                     GLenum
+	SAMPLE_BUFFERS                               GLenum
+	SAMPLE_COVERAGE                              GLenum
+	SAMPLE_COVERAGE_INVERT                       GLenum
+	SAMPLE_COVERAGE_VALUE                        GLenum
+	SCISSOR_BOX                                  GLenum
+	SCISSOR_TEST                                 GLenum
+	SHADER_COMPILER                              GLenum
+	SHADER_SOURCE_LENGTH                         GLenum
+	SHADER_TYPE                                  GLenum
+	SHADING_LANGUAGE_VERSION                     GLenum
+	SHORT                                        GLenum
+	SRC_ALPHA                                    GLenum
+	SRC_ALPHA_SATURATE                           GLenum
+	SRC_COLOR                                    GLenum
+	STATIC_DRAW                                  GLenum
+	STENCIL_ATTACHMENT                           GLenum
+	STENCIL_BACK_FAIL                            GLenum
+	STENCIL_BACK_FUNC                            GLenum
+	STENCIL_BACK_PASS_DEPTH_FAIL                 GLenum
+	STENCIL_BACK_PASS_DEPTH_PASS                 GLenum
+	STENCIL_BACK_REF                             GLenum
+	STENCIL_BACK_VALUE_MASK                      GLenum
+	STENCIL_BACK_WRITEMASK                       GLenum
+	STENCIL_BITS                                 GLenum
+	STENCIL_BUFFER_BIT                           GLenum
+	STENCIL_CLEAR_VALUE                          GLenum
+	STENCIL_FAIL                                 GLenum
+	STENCIL_FUNC                                 GLenum
+	STENCIL_INDEX                                GLenum
+	STENCIL_INDEX8                               GLenum
+	STENCIL_PASS_DEPTH_FAIL                      GLenum
+	STENCIL_PASS_DEPTH_PASS                      GLenum
+	STENCIL_REF                                  GLenum
+	STENCIL_TEST                                 GLenum
+	STENCIL_VALUE_MASK                           GLenum
+	STENCIL_WRITEMASK                            GLenum
+	STREAM_DRAW                                  GLenum
+	SUBPIXEL_BITS                                GLenum
+	TEXTURE                                      GLenum
+	TEXTURE0                                     GLenum
+	TEXTURE1                                     GLenum
+	TEXTURE10                                    GLenum
+	TEXTURE11                                    GLenum
+	TEXTURE12                                    GLenum
+	TEXTURE13                                    GLenum
+	TEXTURE14                                    GLenum
+	TEXTURE15                                    GLenum
+	TEXTURE16                                    GLenum
+	TEXTURE17                                    GLenum
+	TEXTURE18                                    GLenum
+	TEXTURE19                                    GLenum
+	TEXTURE2                                     GLenum
+	TEXTURE20                                    GLenum
+	TEXTURE21                                    GLenum
+	TEXTURE22                                    GLenum
+	TEXTURE23                                    GLenum
+	TEXTURE24                                    GLenum
+	TEXTURE25                                    GLenum
+	TEXTURE26                                    GLenum
+	TEXTURE27                                    GLenum
+	TEXTURE28                                    GLenum
+	TEXTURE29                                    GLenum
+	TEXTURE3                                     GLenum
+	TEXTURE30                                    GLenum
+	TEXTURE31                                    GLenum
+	TEXTURE4                                     GLenum
+	TEXTURE5                                     GLenum
+	TEXTURE6                                     GLenum
+	TEXTURE7                                     GLenum
+	TEXTURE8                                     GLenum
+	TEXTURE9                                     GLenum
+	TEXTURE_2D                                   GLenum
+	TEXTURE_BINDING_2D                           GLenum
+	TEXTURE_BINDING_CUBE_MAP                     GLenum
+	TEXTURE_CUBE_MAP                             GLenum
+	TEXTURE_CUBE_MAP_NEGATIVE_X                  GLenum
+	TEXTURE_CUBE_MAP_NEGATIVE_Y                  GLenum
+	TEXTURE_CUBE_MAP_NEGATIVE_Z                  GLenum
+	TEXTURE_CUBE_MAP_POSITIVE_X                  GLenum
+	TEXTURE_CUBE_MAP_POSITIVE_Y                  GLenum
+	TEXTURE_CUBE_MAP_POSITIVE_Z                  GLenum
+	TEXTURE_MAG_FILTER                           GLenum
+	TEXTURE_MIN_FILTER                           GLenum
+	TEXTURE_WRAP_S                               GLenum
+	TEXTURE_WRAP_T                               GLenum
+	TRIANGLES                                    GLenum
+	TRIANGLE_FAN                                 GLenum
+	TRIANGLE_STRIP                               GLenum
+	UNPACK_ALIGNMENT                             GLenum
+	UNPACK_COLORSPACE_CONVERSION_WEBGL           GLenum
+	UNPACK_FLIP_Y_WEBGL                          GLenum
+	UNPACK_PREMULTIPLY_ALPHA_WEBGL               GLenum
+	UNSIGNED_BYTE                                GLenum
+	UNSIGNED_INT                                 GLenum
+	UNSIGNED_SHORT                               GLenum
+	UNSIGNED_SHORT_4_4_4_4                       GLenum
+	UNSIGNED_SHORT_5_5_5_1                       GLenum
+	UNSIGNED_SHORT_5_6_5                         GLenum
+	VALIDATE_STATUS                              GLenum
+	VENDOR                                       GLenum
+	VERSION                                      GLenum
+	VERTEX_ATTRIB_ARRAY_BUFFER_BINDING           GLenum
+	VERTEX_ATTRIB_ARRAY_ENABLED                  GLenum
+	VERTEX_ATTRIB_ARRAY_NORMALIZED               GLenum
+	VERTEX_ATTRIB_ARRAY_POINTER                  GLenum
+	VERTEX_ATTRIB_ARRAY_SIZE                     GLenum
+	VERTEX_ATTRIB_ARRAY_STRIDE                   GLenum
+	VERTEX_ATTRIB_ARRAY_TYPE                     GLenum
+	VERTEX_SHADER                                GLenum
+	VIEWPORT                                     GLenum
+	ZERO                                         GLenum
+}
+
+// enums2 holds the WebGL 2.0 enums that are exposed as fields of Context2.
+type enums2 struct {
+	ACTIVE_UNIFORM_BLOCKS                         GLenum
+	ALREADY_SIGNALED                              GLenum
+	ANY_SAMPLES_PASSED                            GLenum
+	ANY_SAMPLES_PASSED_CONSERVATIVE               GLenum
+	COLOR                                         GLenum
+	COLOR_ATTACHMENT1                             GLenum
+	COLOR_ATTACHMENT10                            GLenum
+	COLOR_ATTACHMENT11                            GLenum
+	COLOR_ATTACHMENT12                            GLenum
+	COLOR_ATTACHMENT13                            GLenum
+	COLOR_ATTACHMENT14                            GLenum
+	COLOR_ATTACHMENT15                            GLenum
+	COLOR_ATTACHMENT2                             GLenum
+	COLOR_ATTACHMENT3                             GLenum
+	COLOR_ATTACHMENT4                             GLenum
+	COLOR_ATTACHMENT5                             GLenum
+	COLOR_ATTACHMENT6                             GLenum
+	COLOR_ATTACHMENT7                             GLenum
+	COLOR_ATTACHMENT8                             GLenum
+	COLOR_ATTACHMENT9                             GLenum
+	COMPARE_REF_TO_TEXTURE                        GLenum
+	CONDITION_SATISFIED                           GLenum
+	COPY_READ_BUFFER                              GLenum
+	COPY_READ_BUFFER_BINDING                      GLenum
+	COPY_WRITE_BUFFER                             GLenum
+	COPY_WRITE_BUFFER_BINDING                     GLenum
+	CURRENT_QUERY                                 GLenum
+	DEPTH                                         GLenum
+	DEPTH24_STENCIL8                              GLenum
+	DEPTH32F_STENCIL8                             GLenum
+	DEPTH_COMPONENT24                             GLenum
+	DEPTH_COMPONENT32F                            GLenum
+	DRAW_BUFFER0                                  GLenum
+	DRAW_BUFFER1                                  GLenum
+	DRAW_BUFFER10                                 GLenum
+	DRAW_BUFFER11                                 GLenum
+	DRAW_BUFFER12                                 GLenum
+	DRAW_BUFFER13                                 GLenum
+	DRAW_BUFFER14                                 GLenum
+	DRAW_BUFFER15                                 GLenum
+	DRAW_BUFFER2                                  GLenum
+	DRAW_BUFFER3                                  GLenum
+	DRAW_BUFFER4                                  GLenum
+	DRAW_BUFFER5                                  GLenum
+	DRAW_BUFFER6                                  GLenum
+	DRAW_BUFFER7                                  GLenum
+	DRAW_BUFFER8                                  GLenum
+	DRAW_BUFFER9                                  GLenum
+	DRAW_FRAMEBUFFER                              GLenum
+	DRAW_FRAMEBUFFER_BINDING                      GLenum
+	DYNAMIC_COPY                                  GLenum
+	DYNAMIC_READ                                  GLenum
+	FLOAT_32_UNSIGNED_INT_24_8_REV                GLenum
+	FLOAT_MAT2x3                                  GLenum
+	FLOAT_MAT2x4                                  GLenum
+	FLOAT_MAT3x2                                  GLenum
+	FLOAT_MAT3x4                                  GLenum
+	FLOAT_MAT4x2                                  GLenum
+	FLOAT_MAT4x3                                  GLenum
+	FRAGMENT_SHADER_DERIVATIVE_HINT               GLenum
+	FRAMEBUFFER_ATTACHMENT_ALPHA_SIZE             GLenum
+	FRAMEBUFFER_ATTACHMENT_BLUE_SIZE              GLenum
+	FRAMEBUFFER_ATTACHMENT_COLOR_ENCODING         GLenum
+	FRAMEBUFFER_ATTACHMENT_COMPONENT_TYPE         GLenum
+	FRAMEBUFFER_ATTACHMENT_DEPTH_SIZE             GLenum
+	FRAMEBUFFER_ATTACHMENT_GREEN_SIZE             GLenum
+	FRAMEBUFFER_ATTACHMENT_RED_SIZE               GLenum
+	FRAMEBUFFER_ATTACHMENT_STENCIL_SIZE           GLenum
+	FRAMEBUFFER_ATTACHMENT_TEXTURE_LAYER          GLenum
+	FRAMEBUFFER_DEFAULT                           GLenum
+	FRAMEBUFFER_INCOMPLETE_MULTISAMPLE            GLenum
+	HALF_FLOAT                                    GLenum
+	INTERLEAVED_ATTRIBS                           GLenum
+	INT_2_10_10_10_REV                            GLenum
+	INT_SAMPLER_2D                                GLenum
+	INT_SAMPLER_2D_ARRAY                          GLenum
+	INT_SAMPLER_3D                                GLenum
+	INT_SAMPLER_CUBE                              GLenum
+	MAX                                           GLenum
+	MAX_3D_TEXTURE_SIZE                           GLenum
+	MAX_ARRAY_TEXTURE_LAYERS                      GLenum
+	MAX_CLIENT_WAIT_TIMEOUT_WEBGL                 GLenum
+	MAX_COLOR_ATTACHMENTS                         GLenum
+	MAX_COMBINED_FRAGMENT_UNIFORM_COMPONENTS      GLenum
+	MAX_COMBINED_UNIFORM_BLOCKS                   GLenum
+	MAX_COMBINED_VERTEX_UNIFORM_COMPONENTS        GLenum
+	MAX_DRAW_BUFFERS                              GLenum
+	MAX_ELEMENTS_INDICES                          GLenum
+	MAX_ELEMENTS_VERTICES                         GLenum
+	MAX_ELEMENT_INDEX                             GLenum
+	MAX_FRAGMENT_INPUT_COMPONENTS                 GLenum
+	MAX_FRAGMENT_UNIFORM_BLOCKS                   GLenum
+	MAX_FRAGMENT_UNIFORM_COMPONENTS               GLenum
+	MAX_PROGRAM_TEXEL_OFFSET                      GLenum
+	MAX_SAMPLES                                   GLenum
+	MAX_SERVER_WAIT_TIMEOUT                       GLenum
+	MAX_TEXTURE_LOD_BIAS                          GLenum
+	MAX_TRANSFORM_FEEDBACK_INTERLEAVED_COMPONENTS GLenum
+	MAX_TRANSFORM_FEEDBACK_SEPARATE_ATTRIBS       GLenum
+	MAX_TRANSFORM_FEEDBACK_SEPARATE_COMPONENTS    GLenum
+	MAX_UNIFORM_BLOCK_SIZE                        GLenum
+	MAX_UNIFORM_BUFFER_BINDINGS                   GLenum
+	MAX_VARYING_COMPONENTS                        GLenum
+	MAX_VERTEX_OUTPUT_COMPONENTS                  GLenum
+	MAX_VERTEX_UNIFORM_BLOCKS                     GLenum
+	MAX_VERTEX_UNIFORM_COMPONENTS                 GLenum
+	MIN                                           GLenum
+	MIN_PROGRAM_TEXEL_OFFSET                      GLenum
+	OBJECT_TYPE                                   GLenum
+	PACK_ROW_LENGTH                               GLenum
+	PACK_SKIP_PIXELS                              GLenum
+	PACK_SKIP_ROWS                                GLenum
+	PIXEL_PACK_BUFFER                             GLenum
+	PIXEL_PACK_BUFFER_BINDING                     GLenum
+	PIXEL_UNPACK_BUFFER                           GLenum
+	PIXEL_UNPACK_BUFFER_BINDING                   GLenum
+	QUERY_RESULT                                  GLenum
+	QUERY_RESULT_AVAILABLE                        GLenum
+	R11F_G11F_B10F                                GLenum
+	R16F                                          GLenum
+	R16I                                          GLenum
+	R16UI                                         GLenum
+	R32F                                          GLenum
+	R32I                                          GLenum
+	R32UI                                         GLenum
+	R8                                            GLenum
+	R8I                                           GLenum
+	R8UI                                          GLenum
+	R8_SNORM                                      GLenum
+	RASTERIZER_DISCARD                            GLenum
+	READ_BUFFER                                   GLenum
+	READ_FRAMEBUFFER                              GLenum
+	READ_FRAMEBUFFER_BINDING                      GLenum
+	RED                                           GLenum
+	RED_INTEGER                                   GLenum
+	RENDERBUFFER_SAMPLES                          GLenum
+	RG                                            GLenum
+	RG16F                                         GLenum
+	RG16I                                         GLenum
+	RG16UI                                        GLenum
+	RG32F                                         GLenum
+	RG32I                                         GLenum
+	RG32UI                                        GLenum
+	RG8                                           GLenum
+	RG8I                                          GLenum
+	RG8UI                                         GLenum
+	RG8_SNORM                                     GLenum
+	RGB10_A2                                      GLenum
+	RGB10_A2UI                                    GLenum
+	RGB16F                                        GLenum
+	RGB16I                                        GLenum
+	RGB16UI                                       GLenum
+	RGB32F                                        GLenum
+	RGB32I                                        GLenum
+	RGB32UI                                       GLenum
+	RGB8                                          GLenum
+	RGB8I                                         GLenum
+	RGB8UI                                        GLenum
+	RGB8_SNORM                                    GLenum
+	RGB9_E5                                       GLenum
+	RGBA16F                                       GLenum
+	RGBA16I                                       GLenum
+	RGBA16UI                                      GLenum
+	RGBA32F                                       GLenum
+	RGBA32I                                       GLenum
+	RGBA32UI                                      GLenum
+	RGBA8                                         GLenum
+	RGBA8I                                        GLenum
+	RGBA8UI                                       GLenum
+	RGBA8_SNORM                                   GLenum
+	RGBA_INTEGER                                  GLenum
+	RGB_INTEGER                                   GLenum
+	RG_INTEGER                                    GLenum
+	SAMPLER_2D_ARRAY                              GLenum
+	SAMPLER_2D_ARRAY_SHADOW                       GLenum
+	SAMPLER_2D_SHADOW                             GLenum
+	SAMPLER_3D                                    GLenum
+	SAMPLER_BINDING                               GLenum
+	SAMPLER_CUBE_SHADOW                           GLenum
+	SEPARATE_ATTRIBS                              GLenum
+	SIGNALED                                      GLenum
+	SIGNED_NORMALIZED                             GLenum
+	SRGB                                          GLenum
+	SRGB8                                         GLenum
+	SRGB8_ALPHA8                                  GLenum
+	STATIC_COPY                                   GLenum
+	STATIC_READ                                   GLenum
+	STENCIL                                       GLenum
+	STREAM_COPY                                   GLenum
+	STREAM_READ                                   GLenum
+	SYNC_CONDITION                                GLenum
+	SYNC_FENCE                                    GLenum
+	SYNC_FLAGS                                    GLenum
+	SYNC_FLUSH_COMMANDS_BIT                       GLenum
+	SYNC_GPU_COMMANDS_COMPLETE                    GLenum
+	SYNC_STATUS                                   GLenum
+	TEXTURE_2D_ARRAY                              GLenum
+	TEXTURE_3D                                    GLenum
+	TEXTURE_BASE_LEVEL                            GLenum
+	TEXTURE_BINDING_2D_ARRAY                      GLenum
+	TEXTURE_BINDING_3D                            GLenum
+	TEXTURE_COMPARE_FUNC                          GLenum
+	TEXTURE_COMPARE_MODE                          GLenum
+	TEXTURE_IMMUTABLE_FORMAT                      GLenum
+	TEXTURE_IMMUTABLE_LEVELS                      GLenum
+	TEXTURE_MAX_LEVEL                             GLenum
+	TEXTURE_MAX_LOD                               GLenum
+	TEXTURE_MIN_LOD                               GLenum
+	TEXTURE_WRAP_R                                GLenum
+	TIMEOUT_EXPIRED                               GLenum
+	TRANSFORM_FEEDBACK                            GLenum
+	TRANSFORM_FEEDBACK_ACTIVE                     GLenum
+	TRANSFORM_FEEDBACK_BINDING                    GLenum
+	TRANSFORM_FEEDBACK_BUFFER                     GLenum
+	TRANSFORM_FEEDBACK_BUFFER_BINDING             GLenum
+	TRANSFORM_FEEDBACK_BUFFER_MODE                GLenum
+	TRANSFORM_FEEDBACK_BUFFER_SIZE                GLenum
+	TRANSFORM_FEEDBACK_BUFFER_START               GLenum
+	TRANSFORM_FEEDBACK_PAUSED                     GLenum
+	TRANSFORM_FEEDBACK_PRIMITIVES_WRITTEN         GLenum
+	TRANSFORM_FEEDBACK_VARYINGS                   GLenum
+	UNIFORM_ARRAY_STRIDE                          GLenum
+	UNIFORM_BLOCK_ACTIVE_UNIFORMS                 GLenum
+	UNIFORM_BLOCK_ACTIVE_UNIFORM_INDICES          GLenum
+	UNIFORM_BLOCK_BINDING                         GLenum
+	UNIFORM_BLOCK_DATA_SIZE                       GLenum
+	UNIFORM_BLOCK_INDEX                           GLenum
+	UNIFORM_BLOCK_REFERENCED_BY_FRAGMENT_SHADER   GLenum
+	UNIFORM_BLOCK_REFERENCED_BY_VERTEX_SHADER     GLenum
+	UNIFORM_BUFFER                                GLenum
+	UNIFORM_BUFFER_BINDING                        GLenum
+	UNIFORM_BUFFER_OFFSET_ALIGNMENT               GLenum
+	UNIFORM_BUFFER_SIZE                           GLenum
+	UNIFORM_BUFFER_START                          GLenum
+	UNIFORM_IS_ROW_MAJOR                          GLenum
+	UNIFORM_MATRIX_STRIDE                         GLenum
+	UNIFORM_OFFSET                                GLenum
+	UNIFORM_SIZE                                  GLenum
+	UNIFORM_TYPE                                  GLenum
+	UNPACK_IMAGE_HEIGHT                           GLenum
+	UNPACK_ROW_LENGTH                             GLenum
+	UNPACK_SKIP_IMAGES                            GLenum
+	UNPACK_SKIP_PIXELS                            GLenum
+	UNPACK_SKIP_ROWS                              GLenum
+	UNSIGNALED                                    GLenum
+	UNSIGNED_INT_10F_11F_11F_REV                  GLenum
+	UNSIGNED_INT_24_8                             GLenum
+	UNSIGNED_INT_2_10_10_10_REV                   GLenum
+	UNSIGNED_INT_5_9_9_9_REV                      GLenum
+	UNSIGNED_INT_SAMPLER_2D                       GLenum
+	UNSIGNED_INT_SAMPLER_2D_ARRAY                 GLenum
+	UNSIGNED_INT_SAMPLER_3D                       GLenum
+	UNSIGNED_INT_SAMPLER_CUBE                     GLenum
+	UNSIGNED_INT_VEC2                             GLenum
+	UNSIGNED_INT_VEC3                             GLenum
+	UNSIGNED_INT_VEC4                             GLenum
+	UNSIGNED_NORMALIZED                           GLenum
+	VERTEX_ARRAY_BINDING                          GLenum
+	VERTEX_ATTRIB_ARRAY_DIVISOR                   GLenum
+	VERTEX_ATTRIB_ARRAY_INTEGER                   GLenum
+	WAIT_FAILED                                   GLenum
+}
+
+var webgl1Enums = enums{
+	ACTIVE_ATTRIBUTES:                  ACTIVE_ATTRIBUTES,
+	ACTIVE_TEXTURE:                     ACTIVE_TEXTURE,
+	ACTIVE_UNIFORMS:                    ACTIVE_UNIFORMS,
+	ALIASED_LINE_WIDTH_RANGE:           ALIASED_LINE_WIDTH_RANGE,
+	ALIASED_POINT_SIZE_RANGE:           ALIASED_POINT_SIZE_RANGE,
+	ALPHA:                              ALPHA,
+	ALPHA_BITS:                         ALPHA_BITS,
+	ALWAYS:                             ALWAYS,
+	ARRAY_BUFFER:                       ARRAY_BUFFER,
+	ARRAY_BUFFER_BINDING:               ARRAY_BUFFER_BINDING,
+	ATTACHED_SHADERS:                   ATTACHED_SHADERS,
+	BACK:                               BACK,
+	BLEND:                              BLEND,
+	BLEND_COLOR:                        BLEND_COLOR,
+	BLEND_DST_ALPHA:                    BLEND_DST_ALPHA,
+	BLEND_DST_RGB:                      BLEND_DST_RGB,
+	BLEND_EQUATION:                     BLEND_EQUATION,
+	BLEND_EQUATION_ALPHA:               BLEND_EQUATION_ALPHA,
+	BLEND_EQUATION_RGB:                 BLEND_EQUATION_RGB,
+	BLEND_SRC_ALPHA:                    BLEND_SRC_ALPHA,
+	BLEND_SRC_RGB:                      BLEND_SRC_RGB,
+	BLUE_BITS:                          BLUE_BITS,
+	BOOL:                               BOOL,
+	BOOL_VEC2:                          BOOL_VEC2,
+	BOOL_VEC3:                          BOOL_VEC3,
+	BOOL_VEC4:                          BOOL_VEC4,
+	BROWSER_DEFAULT_WEBGL:              BROWSER_DEFAULT_WEBGL,
+	BUFFER_SIZE:                        BUFFER_SIZE,
+	BUFFER_USAGE:                       BUFFER_USAGE,
+	BYTE:                               BYTE,
+	CCW:                                CCW,
+	CLAMP_TO_EDGE:                      CLAMP_TO_EDGE,
+	COLOR_ATTACHMENT0:                  COLOR_ATTACHMENT0,
+	COLOR_BUFFER_BIT:                   COLOR_BUFFER_BIT,
+	COLOR_CLEAR_VALUE:                  COLOR_CLEAR_VALUE,
+	COLOR_WRITEMASK:                    COLOR_WRITEMASK,
+	COMPILE_STATUS:                     COMPILE_STATUS,
+	COMPRESSED_TEXTURE_FORMATS:         COMPRESSED_TEXTURE_FORMATS,
+	CONSTANT_ALPHA:                     CONSTANT_ALPHA,
+	CONSTANT_COLOR:                     CONSTANT_COLOR,
+	CONTEXT_LOST_WEBGL:                 CONTEXT_LOST_WEBGL,
+	CULL_FACE:                          CULL_FACE,
+	CULL_FACE_MODE:                     CULL_FACE_MODE,
+	CURRENT_PROGRAM:                    CURRENT_PROGRAM,
+	CURRENT_VERTEX_ATTRIB:              CURRENT_VERTEX_ATTRIB,
+	CW:                                 CW,
+	DECR:                               DECR,
+	DECR_WRAP:                          DECR_WRAP,
+	DELETE_STATUS:                      DELETE_STATUS,
+	DEPTH_ATTACHMENT:                   DEPTH_ATTACHMENT,
+	DEPTH_BITS:                         DEPTH_BITS,
+	DEPTH_BUFFER_BIT:                   DEPTH_BUFFER_BIT,
+	DEPTH_CLEAR_VALUE:                  DEPTH_CLEAR_VALUE,
+	DEPTH_COMPONENT:                    DEPTH_COMPONENT,
+	DEPTH_COMPONENT16:                  DEPTH_COMPONENT16,
+	DEPTH_FUNC:                         DEPTH_FUNC,
+	DEPTH_RANGE:                        DEPTH_RANGE,
+	DEPTH_STENCIL:                      DEPTH_STENCIL,
+	DEPTH_STENCIL_ATTACHMENT:           DEPTH_STENCIL_ATTACHMENT,
+	DEPTH_TEST:                         DEPTH_TEST,
+	DEPTH_WRITEMASK:                    DEPTH_WRITEMASK,
+	DITHER:                             DITHER,
+	DONT_CARE:                          DONT_CARE,
+	DST_ALPHA:                          DST_ALPHA,
+	DST_COLOR:                          DST_COLOR,
+	DYNAMIC_DRAW:                       DYNAMIC_DRAW,
+	ELEMENT_ARRAY_BUFFER:               ELEMENT_ARRAY_BUFFER,
+	ELEMENT_ARRAY_BUFFER_BINDING:       ELEMENT_ARRAY_BUFFER_BINDING,
+	EQUAL:                              EQUAL,
+	FASTEST:                            FASTEST,
+	FLOAT:                              FLOAT,
+	FLOAT_MAT2:                         FLOAT_MAT2,
+	FLOAT_MAT3:                         FLOAT_MAT3,
+	FLOAT_MAT4:                         FLOAT_MAT4,
+	FLOAT_VEC2:                         FLOAT_VEC2,
+	FLOAT_VEC3:                         FLOAT_VEC3,
+	FLOAT_VEC4:                         FLOAT_VEC4,
+	FRAGMENT_SHADER:                    FRAGMENT_SHADER,
+	FRAMEBUFFER:                        FRAMEBUFFER,
+	FRAMEBUFFER_ATTACHMENT_OBJECT_NAME: FRAMEBUFFER_ATTACHMENT_OBJECT_NAME,
+	FRAMEBUFFER_ATTACHMENT_OBJECT_TYPE: FRAMEBUFFER_ATTACHMENT_OBJECT_TYPE,
+	FRAMEBUFFER_ATTACHMENT_TEXTURE_CUBE_MAP_FACE: FRAMEBUFFER_ATTACHMENT_TEXTURE_CUBE_MAP_FACE,
+	FRAMEBUFFER_ATTACHMENT_TEXTURE_LEVEL:         FRAMEBUFFER_ATTACHMENT_TEXTURE_LEVEL,
+	FRAMEBUFFER_BINDING:                          FRAMEBUFFER_BINDING,
+	FRAMEBUFFER_COMPLETE:                         FRAMEBUFFER_COMPLETE,
+	FRAMEBUFFER_INCOMPLETE_ATTACHMENT:            FRAMEBUFFER_INCOMPLETE_ATTACHMENT,
+	FRAMEBUFFER_INCOMPLETE_DIMENSIONS:            FRAMEBUFFER_INCOMPLETE_DIMENSIONS,
+	FRAMEBUFFER_INCOMPLETE_MISSING_ATTACHMENT:    FRAMEBUFFER_INCOMPLETE_MISSING_ATTACHMENT,
+	FRAMEBUFFER_UNSUPPORTED:                      FRAMEBUFFER_UNSUPPORTED,
+	FRONT:                                        FRONT,
+	FRONT_AND_BACK:                               FRONT_AND_BACK,
+	FRONT_FACE:                                   FRONT_FACE,
+	FUNC_ADD:                                     FUNC_ADD,
+	FUNC_REVERSE_SUBTRACT:                        FUNC_REVERSE_SUBTRACT,
+	FUNC_SUBTRACT:                                FUNC_SUBTRACT,
+	GENERATE_MIPMAP_HINT:                         GENERATE_MIPMAP_HINT,
+	GEQUAL:                                       GEQUAL,
+	GREATER:                                      GREATER,
+	GREEN_BITS:                                   GREEN_BITS,
+	HIGH_FLOAT:                                   HIGH_FLOAT,
+	HIGH_INT:                                     HIGH_INT,
+	IMPLEMENTATION_COLOR_READ_FORMAT:             IMPLEMENTATION_COLOR_READ_FORMAT,
+	IMPLEMENTATION_COLOR_READ_TYPE:               IMPLEMENTATION_COLOR_READ_TYPE,
+	INCR:                                         INCR,
+	INCR_WRAP:                                    INCR_WRAP,
+	INFO_LOG_LENGTH:                              INFO_LOG_LENGTH,
+	INT:                                          INT,
+	INT_VEC2:                                     INT_VEC2,
+	INT_VEC3:                                     INT_VEC3,
+	INT_VEC4:                                     INT_VEC4,
+	INVALID_ENUM:                                 INVALID_ENUM,
+	INVALID_FRAMEBUFFER_OPERATION:                INVALID_FRAMEBUFFER_OPERATION,
+	INVALID_OPERATION:                            INVALID_OPERATION,
+	INVALID_VALUE:                                INVALID_VALUE,
+	INVERT:                                       INVERT,
+	KEEP:                                         KEEP,
+	LEQUAL:                                       LEQUAL,
+	LESS:                                         LESS,
+	LINEAR:                                       LINEAR,
+	LINEAR_MIPMAP_LINEAR:                         LINEAR_MIPMAP_LINEAR,
+	LINEAR_MIPMAP_NEAREST:                        LINEAR_MIPMAP_NEAREST,
+	LINES:                                        LINES,
+	LINE_LOOP:                                    LINE_LOOP,
+	LINE_STRIP:                                   LINE_STRIP,
+	LINE_WIDTH:                                   LINE_WIDTH,
+	LINK_STATUS:                                  LINK_STATUS,
+	LOW_FLOAT:                                    LOW_FLOAT,
+	LOW_INT:                                      LOW_INT,
+	LUMINANCE:                                    LUMINANCE,
+	LUMINANCE_ALPHA:                              LUMINANCE_ALPHA,
+	MAX_COMBINED_TEXTURE_IMAGE_UNITS:             MAX_COMBINED_TEXTURE_IMAGE_UNITS,
+	MAX_CUBE_MAP_TEXTURE_SIZE:                    MAX_CUBE_MAP_TEXTURE_SIZE,
+	MAX_FRAGMENT_UNIFORM_VECTORS:                 MAX_FRAGMENT_UNIFORM_VECTORS,
+	MAX_RENDERBUFFER_SIZE:                        MAX_RENDERBUFFER_SIZE,
+	MAX_TEXTURE_IMAGE_UNITS:                      MAX_TEXTURE_IMAGE_UNITS,
+	MAX_TEXTURE_SIZE:                             MAX_TEXTURE_SIZE,
+	MAX_VARYING_VECTORS:                          MAX_VARYING_VECTORS,
+	MAX_VERTEX_ATTRIBS:                           MAX_VERTEX_ATTRIBS,
+	MAX_VERTEX_TEXTURE_IMAGE_UNITS:               MAX_VERTEX_TEXTURE_IMAGE_UNITS,
+	MAX_VERTEX_UNIFORM_VECTORS:                   MAX_VERTEX_UNIFORM_VECTORS,
+	MAX_VIEWPORT_DIMS:                            MAX_VIEWPORT_DIMS,
+	MEDIUM_FLOAT:                                 MEDIUM_FLOAT,
+	MEDIUM_INT:                                   MEDIUM_INT,
+	MIRRORED_REPEAT:                              MIRRORED_REPEAT,
+	NEAREST:                                      NEAREST,
+	NEAREST_MIPMAP_LINEAR:                        NEAREST_MIPMAP_LINEAR,
+	NEAREST_MIPMAP_NEAREST:                       NEAREST_MIPMAP_NEAREST,
+	NEVER:                                        NEVER,
+	NICEST:                                       NICEST,
+	NONE:                                         NONE,
+	NOTEQUAL:                                     NOTEQUAL,
+	NO_ERROR:                                     NO_ERROR,
+	NUM_COMPRESSED_TEXTURE_FORMATS:               NUM_COMPRESSED_TEXTURE_FORMATS,
+	ONE:                                          ONE,
+	ONE_MINUS_CONSTANT_ALPHA:                     ONE_MINUS_CONSTANT_ALPHA,
+	ONE_MINUS_CONSTANT_COLOR:                     ONE_MINUS_CONSTANT_COLOR,
+	ONE_MINUS_DST_ALPHA:                          ONE_MINUS_DST_ALPHA,
+	ONE_MINUS_DST_COLOR:                          ONE_MINUS_DST_COLOR,
+	ONE_MINUS_SRC_ALPHA:                          ONE_MINUS_SRC_ALPHA,
+	ONE_MINUS_SRC_COLOR:                          ONE_MINUS_SRC_COLOR,
+	OUT_OF_MEMORY:                                OUT_OF_MEMORY,
+	PACK_ALIGNMENT:                               PACK_ALIGNMENT,
+	POINTS:                                       POINTS,
+	POLYGON_OFFSET_FACTOR:                        POLYGON_OFFSET_FACTOR,
+	POLYGON_OFFSET_FILL:                          POLYGON_OFFSET_FILL,
+	POLYGON_OFFSET_UNITS:                         POLYGON_OFFSET_UNITS,
+	RED_BITS:                                     RED_BITS,
+	RENDERBUFFER:                                 RENDERBUFFER,
+	RENDERBUFFER_ALPHA_SIZE:                      RENDERBUFFER_ALPHA_SIZE,
+	RENDERBUFFER_BINDING:                         RENDERBUFFER_BINDING,
+	RENDERBUFFER_BLUE_SIZE:                       RENDERBUFFER_BLUE_SIZE,
+	RENDERBUFFER_DEPTH_SIZE:                      RENDERBUFFER_DEPTH_SIZE,
+	RENDERBUFFER_GREEN_SIZE:                      RENDERBUFFER_GREEN_SIZE,
+	RENDERBUFFER_HEIGHT:                          RENDERBUFFER_HEIGHT,
+	RENDERBUFFER_INTERNAL_FORMAT:                 RENDERBUFFER_INTERNAL_FORMAT,
+	RENDERBUFFER_RED_SIZE:                        RENDERBUFFER_RED_SIZE,
+	RENDERBUFFER_STENCIL_SIZE:                    RENDERBUFFER_STENCIL_SIZE,
+	RENDERBUFFER_WIDTH:                           RENDERBUFFER_WIDTH,
+	RENDERER:                                     RENDERER,
+	REPEAT:                                       REPEAT,
+	REPLACE:                                      REPLACE,
+	RGB:                                          RGB,
+	RGB565:                                       RGB565,
+	RGB5_A1:                                      RGB5_A1,
+	RGBA:                                         RGBA,
+	RGBA4:                                        RGBA4,
+	SAMPLER_2D:                                   SAMPLER_2D,
+	SAMPLER_CUBE:                                 SAMPLER_CUBE,
+	SAMPLES:                                      SAMPLES,
+	SAMPLE_ALPHA_TO_COVERAGE:                     SAMPLE_ALPHA_TO_COVERAGE,
+	SAMPLE_BUFFERS:                               SAMPLE_BUFFERS,
+	SAMPLE_COVERAGE:                              SAMPLE_COVERAGE,
+	SAMPLE_COVERAGE_INVERT:                       SAMPLE_COVERAGE_INVERT,
+	SAMPLE_COVERAGE_VALUE:                        SAMPLE_COVERAGE_VALUE,
+	SCISSOR_BOX:                                  SCISSOR_BOX,
+	SCISSOR_TEST:                                 SCISSOR_TEST,
+	SHADER_COMPILER:                              SHADER_COMPILER,
+	SHADER_SOURCE_LENGTH:                         SHADER_SOURCE_LENGTH,
+	SHADER_TYPE:                                  SHADER_TYPE,
+	SHADING_LANGUAGE_VERSION:                     SHADING_LANGUAGE_VERSION,
+	SHORT:                                        SHORT,
+	SRC_ALPHA:                                    SRC_ALPHA,
+	SRC_ALPHA_SATURATE:                           SRC_ALPHA_SATURATE,
+	SRC_COLOR:                                    SRC_COLOR,
+	STATIC_DRAW:                                  STATIC_DRAW,
+	STENCIL_ATTACHMENT:                           STENCIL_ATTACHMENT,
+	STENCIL_BACK_FAIL:                            STENCIL_BACK_FAIL,
+	STENCIL_BACK_FUNC:                            STENCIL_BACK_FUNC,
+	STENCIL_BACK_PASS_DEPTH_FAIL:                 STENCIL_BACK_PASS_DEPTH_FAIL,
+	STENCIL_BACK_PASS_DEPTH_PASS:                 STENCIL_BACK_PASS_DEPTH_PASS,
+	STENCIL_BACK_REF:                             STENCIL_BACK_REF,
+	STENCIL_BACK_VALUE_MASK:                      STENCIL_BACK_VALUE_MASK,
+	STENCIL_BACK_WRITEMASK:                       STENCIL_BACK_WRITEMASK,
+	STENCIL_BITS:                                 STENCIL_BITS,
+	STENCIL_BUFFER_BIT:                           STENCIL_BUFFER_BIT,
+	STENCIL_CLEAR_VALUE:                          STENCIL_CLEAR_VALUE,
+	STENCIL_FAIL:                                 STENCIL_FAIL,
+	STENCIL_FUNC:                                 STENCIL_FUNC,
+	STENCIL_INDEX:                                STENCIL_INDEX,
+	STENCIL_INDEX8:                               STENCIL_INDEX8,
+	STENCIL_PASS_DEPTH_FAIL:                      STENCIL_PASS_DEPTH_FAIL,
+	STENCIL_PASS_DEPTH_PASS:                      STENCIL_PASS_DEPTH_PASS,
+	STENCIL_REF:                                  STENCIL_REF,
+	STENCIL_TEST:                                 STENCIL_TEST,
+	STENCIL_VALUE_MASK:                           STENCIL_VALUE_MASK,
+	STENCIL_WRITEMASK:                            STENCIL_WRITEMASK,
+	STREAM_DRAW:                                  STREAM_DRAW,
+	SUBPIXEL_BITS:                                SUBPIXEL_BITS,
+	TEXTURE:                                      TEXTURE,
+	TEXTURE0:                                     TEXTURE0,
+	TEXTURE1:                                     TEXTURE1,
+	TEXTURE10:                                    TEXTURE10,
+	TEXTURE11:                                    TEXTURE11,
+	TEXTURE12:                                    TEXTURE12,
+	TEXTURE13:                                    TEXTURE13,
+	TEXTURE14:                                    TEXTURE14,
+	TEXTURE15:                                    TEXTURE15,
+	TEXTURE16:                                    TEXTURE16,
+	TEXTURE17:                                    TEXTURE17,
+	TEXTURE18:                                    TEXTURE18,
+	TEXTURE19:                                    TEXTURE19,
+	TEXTURE2:                                     TEXTURE2,
+	TEXTURE20:                                    TEXTURE20,
+	TEXTURE21:                                    TEXTURE21,
+	TEXTURE22:                                    TEXTURE22,
+	TEXTURE23:                                    TEXTURE23,
+	TEXTURE24:                                    TEXTURE24,
+	TEXTURE25:                                    TEXTURE25,
+	TEXTURE26:                                    TEXTURE26,
+	TEXTURE27:                                    TEXTURE27,
+	TEXTURE28:                                    TEXTURE28,
+	TEXTURE29:                                    TEXTURE29,
+	TEXTURE3:                                     TEXTURE3,
+	TEXTURE30:                                    TEXTURE30,
+	TEXTURE31:                                    TEXTURE31,
+	TEXTURE4:                                     TEXTURE4,
+	TEXTURE5:                                     TEXTURE5,
+	TEXTURE6:                                     TEXTURE6,
+	TEXTURE7:                                     TEXTURE7,
+	TEXTURE8:                                     TEXTURE8,
+	TEXTURE9:                                     TEXTURE9,
+	TEXTURE_2D:                                   TEXTURE_2D,
+	TEXTURE_BINDING_2D:                           TEXTURE_BINDING_2D,
+	TEXTURE_BINDING_CUBE_MAP:                     TEXTURE_BINDING_CUBE_MAP,
+	TEXTURE_CUBE_MAP:                             TEXTURE_CUBE_MAP,
+	TEXTURE_CUBE_MAP_NEGATIVE_X:                  TEXTURE_CUBE_MAP_NEGATIVE_X,
+	TEXTURE_CUBE_MAP_NEGATIVE_Y:                  TEXTURE_CUBE_MAP_NEGATIVE_Y,
+	TEXTURE_CUBE_MAP_NEGATIVE_Z:                  TEXTURE_CUBE_MAP_NEGATIVE_Z,
+	TEXTURE_CUBE_MAP_POSITIVE_X:                  TEXTURE_CUBE_MAP_POSITIVE_X,
+	TEXTURE_CUBE_MAP_POSITIVE_Y:                  TEXTURE_CUBE_MAP_POSITIVE_Y,
+	TEXTURE_CUBE_MAP_POSITIVE_Z:                  TEXTURE_CUBE_MAP_POSITIVE_Z,
+	TEXTURE_MAG_FILTER:                           TEXTURE_MAG_FILTER,
+	TEXTURE_MIN_FILTER:                           TEXTURE_MIN_FILTER,
+	TEXTURE_WRAP_S:                               TEXTURE_WRAP_S,
+	TEXTURE_WRAP_T:                               TEXTURE_WRAP_T,
+	TRIANGLES:                                    TRIANGLES,
+	TRIANGLE_FAN:                                 TRIANGLE_FAN,
+	TRIANGLE_STRIP:                               TRIANGLE_STRIP,
+	UNPACK_ALIGNMENT:                             UNPACK_ALIGNMENT,
+	UNPACK_COLORSPACE_CONVERSION_WEBGL:           UNPACK_COLORSPACE_CONVERSION_WEBGL,
+	UNPACK_FLIP_Y_WEBGL:                          UNPACK_FLIP_Y_WEBGL,
+	UNPACK_PREMULTIPLY_ALPHA_WEBGL:               UNPACK_PREMULTIPLY_ALPHA_WEBGL,
+	UNSIGNED_BYTE:                                UNSIGNED_BYTE,
+	UNSIGNED_INT:                                 UNSIGNED_INT,
+	UNSIGNED_SHORT:                               UNSIGNED_SHORT,
+	UNSIGNED_SHORT_4_4_4_4:                       UNSIGNED_SHORT_4_4_4_4,
+	UNSIGNED_SHORT_5_5_5_1:                       UNSIGNED_SHORT_5_5_5_1,
+	UNSIGNED_SHORT_5_6_5:                         UNSIGNED_SHORT_5_6_5,
+	VALIDATE_STATUS:                              VALIDATE_STATUS,
+	VENDOR:                                       VENDOR,
+	VERSION:                                      VERSION,
+	VERTEX_ATTRIB_ARRAY_BUFFER_BINDING:           VERTEX_ATTRIB_ARRAY_BUFFER_BINDING,
+	VERTEX_ATTRIB_ARRAY_ENABLED:                  VERTEX_ATTRIB_ARRAY_ENABLED,
+	VERTEX_ATTRIB_ARRAY_NORMALIZED:               VERTEX_ATTRIB_ARRAY_NORMALIZED,
+	VERTEX_ATTRIB_ARRAY_POINTER:                  VERTEX_ATTRIB_ARRAY_POINTER,
+	VERTEX_ATTRIB_ARRAY_SIZE:                     VERTEX_ATTRIB_ARRAY_SIZE,
+	VERTEX_ATTRIB_ARRAY_STRIDE:                   VERTEX_ATTRIB_ARRAY_STRIDE,
+	VERTEX_ATTRIB_ARRAY_TYPE:                     VERTEX_ATTRIB_ARRAY_TYPE,
+	VERTEX_SHADER:                                VERTEX_SHADER,
+	VIEWPORT:                                     VIEWPORT,
+	ZERO:                                         ZERO,
+}
+
+var webgl2Enums = enums2{
+	ACTIVE_UNIFORM_BLOCKS:                    ACTIVE_UNIFORM_BLOCKS,
+	ALREADY_SIGNALED:                         ALREADY_SIGNALED,
+	ANY_SAMPLES_PASSED:                       ANY_SAMPLES_PASSED,
+	ANY_SAMPLES_PASSED_CONSERVATIVE:          ANY_SAMPLES_PASSED_CONSERVATIVE,
+	COLOR:                                    COLOR,
+	COLOR_ATTACHMENT1:                        COLOR_ATTACHMENT1,
+	COLOR_ATTACHMENT10:                       COLOR_ATTACHMENT10,
+	COLOR_ATTACHMENT11:                       COLOR_ATTACHMENT11,
+	COLOR_ATTACHMENT12:                       COLOR_ATTACHMENT12,
+	COLOR_ATTACHMENT13:                       COLOR_ATTACHMENT13,
+	COLOR_ATTACHMENT14:                       COLOR_ATTACHMENT14,
+	COLOR_ATTACHMENT15:                       COLOR_ATTACHMENT15,
+	COLOR_ATTACHMENT2:                        COLOR_ATTACHMENT2,
+	COLOR_ATTACHMENT3:                        COLOR_ATTACHMENT3,
+	COLOR_ATTACHMENT4:                        COLOR_ATTACHMENT4,
+	COLOR_ATTACHMENT5:                        COLOR_ATTACHMENT5,
+	COLOR_ATTACHMENT6:                        COLOR_ATTACHMENT6,
+	COLOR_ATTACHMENT7:                        COLOR_ATTACHMENT7,
+	COLOR_ATTACHMENT8:                        COLOR_ATTACHMENT8,
+	COLOR_ATTACHMENT9:                        COLOR_ATTACHMENT9,
+	COMPARE_REF_TO_TEXTURE:                   COMPARE_REF_TO_TEXTURE,
+	CONDITION_SATISFIED:                      CONDITION_SATISFIED,
+	COPY_READ_BUFFER:                         COPY_READ_BUFFER,
+	COPY_READ_BUFFER_BINDING:                 COPY_READ_BUFFER_BINDING,
+	COPY_WRITE_BUFFER:                        COPY_WRITE_BUFFER,
+	COPY_WRITE_BUFFER_BINDING:                COPY_WRITE_BUFFER_BINDING,
+	CURRENT_QUERY:                            CURRENT_QUERY,
+	DEPTH:                                    DEPTH,
+	DEPTH24_STENCIL8:                         DEPTH24_STENCIL8,
+	DEPTH32F_STENCIL8:                        DEPTH32F_STENCIL8,
+	DEPTH_COMPONENT24:                        DEPTH_COMPONENT24,
+	DEPTH_COMPONENT32F:                       DEPTH_COMPONENT32F,
+	DRAW_BUFFER0:                             DRAW_BUFFER0,
+	DRAW_BUFFER1:                             DRAW_BUFFER1,
+	DRAW_BUFFER10:                            DRAW_BUFFER10,
+	DRAW_BUFFER11:                            DRAW_BUFFER11,
+	DRAW_BUFFER12:                            DRAW_BUFFER12,
+	DRAW_BUFFER13:                            DRAW_BUFFER13,
+	DRAW_BUFFER14:                            DRAW_BUFFER14,
+	DRAW_BUFFER15:                            DRAW_BUFFER15,
+	DRAW_BUFFER2:                             DRAW_BUFFER2,
+	DRAW_BUFFER3:                             DRAW_BUFFER3,
+	DRAW_BUFFER4:                             DRAW_BUFFER4,
+	DRAW_BUFFER5:                             DRAW_BUFFER5,
+	DRAW_BUFFER6:                             DRAW_BUFFER6,
+	DRAW_BUFFER7:                             DRAW_BUFFER7,
+	DRAW_BUFFER8:                             DRAW_BUFFER8,
+	DRAW_BUFFER9:                             DRAW_BUFFER9,
+	DRAW_FRAMEBUFFER:                         DRAW_FRAMEBUFFER,
+	DRAW_FRAMEBUFFER_BINDING:                 DRAW_FRAMEBUFFER_BINDING,
+	DYNAMIC_COPY:                             DYNAMIC_COPY,
+	DYNAMIC_READ:                             DYNAMIC_READ,
+	FLOAT_32_UNSIGNED_INT_24_8_REV:           FLOAT_32_UNSIGNED_INT_24_8_REV,
+	FLOAT_MAT2x3:                             FLOAT_MAT2x3,
+	FLOAT_MAT2x4:                             FLOAT_MAT2x4,
+	FLOAT_MAT3x2:                             FLOAT_MAT3x2,
+	FLOAT_MAT3x4:                             FLOAT_MAT3x4,
+	FLOAT_MAT4x2:                             FLOAT_MAT4x2,
+	FLOAT_MAT4x3:                             FLOAT_MAT4x3,
+	FRAGMENT_SHADER_DERIVATIVE_HINT:          FRAGMENT_SHADER_DERIVATIVE_HINT,
+	FRAMEBUFFER_ATTACHMENT_ALPHA_SIZE:        FRAMEBUFFER_ATTACHMENT_ALPHA_SIZE,
+	FRAMEBUFFER_ATTACHMENT_BLUE_SIZE:         FRAMEBUFFER_ATTACHMENT_BLUE_SIZE,
+	FRAMEBUFFER_ATTACHMENT_COLOR_ENCODING:    FRAMEBUFFER_ATTACHMENT_COLOR_ENCODING,
+	FRAMEBUFFER_ATTACHMENT_COMPONENT_TYPE:    FRAMEBUFFER_ATTACHMENT_COMPONENT_TYPE,
+	FRAMEBUFFER_ATTACHMENT_DEPTH_SIZE:        FRAMEBUFFER_ATTACHMENT_DEPTH_SIZE,
+	FRAMEBUFFER_ATTACHMENT_GREEN_SIZE:        FRAMEBUFFER_ATTACHMENT_GREEN_SIZE,
+	FRAMEBUFFER_ATTACHMENT_RED_SIZE:          FRAMEBUFFER_ATTACHMENT_RED_SIZE,
+	FRAMEBUFFER_ATTACHMENT_STENCIL_SIZE:      FRAMEBUFFER_ATTACHMENT_STENCIL_SIZE,
+	FRAMEBUFFER_ATTACHMENT_TEXTURE_LAYER:     FRAMEBUFFER_ATTACHMENT_TEXTURE_LAYER,
+	FRAMEBUFFER_DEFAULT:                      FRAMEBUFFER_DEFAULT,
+	FRAMEBUFFER_INCOMPLETE_MULTISAMPLE:       FRAMEBUFFER_INCOMPLETE_MULTISAMPLE,
+	HALF_FLOAT:                               HALF_FLOAT,
+	INTERLEAVED_ATTRIBS:                      INTERLEAVED_ATTRIBS,
+	INT_2_10_10_10_REV:                       INT_2_10_10_10_REV,
+	INT_SAMPLER_2D:                           INT_SAMPLER_2D,
+	INT_SAMPLER_2D_ARRAY:                     INT_SAMPLER_2D_ARRAY,
+	INT_SAMPLER_3D:                           INT_SAMPLER_3D,
+	INT_SAMPLER_CUBE:                         INT_SAMPLER_CUBE,
+	MAX:                                      MAX,
+	MAX_3D_TEXTURE_SIZE:                      MAX_3D_TEXTURE_SIZE,
+	MAX_ARRAY_TEXTURE_LAYERS:                 MAX_ARRAY_TEXTURE_LAYERS,
+	MAX_CLIENT_WAIT_TIMEOUT_WEBGL:            MAX_CLIENT_WAIT_TIMEOUT_WEBGL,
+	MAX_COLOR_ATTACHMENTS:                    MAX_COLOR_ATTACHMENTS,
+	MAX_COMBINED_FRAGMENT_UNIFORM_COMPONENTS: MAX_COMBINED_FRAGMENT_UNIFORM_COMPONENTS,
+	MAX_COMBINED_UNIFORM_BLOCKS:              MAX_COMBINED_UNIFORM_BLOCKS,
+	MAX_COMBINED_VERTEX_UNIFORM_COMPONENTS:   MAX_COMBINED_VERTEX_UNIFORM_COMPONENTS,
+	MAX_DRAW_BUFFERS:                         MAX_DRAW_BUFFERS,
+	MAX_ELEMENTS_INDICES:                     MAX_ELEMENTS_INDICES,
+	MAX_ELEMENTS_VERTICES:                    MAX_ELEMENTS_VERTICES,
+	MAX_ELEMENT_INDEX:                        MAX_ELEMENT_INDEX,
+	MAX_FRAGMENT_INPUT_COMPONENTS:            MAX_FRAGMENT_INPUT_COMPONENTS,
+	MAX_FRAGMENT_UNIFORM_BLOCKS:              MAX_FRAGMENT_UNIFORM_BLOCKS,
+	MAX_FRAGMENT_UNIFORM_COMPONENTS:          MAX_FRAGMENT_UNIFORM_COMPONENTS,
+	MAX_PROGRAM_TEXEL_OFFSET:                 MAX_PROGRAM_TEXEL_OFFSET,
+	MAX_SAMPLES:                              MAX_SAMPLES,
+	MAX_SERVER_WAIT_TIMEOUT:                  MAX_SERVER_WAIT_TIMEOUT,
+	MAX_TEXTURE_LOD_BIAS:                     MAX_TEXTURE_LOD_BIAS,
+	MAX_TRANSFORM_FEEDBACK_INTERLEAVED_COMPONENTS: MAX_TRANSFORM_FEEDBACK_INTERLEAVED_COMPONENTS,
+	MAX_TRANSFORM_FEEDBACK_SEPARATE_ATTRIBS:       MAX_TRANSFORM_FEEDBACK_SEPARATE_ATTRIBS,
+	MAX_TRANSFORM_FEEDBACK_SEPARATE_COMPONENTS:    MAX_TRANSFORM_FEEDBACK_SEPARATE_COMPONENTS,
+	MAX_UNIFORM_BLOCK_SIZE:                        MAX_UNIFORM_BLOCK_SIZE,
+	MAX_UNIFORM_BUFFER_BINDINGS:                   MAX_UNIFORM_BUFFER_BINDINGS,
+	MAX_VARYING_COMPONENTS:                        MAX_VARYING_COMPONENTS,
+	MAX_VERTEX_OUTPUT_COMPONENTS:                  MAX_VERTEX_OUTPUT_COMPONENTS,
+	MAX_VERTEX_UNIFORM_BLOCKS:                     MAX_VERTEX_UNIFORM_BLOCKS,
+	MAX_VERTEX_UNIFORM_COMPONENTS:                 MAX_VERTEX_UNIFORM_COMPONENTS,
+	MIN:                                           MIN,
+	MIN_PROGRAM_TEXEL_OFFSET:                      MIN_PROGRAM_TEXEL_OFFSET,
+	OBJECT_TYPE:                                   OBJECT_TYPE,
+	PACK_ROW_LENGTH:                               PACK_ROW_LENGTH,
+	PACK_SKIP_PIXELS:                              PACK_SKIP_PIXELS,
+	PACK_SKIP_ROWS:                                PACK_SKIP_ROWS,
+	PIXEL_PACK_BUFFER:                             PIXEL_PACK_BUFFER,
+	PIXEL_PACK_BUFFER_BINDING:                     PIXEL_PACK_BUFFER_BINDING,
+	PIXEL_UNPACK_BUFFER:                           PIXEL_UNPACK_BUFFER,
+	PIXEL_UNPACK_BUFFER_BINDING:                   PIXEL_UNPACK_BUFFER_BINDING,
+	QUERY_RESULT:                                  QUERY_RESULT,
+	QUERY_RESULT_AVAILABLE:                        QUERY_RESULT_AVAILABLE,
+	R11F_G11F_B10F:                                R11F_G11F_B10F,
+	R16F:                                          R16F,
+	R16I:                                          R16I,
+	R16UI:                                         R16UI,
+	R32F:                                          R32F,
+	R32I:                                          R32I,
+	R32UI:                                         R32UI,
+	R8:                                            R8,
+	R8I:                                           R8I,
+	R8UI:                                          R8UI,
+	R8_SNORM:                                      R8_SNORM,
+	RASTERIZER_DISCARD:                            RASTERIZER_DISCARD,
+	READ_BUFFER:                                   READ_BUFFER,
+	READ_FRAMEBUFFER:                              READ_FRAMEBUFFER,
+	READ_FRAMEBUFFER_BINDING:                      READ_FRAMEBUFFER_BINDING,
+	RED:                                           RED,
+	RED_INTEGER:                                   RED_INTEGER,
+	RENDERBUFFER_SAMPLES:                          RENDERBUFFER_SAMPLES,
+	RG:                                            RG,
+	RG16F:                                         RG16F,
+	RG16I:                                         RG16I,
+	RG16UI:                                        RG16UI,
+	RG32F:                                         RG32F,
+	RG32I:                                         RG32I,
+	RG32UI:                                        RG32UI,
+	RG8:                                           RG8,
+	RG8I:                                          RG8I,
+	RG8UI:                                         RG8UI,
+	RG8_SNORM:                                     RG8_SNORM,
+	RGB10_A2:                                      RGB10_A2,
+	RGB10_A2UI:                                    RGB10_A2UI,
+	RGB16F:                                        RGB16F,
+	RGB16I:                                        RGB16I,
+	RGB16UI:                                       RGB16UI,
+	RGB32F:                                        RGB32F,
+	RGB32I:                                        RGB32I,
+	RGB32UI:                                       RGB32UI,
+	RGB8:                                          RGB8,
+	RGB8I:                                         RGB8I,
+	RGB8UI:                                        RGB8UI,
+	RGB8_SNORM:                                    RGB8_SNORM,
+	RGB9_E5:                                       RGB9_E5,
+	RGBA16F:                                       RGBA16F,
+	RGBA16I:                                       RGBA16I,
+	RGBA16UI:                                      RGBA16UI,
+	RGBA32F:                                       RGBA32F,
+	RGBA32I:                                       RGBA32I,
+	RGBA32UI:                                      RGBA32UI,
+	RGBA8:                                         RGBA8,
+	RGBA8I:                                        RGBA8I,
+	RGBA8UI:                                       RGBA8UI,
+	RGBA8_SNORM:                                   RGBA8_SNORM,
+	RGBA_INTEGER:                                  RGBA_INTEGER,
+	RGB_INTEGER:                                   RGB_INTEGER,
+	RG_INTEGER:                                    RG_INTEGER,
+	SAMPLER_2D_ARRAY:                              SAMPLER_2D_ARRAY,
+	SAMPLER_2D_ARRAY_SHADOW:                       SAMPLER_2D_ARRAY_SHADOW,
+	SAMPLER_2D_SHADOW:                             SAMPLER_2D_SHADOW,
+	SAMPLER_3D:                                    SAMPLER_3D,
+	SAMPLER_BINDING:                               SAMPLER_BINDING,
+	SAMPLER_CUBE_SHADOW:                           SAMPLER_CUBE_SHADOW,
+	SEPARATE_ATTRIBS:                              SEPARATE_ATTRIBS,
+	SIGNALED:                                      SIGNALED,
+	SIGNED_NORMALIZED:                             SIGNED_NORMALIZED,
+	SRGB:                                          SRGB,
+	SRGB8:                                         SRGB8,
+	SRGB8_ALPHA8:                                  SRGB8_ALPHA8,
+	STATIC_COPY:                                   STATIC_COPY,
+	STATIC_READ:                                   STATIC_READ,
+	STENCIL:                                       STENCIL,
+	STREAM_COPY:                                   STREAM_COPY,
+	STREAM_READ:                                   STREAM_READ,
+	SYNC_CONDITION:                                SYNC_CONDITION,
+	SYNC_FENCE:                                    SYNC_FENCE,
+	SYNC_FLAGS:                                    SYNC_FLAGS,
+	SYNC_FLUSH_COMMANDS_BIT:                       SYNC_FLUSH_COMMANDS_BIT,
+	SYNC_GPU_COMMANDS_COMPLETE:                    SYNC_GPU_COMMANDS_COMPLETE,
+	SYNC_STATUS:                                   SYNC_STATUS,
+	TEXTURE_2D_ARRAY:                              TEXTURE_2D_ARRAY,
+	TEXTURE_3D:                                    TEXTURE_3D,
+	TEXTURE_BASE_LEVEL:                            TEXTURE_BASE_LEVEL,
+	TEXTURE_BINDING_2D_ARRAY:                      TEXTURE_BINDING_2D_ARRAY,
+	TEXTURE_BINDING_3D:                            TEXTURE_BINDING_3D,
+	TEXTURE_COMPARE_FUNC:                          TEXTURE_COMPARE_FUNC,
+	TEXTURE_COMPARE_MODE:                          TEXTURE_COMPARE_MODE,
+	TEXTURE_IMMUTABLE_FORMAT:                      TEXTURE_IMMUTABLE_FORMAT,
+	TEXTURE_IMMUTABLE_LEVELS:                      TEXTURE_IMMUTABLE_LEVELS,
+	TEXTURE_MAX_LEVEL:                             TEXTURE_MAX_LEVEL,
+	TEXTURE_MAX_LOD:                               TEXTURE_MAX_LOD,
+	TEXTURE_MIN_LOD:                               TEXTURE_MIN_LOD,
+	TEXTURE_WRAP_R:                                TEXTURE_WRAP_R,
+	TIMEOUT_EXPIRED:                               TIMEOUT_EXPIRED,
+	TRANSFORM_FEEDBACK:                            TRANSFORM_FEEDBACK,
+	TRANSFORM_FEEDBACK_ACTIVE:                     TRANSFORM_FEEDBACK_ACTIVE,
+	TRANSFORM_FEEDBACK_BINDING:                    TRANSFORM_FEEDBACK_BINDING,
+	TRANSFORM_FEEDBACK_BUFFER:                     TRANSFORM_FEEDBACK_BUFFER,
+	TRANSFORM_FEEDBACK_BUFFER_BINDING:             TRANSFORM_FEEDBACK_BUFFER_BINDING,
+	TRANSFORM_FEEDBACK_BUFFER_MODE:                TRANSFORM_FEEDBACK_BUFFER_MODE,
+	TRANSFORM_FEEDBACK_BUFFER_SIZE:                TRANSFORM_FEEDBACK_BUFFER_SIZE,
+	TRANSFORM_FEEDBACK_BUFFER_START:               TRANSFORM_FEEDBACK_BUFFER_START,
+	TRANSFORM_FEEDBACK_PAUSED:                     TRANSFORM_FEEDBACK_PAUSED,
+	TRANSFORM_FEEDBACK_PRIMITIVES_WRITTEN:         TRANSFORM_FEEDBACK_PRIMITIVES_WRITTEN,
+	TRANSFORM_FEEDBACK_VARYINGS:                   TRANSFORM_FEEDBACK_VARYINGS,
+	UNIFORM_ARRAY_STRIDE:                          UNIFORM_ARRAY_STRIDE,
+	UNIFORM_BLOCK_ACTIVE_UNIFORMS:                 UNIFORM_BLOCK_ACTIVE_UNIFORMS,
+	UNIFORM_BLOCK_ACTIVE_UNIFORM_INDICES:          UNIFORM_BLOCK_ACTIVE_UNIFORM_INDICES,
+	UNIFORM_BLOCK_BINDING:                         UNIFORM_BLOCK_BINDING,
+	UNIFORM_BLOCK_DATA_SIZE:                       UNIFORM_BLOCK_DATA_SIZE,
+	UNIFORM_BLOCK_INDEX:                           UNIFORM_BLOCK_INDEX,
+	UNIFORM_BLOCK_REFERENCED_BY_FRAGMENT_SHADER: UNIFORM_BLOCK_REFERENCED_BY_FRAGMENT_SHADER,
+	UNIFORM_BLOCK_REFERENCED_BY_VERTEX_SHADER:   UNIFORM_BLOCK_REFERENCED_BY_VERTEX_SHADER,
+	UNIFORM_BUFFER:                  UNIFORM_BUFFER,
+	UNIFORM_BUFFER_BINDING:          UNIFORM_BUFFER_BINDING,
+	UNIFORM_BUFFER_OFFSET_ALIGNMENT: UNIFORM_BUFFER_OFFSET_ALIGNMENT,
+	UNIFORM_BUFFER_SIZE:             UNIFORM_BUFFER_SIZE,
+	UNIFORM_BUFFER_START:            UNIFORM_BUFFER_START,
+	UNIFORM_IS_ROW_MAJOR:            UNIFORM_IS_ROW_MAJOR,
+	UNIFORM_MATRIX_STRIDE:           UNIFORM_MATRIX_STRIDE,
+	UNIFORM_OFFSET:                  UNIFORM_OFFSET,
+	UNIFORM_SIZE:                    UNIFORM_SIZE,
+	UNIFORM_TYPE:                    UNIFORM_TYPE,
+	UNPACK_IMAGE_HEIGHT:             UNPACK_IMAGE_HEIGHT,
+	UNPACK_ROW_LENGTH:               UNPACK_ROW_LENGTH,
+	UNPACK_SKIP_IMAGES:              UNPACK_SKIP_IMAGES,
+	UNPACK_SKIP_PIXELS:              UNPACK_SKIP_PIXELS,
+	UNPACK_SKIP_ROWS:                UNPACK_SKIP_ROWS,
+	UNSIGNALED:                      UNSIGNALED,
+	UNSIGNED_INT_10F_11F_11F_REV:    UNSIGNED_INT_10F_11F_11F_REV,
+	UNSIGNED_INT_24_8:               UNSIGNED_INT_24_8,
+	UNSIGNED_INT_2_10_10_10_REV:     UNSIGNED_INT_2_10_10_10_REV,
+	UNSIGNED_INT_5_9_9_9_REV:        UNSIGNED_INT_5_9_9_9_REV,
+	UNSIGNED_INT_SAMPLER_2D:         UNSIGNED_INT_SAMPLER_2D,
+	UNSIGNED_INT_SAMPLER_2D_ARRAY:   UNSIGNED_INT_SAMPLER_2D_ARRAY,
+	UNSIGNED_INT_SAMPLER_3D:         UNSIGNED_INT_SAMPLER_3D,
+	UNSIGNED_INT_SAMPLER_CUBE:       UNSIGNED_INT_SAMPLER_CUBE,
+	UNSIGNED_INT_VEC2:               UNSIGNED_INT_VEC2,
+	UNSIGNED_INT_VEC3:               UNSIGNED_INT_VEC3,
+	UNSIGNED_INT_VEC4:               UNSIGNED_INT_VEC4,
+	UNSIGNED_NORMALIZED:             UNSIGNED_NORMALIZED,
+	VERTEX_ARRAY_BINDING:            VERTEX_ARRAY_BINDING,
+	VERTEX_ATTRIB_ARRAY_DIVISOR:     VERTEX_ATTRIB_ARRAY_DIVISOR,
+	VERTEX_ATTRIB_ARRAY_INTEGER:     VERTEX_ATTRIB_ARRAY_INTEGER,
+	WAIT_FAILED:                     WAIT_FAILED,
+}
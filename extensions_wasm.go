@@ -0,0 +1,116 @@
+// Copyright 2014 Joseph Hager. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// +build wasm
+
+package webgl
+
+import (
+	"fmt"
+
+	"github.com/gopherjs/gopherwasm/js"
+)
+
+// extension enables the extension name, returning an error if the context
+// does not support it.
+func (c *Context) extension(name string) (js.Value, error) {
+	ext := c.Call("getExtension", name)
+	if ext == null {
+		return null, fmt.Errorf("webgl: %s is not supported", name)
+	}
+	return ext, nil
+}
+
+// InstancedArrays is the ANGLE_instanced_arrays extension, which draws
+// several instances of the same vertices in one call. Attributes with a
+// non-zero divisor advance once per that many instances instead of once
+// per vertex.
+type InstancedArrays struct {
+	*js.Value
+}
+
+// Enables and returns the ANGLE_instanced_arrays extension. It returns an
+// error if the context does not support it.
+func (c *Context) InstancedArrays() (*InstancedArrays, error) {
+	ext, err := c.extension("ANGLE_instanced_arrays")
+	if err != nil {
+		return nil, err
+	}
+	c.instancedArrays = &InstancedArrays{&ext}
+	return c.instancedArrays, nil
+}
+
+// Draws primcount instances of the primitives in the vertex arrays, from
+// first to first+count.
+func (ext *InstancedArrays) DrawArraysInstanced(mode GLenum, first, count, primcount int) {
+	ext.Call("drawArraysInstancedANGLE", mode, first, count, primcount)
+}
+
+// Draws primcount instances of the primitives indexed by count elements of
+// type typ, starting at byte offset in the ELEMENT_ARRAY_BUFFER.
+func (ext *InstancedArrays) DrawElementsInstanced(mode GLenum, count int, typ GLenum, offset, primcount int) {
+	ext.Call("drawElementsInstancedANGLE", mode, count, typ, offset, primcount)
+}
+
+// Sets the number of instances drawn before the attribute at index
+// advances. A divisor of 0, the default, advances it once per vertex.
+func (ext *InstancedArrays) VertexAttribDivisor(index, divisor int) {
+	ext.Call("vertexAttribDivisorANGLE", index, divisor)
+}
+
+// vertexArrayExt is the OES_vertex_array_object extension object.
+type vertexArrayExt struct {
+	v js.Value
+}
+
+// vertexArrayExt enables OES_vertex_array_object, returning nil if the
+// context does not support it.
+func (c *Context) vertexArrayExt() *vertexArrayExt {
+	ext, err := c.extension("OES_vertex_array_object")
+	if err != nil {
+		return nil
+	}
+	return &vertexArrayExt{ext}
+}
+
+func (ext *vertexArrayExt) create() VertexArray {
+	return VertexArray{wrap(ext.v.Call("createVertexArrayOES"))}
+}
+
+func (ext *vertexArrayExt) bind(vertexArray VertexArray) {
+	ext.v.Call("bindVertexArrayOES", vertexArray.jsValue())
+}
+
+func (ext *vertexArrayExt) delete(vertexArray VertexArray) {
+	ext.v.Call("deleteVertexArrayOES", vertexArray.jsValue())
+}
+
+func (ext *vertexArrayExt) is(vertexArray VertexArray) bool {
+	return ext.v.Call("isVertexArrayOES", vertexArray.jsValue()).Bool()
+}
+
+// DrawBuffers is the WEBGL_draw_buffers extension, which lets a fragment
+// shader write to several color attachments of a framebuffer at once,
+// through gl_FragData[i].
+type DrawBuffers struct {
+	*js.Value
+	c *Context
+}
+
+// Enables and returns the WEBGL_draw_buffers extension. It returns an
+// error if the context does not support it.
+func (c *Context) DrawBuffers() (*DrawBuffers, error) {
+	ext, err := c.extension("WEBGL_draw_buffers")
+	if err != nil {
+		return nil, err
+	}
+	return &DrawBuffers{Value: &ext, c: c}, nil
+}
+
+// Sets the attachments of the bound framebuffer that gl_FragData[i] is
+// written to, buffers[i] being COLOR_ATTACHMENTi_WEBGL or NONE. For the
+// default framebuffer, buffers holds a single BACK or NONE.
+func (ext *DrawBuffers) DrawBuffers(buffers []GLenum) {
+	ext.Call("drawBuffersWEBGL", enumsToJS(buffers))
+}
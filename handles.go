@@ -0,0 +1,128 @@
+// Copyright 2014 Joseph Hager. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package webgl
+
+// The handle types below wrap the WebGL objects returned by the Create*
+// methods of Context and Context2. Each kind of object has its own type, so
+// passing a Texture where a Buffer is expected does not compile. The zero
+// value of every handle type is passed to WebGL as null.
+//
+// Backend implementations that are not backed by JavaScript create their
+// handles with the *FromID functions and tell them apart by ID. Copies of a
+// handle compare equal with ==, but two handles made from the same id do not.
+
+// Buffer is a WebGLBuffer.
+type Buffer struct{ *object }
+
+// Framebuffer is a WebGLFramebuffer.
+type Framebuffer struct{ *object }
+
+// Program is a WebGLProgram.
+type Program struct{ *object }
+
+// Renderbuffer is a WebGLRenderbuffer.
+type Renderbuffer struct{ *object }
+
+// Shader is a WebGLShader.
+type Shader struct{ *object }
+
+// Texture is a WebGLTexture.
+type Texture struct{ *object }
+
+// UniformLocation is a WebGLUniformLocation.
+type UniformLocation struct{ *object }
+
+// Query is a WebGL 2.0 WebGLQuery.
+type Query struct{ *object }
+
+// Sampler is a WebGL 2.0 WebGLSampler.
+type Sampler struct{ *object }
+
+// Sync is a WebGL 2.0 WebGLSync.
+type Sync struct{ *object }
+
+// TransformFeedback is a WebGL 2.0 WebGLTransformFeedback.
+type TransformFeedback struct{ *object }
+
+// VertexArray is a WebGL 2.0 WebGLVertexArrayObject, or a vertex array of
+// the OES_vertex_array_object extension.
+type VertexArray struct{ *object }
+
+// ID returns the id the handle was created with by one of the *FromID
+// functions. It is 0 for zero handles and for handles created by Context.
+func (o *object) ID() uint32 {
+	if o == nil {
+		return 0
+	}
+	return o.id
+}
+
+// handle returns the object for a handle with the given id.
+func handle(id uint32) *object {
+	if id == 0 {
+		return nil
+	}
+	return &object{id: id}
+}
+
+// BufferFromID returns a Buffer identified by id. An id of 0 returns the zero Buffer.
+func BufferFromID(id uint32) Buffer {
+	return Buffer{handle(id)}
+}
+
+// FramebufferFromID returns a Framebuffer identified by id. An id of 0 returns the zero Framebuffer.
+func FramebufferFromID(id uint32) Framebuffer {
+	return Framebuffer{handle(id)}
+}
+
+// ProgramFromID returns a Program identified by id. An id of 0 returns the zero Program.
+func ProgramFromID(id uint32) Program {
+	return Program{handle(id)}
+}
+
+// RenderbufferFromID returns a Renderbuffer identified by id. An id of 0 returns the zero Renderbuffer.
+func RenderbufferFromID(id uint32) Renderbuffer {
+	return Renderbuffer{handle(id)}
+}
+
+// ShaderFromID returns a Shader identified by id. An id of 0 returns the zero Shader.
+func ShaderFromID(id uint32) Shader {
+	return Shader{handle(id)}
+}
+
+// TextureFromID returns a Texture identified by id. An id of 0 returns the zero Texture.
+func TextureFromID(id uint32) Texture {
+	return Texture{handle(id)}
+}
+
+// UniformLocationFromID returns a UniformLocation identified by id. An id of 0 returns the zero UniformLocation.
+func UniformLocationFromID(id uint32) UniformLocation {
+	return UniformLocation{handle(id)}
+}
+
+// QueryFromID returns a Query identified by id. An id of 0 returns the zero Query.
+func QueryFromID(id uint32) Query {
+	return Query{handle(id)}
+}
+
+// SamplerFromID returns a Sampler identified by id. An id of 0 returns the zero Sampler.
+func SamplerFromID(id uint32) Sampler {
+	return Sampler{handle(id)}
+}
+
+// SyncFromID returns a Sync identified by id. An id of 0 returns the zero Sync.
+func SyncFromID(id uint32) Sync {
+	return Sync{handle(id)}
+}
+
+// TransformFeedbackFromID returns a TransformFeedback identified by id. An id of 0 returns the zero TransformFeedback.
+func TransformFeedbackFromID(id uint32) TransformFeedback {
+	return TransformFeedback{handle(id)}
+}
+
+// VertexArrayFromID returns a VertexArray identified by id. An id of 0 returns the zero VertexArray.
+func VertexArrayFromID(id uint32) VertexArray {
+	return VertexArray{handle(id)}
+}
@@ -0,0 +1,348 @@
+// Copyright 2014 Joseph Hager. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package webgl
+
+import (
+	"fmt"
+	"image"
+	"image/color"
+	"image/draw"
+	"reflect"
+)
+
+// ReadImage reads the whole drawing buffer of b into an image. It should
+// be called with the default framebuffer bound; for framebuffer objects,
+// use ReadImageRect with the size of their attachments.
+func ReadImage(b Backend) *image.RGBA {
+	return ReadImageRect(b, image.Rect(0, 0, b.DrawingBufferWidth(), b.DrawingBufferHeight()))
+}
+
+// ReadImageRect reads rect, given in window coordinates with the origin
+// at the bottom left, from the color buffer of the bound framebuffer. The
+// returned image has the size of rect with its origin at (0, 0), and its
+// top row first as image.RGBA expects, so the WebGL row order is flipped.
+//
+// Pixels are read as RGBA, UNSIGNED_BYTE with PACK_ALIGNMENT at its
+// default of 4 or less. They hold premultiplied alpha, as image.RGBA
+// expects, when the context was created with PremultipliedAlpha.
+func ReadImageRect(b Backend, rect image.Rectangle) *image.RGBA {
+	img := image.NewRGBA(image.Rect(0, 0, rect.Dx(), rect.Dy()))
+	if rect.Empty() {
+		return img
+	}
+	b.ReadPixelsBytes(rect.Min.X, rect.Min.Y, rect.Dx(), rect.Dy(), RGBA, UNSIGNED_BYTE, img.Pix)
+	flipRows(img.Pix, img.Stride, rect.Dy())
+	return img
+}
+
+// flipRows reverses the order of the height rows of stride bytes in pix.
+func flipRows(pix []byte, stride, height int) {
+	tmp := make([]byte, stride)
+	for top, bottom := 0, height-1; top < bottom; top, bottom = top+1, bottom-1 {
+		t := pix[top*stride : (top+1)*stride]
+		b := pix[bottom*stride : (bottom+1)*stride]
+		copy(tmp, t)
+		copy(t, b)
+		copy(b, tmp)
+	}
+}
+
+// PixelOptions controls how the texture upload helpers prepare pixels
+// before passing them to WebGL. A nil *PixelOptions uses the zero value.
+//
+// The helpers flip and premultiply in Go, so UNPACK_FLIP_Y_WEBGL and
+// UNPACK_PREMULTIPLY_ALPHA_WEBGL should be left false while using them.
+type PixelOptions struct {
+	// FlipY reverses the order of the rows. Images are stored top row
+	// first, while WebGL expects the bottom row first.
+	FlipY bool
+
+	// Premultiply stores colors multiplied by alpha. Otherwise they are
+	// stored unmultiplied, so premultiplied sources like *image.RGBA are
+	// converted.
+	Premultiply bool
+
+	// Alignment is the row alignment, 1, 2, 4 or 8, to which rows are
+	// padded. The helpers set UNPACK_ALIGNMENT to it before uploading, and
+	// leave it set. Zero means the default of 4.
+	Alignment int
+}
+
+func (o *PixelOptions) alignment() int {
+	if o == nil || o.Alignment == 0 {
+		return 4
+	}
+	return o.Alignment
+}
+
+// TexImage2DImage defines a texture image from img. *image.RGBA and
+// *image.NRGBA images are uploaded as RGBA, *image.Gray as LUMINANCE and
+// *image.YCbCr as RGB, all with UNSIGNED_BYTE; other images are converted
+// to RGBA.
+func TexImage2DImage(b Backend, target GLenum, level int, img image.Image, opts *PixelOptions) {
+	width, height, format, pix := imagePixels(img, opts)
+	b.PixelStorei(UNPACK_ALIGNMENT, opts.alignment())
+	b.TexImage2DBytes(target, level, format, width, height, 0, format, UNSIGNED_BYTE, pix)
+}
+
+// TexSubImage2DImage replaces the portion of a texture image at xoffset,
+// yoffset with img, which is converted as in TexImage2DImage and must
+// match the format of the texture image.
+func TexSubImage2DImage(b Backend, target GLenum, level, xoffset, yoffset int, img image.Image, opts *PixelOptions) {
+	width, height, format, pix := imagePixels(img, opts)
+	b.PixelStorei(UNPACK_ALIGNMENT, opts.alignment())
+	b.TexSubImage2DBytes(target, level, xoffset, yoffset, width, height, format, UNSIGNED_BYTE, pix)
+}
+
+// TexImage2DPixels defines a width by height texture image from pixels,
+// which holds tightly packed pixels of the given format and type: a []byte
+// for UNSIGNED_BYTE, a []uint16 for the packed UNSIGNED_SHORT types and a
+// []float32 for FLOAT. It returns an error if pixels has another type or
+// is too short.
+func TexImage2DPixels(b Backend, target GLenum, level int, width, height int, format, typ GLenum, pixels interface{}, opts *PixelOptions) error {
+	pixels, err := packPixels(width, height, format, typ, pixels, opts)
+	if err != nil {
+		return err
+	}
+	b.PixelStorei(UNPACK_ALIGNMENT, opts.alignment())
+	switch p := pixels.(type) {
+	case []byte:
+		b.TexImage2DBytes(target, level, format, width, height, 0, format, typ, p)
+	case []uint16:
+		b.TexImage2DUint16(target, level, format, width, height, 0, format, typ, p)
+	case []float32:
+		b.TexImage2DFloat32(target, level, format, width, height, 0, format, typ, p)
+	}
+	return nil
+}
+
+// TexSubImage2DPixels replaces a width by height portion of a texture
+// image at xoffset, yoffset with pixels, as in TexImage2DPixels.
+func TexSubImage2DPixels(b Backend, target GLenum, level, xoffset, yoffset, width, height int, format, typ GLenum, pixels interface{}, opts *PixelOptions) error {
+	pixels, err := packPixels(width, height, format, typ, pixels, opts)
+	if err != nil {
+		return err
+	}
+	b.PixelStorei(UNPACK_ALIGNMENT, opts.alignment())
+	switch p := pixels.(type) {
+	case []byte:
+		b.TexSubImage2DBytes(target, level, xoffset, yoffset, width, height, format, typ, p)
+	case []uint16:
+		b.TexSubImage2DUint16(target, level, xoffset, yoffset, width, height, format, typ, p)
+	case []float32:
+		b.TexSubImage2DFloat32(target, level, xoffset, yoffset, width, height, format, typ, p)
+	}
+	return nil
+}
+
+// imagePixels returns the pixels of img packed for upload.
+func imagePixels(img image.Image, opts *PixelOptions) (width, height int, format GLenum, pix []byte) {
+	r := img.Bounds()
+	width, height = r.Dx(), r.Dy()
+	premultiply := opts != nil && opts.Premultiply
+	var size int
+	var row func(y int, dst []byte)
+	switch img := img.(type) {
+	case *image.RGBA:
+		format, size = RGBA, 4
+		row = func(y int, dst []byte) {
+			copy(dst, img.Pix[img.PixOffset(r.Min.X, y):])
+			if !premultiply {
+				unpremultiply(dst)
+			}
+		}
+	case *image.Gray:
+		format, size = LUMINANCE, 1
+		row = func(y int, dst []byte) {
+			copy(dst, img.Pix[img.PixOffset(r.Min.X, y):])
+		}
+	case *image.YCbCr:
+		format, size = RGB, 3
+		row = func(y int, dst []byte) {
+			for x := 0; x < width; x++ {
+				c := img.YCbCrAt(r.Min.X+x, y)
+				dst[3*x], dst[3*x+1], dst[3*x+2] = color.YCbCrToRGB(c.Y, c.Cb, c.Cr)
+			}
+		}
+	default:
+		nrgba, ok := img.(*image.NRGBA)
+		if !ok {
+			nrgba = image.NewNRGBA(r)
+			draw.Draw(nrgba, r, img, r.Min, draw.Src)
+		}
+		format, size = RGBA, 4
+		row = func(y int, dst []byte) {
+			copy(dst, nrgba.Pix[nrgba.PixOffset(r.Min.X, y):])
+			if premultiply {
+				premultiplyBytes(dst, 4)
+			}
+		}
+	}
+	stride := alignUp(width*size, opts.alignment())
+	if width == 0 || height == 0 {
+		return width, height, format, nil
+	}
+	pix = make([]byte, (height-1)*stride+width*size)
+	for j := 0; j < height; j++ {
+		y := j
+		if opts != nil && opts.FlipY {
+			y = height - 1 - j
+		}
+		row(r.Min.Y+y, pix[j*stride:j*stride+width*size])
+	}
+	return width, height, format, pix
+}
+
+// packPixels returns pixels with its rows padded to the alignment and
+// flipped and premultiplied as opts say. It returns pixels itself if
+// there is nothing to do.
+func packPixels(width, height int, format, typ GLenum, pixels interface{}, opts *PixelOptions) (interface{}, error) {
+	var elemSize int
+	switch pixels.(type) {
+	case []byte:
+		elemSize = 1
+	case []uint16:
+		elemSize = 2
+	case []float32:
+		elemSize = 4
+	default:
+		return nil, fmt.Errorf("webgl: unsupported pixel slice type %T", pixels)
+	}
+	n := components(format)
+	if n == 0 {
+		return nil, fmt.Errorf("webgl: unsupported pixel format %v", format)
+	}
+	// A pixel of a packed type is one uint16 holding all its components.
+	var packedFormat GLenum
+	switch typ {
+	case UNSIGNED_BYTE:
+		if elemSize != 1 {
+			return nil, fmt.Errorf("webgl: %T cannot hold pixels of type %v, which need a []byte", pixels, typ)
+		}
+	case UNSIGNED_SHORT_5_6_5:
+		packedFormat = RGB
+	case UNSIGNED_SHORT_4_4_4_4, UNSIGNED_SHORT_5_5_5_1:
+		packedFormat = RGBA
+	case FLOAT:
+		if elemSize != 4 {
+			return nil, fmt.Errorf("webgl: %T cannot hold pixels of type %v, which need a []float32", pixels, typ)
+		}
+	default:
+		return nil, fmt.Errorf("webgl: unsupported pixel type %v", typ)
+	}
+	if packedFormat != 0 {
+		if elemSize != 2 {
+			return nil, fmt.Errorf("webgl: %T cannot hold pixels of type %v, which need a []uint16", pixels, typ)
+		}
+		if format != packedFormat {
+			return nil, fmt.Errorf("webgl: pixels of type %v have format %v, not %v", typ, packedFormat, format)
+		}
+		n = 1
+	}
+	rowLen := width * n
+	stride := alignUp(rowLen*elemSize, opts.alignment()) / elemSize
+	flip := opts != nil && opts.FlipY
+	premultiply := opts != nil && opts.Premultiply && (format == RGBA || format == LUMINANCE_ALPHA)
+
+	v := reflect.ValueOf(pixels)
+	if v.Len() < width*height*n {
+		return nil, fmt.Errorf("webgl: %dx%d pixels need %d elements, have %d", width, height, width*height*n, v.Len())
+	}
+	if width == 0 || height == 0 || !flip && !premultiply && (stride == rowLen || height == 1) {
+		return pixels, nil
+	}
+	out := reflect.MakeSlice(v.Type(), (height-1)*stride+rowLen, (height-1)*stride+rowLen)
+	for j := 0; j < height; j++ {
+		y := j
+		if flip {
+			y = height - 1 - j
+		}
+		reflect.Copy(out.Slice(j*stride, j*stride+rowLen), v.Slice(y*rowLen, (y+1)*rowLen))
+	}
+	if premultiply {
+		for j := 0; j < height; j++ {
+			switch row := out.Slice(j*stride, j*stride+rowLen).Interface().(type) {
+			case []byte:
+				premultiplyBytes(row, n)
+			case []uint16:
+				premultiplyPacked(row, typ)
+			case []float32:
+				for i := n - 1; i < len(row); i += n {
+					for k := i - n + 1; k < i; k++ {
+						row[k] *= row[i]
+					}
+				}
+			}
+		}
+	}
+	return out.Interface(), nil
+}
+
+// components returns the number of components of a pixel format.
+func components(format GLenum) int {
+	switch format {
+	case ALPHA, LUMINANCE:
+		return 1
+	case LUMINANCE_ALPHA:
+		return 2
+	case RGB:
+		return 3
+	case RGBA:
+		return 4
+	}
+	return 0
+}
+
+func alignUp(n, alignment int) int {
+	return (n + alignment - 1) / alignment * alignment
+}
+
+// premultiplyBytes multiplies the color of pixels of n components, alpha
+// last, by their alpha.
+func premultiplyBytes(pix []byte, n int) {
+	for i := n - 1; i < len(pix); i += n {
+		a := int(pix[i])
+		for k := i - n + 1; k < i; k++ {
+			pix[k] = byte((int(pix[k])*a + 127) / 255)
+		}
+	}
+}
+
+// unpremultiply divides the color of RGBA pixels by their alpha.
+func unpremultiply(pix []byte) {
+	for i := 0; i < len(pix); i += 4 {
+		a := int(pix[i+3])
+		if a == 0 || a == 0xff {
+			continue
+		}
+		for k := i; k < i+3; k++ {
+			// Colors above alpha are not valid premultiplied colors, and
+			// are clamped.
+			c := (int(pix[k])*0xff + a/2) / a
+			if c > 0xff {
+				c = 0xff
+			}
+			pix[k] = byte(c)
+		}
+	}
+}
+
+// premultiplyPacked multiplies the color of packed RGBA pixels by their
+// alpha.
+func premultiplyPacked(pix []uint16, typ GLenum) {
+	for i, v := range pix {
+		switch typ {
+		case UNSIGNED_SHORT_4_4_4_4:
+			a := v & 15
+			r, g, b := v>>12, v>>8&15, v>>4&15
+			r, g, b = (r*a+7)/15, (g*a+7)/15, (b*a+7)/15
+			pix[i] = r<<12 | g<<8 | b<<4 | a
+		case UNSIGNED_SHORT_5_5_5_1:
+			if v&1 == 0 {
+				pix[i] = 0
+			}
+		}
+	}
+}
@@ -0,0 +1,283 @@
+// Copyright 2014 Joseph Hager. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package webgl
+
+import (
+	"image"
+	"image/color"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestPackPixels(t *testing.T) {
+	tests := []struct {
+		name          string
+		width, height int
+		format, typ   GLenum
+		pixels        interface{}
+		opts          *PixelOptions
+		want          interface{}
+		err           string
+	}{
+		{
+			name:  "aligned",
+			width: 2, height: 2, format: RGBA, typ: UNSIGNED_BYTE,
+			pixels: []byte{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16},
+			want:   []byte{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16},
+		},
+		{
+			name:  "padded",
+			width: 1, height: 2, format: RGB, typ: UNSIGNED_BYTE,
+			pixels: []byte{1, 2, 3, 4, 5, 6},
+			want:   []byte{1, 2, 3, 0, 4, 5, 6},
+		},
+		{
+			name:  "flipped",
+			width: 1, height: 3, format: LUMINANCE, typ: UNSIGNED_BYTE,
+			pixels: []byte{1, 2, 3},
+			opts:   &PixelOptions{FlipY: true, Alignment: 1},
+			want:   []byte{3, 2, 1},
+		},
+		{
+			name:  "premultiplied bytes",
+			width: 1, height: 1, format: RGBA, typ: UNSIGNED_BYTE,
+			pixels: []byte{255, 128, 0, 128},
+			opts:   &PixelOptions{Premultiply: true},
+			want:   []byte{128, 64, 0, 128},
+		},
+		{
+			name:  "premultiply without alpha",
+			width: 1, height: 1, format: RGB, typ: UNSIGNED_BYTE,
+			pixels: []byte{255, 128, 0},
+			opts:   &PixelOptions{Premultiply: true},
+			want:   []byte{255, 128, 0},
+		},
+		{
+			name:  "5_6_5",
+			width: 3, height: 2, format: RGB, typ: UNSIGNED_SHORT_5_6_5,
+			pixels: []uint16{1, 2, 3, 4, 5, 6},
+			want:   []uint16{1, 2, 3, 0, 4, 5, 6},
+		},
+		{
+			name:  "premultiplied 4_4_4_4",
+			width: 1, height: 1, format: RGBA, typ: UNSIGNED_SHORT_4_4_4_4,
+			pixels: []uint16{0xff08},
+			opts:   &PixelOptions{Premultiply: true},
+			want:   []uint16{0x8808},
+		},
+		{
+			name:  "premultiplied 5_5_5_1",
+			width: 2, height: 1, format: RGBA, typ: UNSIGNED_SHORT_5_5_5_1,
+			pixels: []uint16{0xfffe, 0xffff},
+			opts:   &PixelOptions{Premultiply: true},
+			want:   []uint16{0, 0xffff},
+		},
+		{
+			name:  "premultiplied floats",
+			width: 1, height: 2, format: LUMINANCE_ALPHA, typ: FLOAT,
+			pixels: []float32{0.5, 0.5, 1, 0},
+			opts:   &PixelOptions{Premultiply: true},
+			want:   []float32{0.25, 0.5, 0, 0},
+		},
+		{
+			name:  "too short",
+			width: 2, height: 2, format: RGB, typ: UNSIGNED_BYTE,
+			pixels: make([]byte, 11),
+			err:    "need 12 elements, have 11",
+		},
+		{
+			name:  "packed too short",
+			width: 2, height: 2, format: RGB, typ: UNSIGNED_SHORT_5_6_5,
+			pixels: make([]uint16, 3),
+			err:    "need 4 elements, have 3",
+		},
+		{
+			name:  "bytes for 5_6_5",
+			width: 1, height: 1, format: RGB, typ: UNSIGNED_SHORT_5_6_5,
+			pixels: []byte{1, 2},
+			err:    "need a []uint16",
+		},
+		{
+			name:  "uint16s for UNSIGNED_BYTE",
+			width: 1, height: 1, format: LUMINANCE, typ: UNSIGNED_BYTE,
+			pixels: []uint16{1},
+			err:    "need a []byte",
+		},
+		{
+			name:  "floats for UNSIGNED_BYTE",
+			width: 1, height: 1, format: LUMINANCE, typ: UNSIGNED_BYTE,
+			pixels: []float32{1},
+			err:    "need a []byte",
+		},
+		{
+			name:  "bytes for FLOAT",
+			width: 1, height: 1, format: LUMINANCE, typ: FLOAT,
+			pixels: []byte{1, 2, 3, 4},
+			err:    "need a []float32",
+		},
+		{
+			name:  "format of 4_4_4_4",
+			width: 1, height: 1, format: RGB, typ: UNSIGNED_SHORT_4_4_4_4,
+			pixels: []uint16{1},
+			err:    "have format RGBA",
+		},
+		{
+			name:  "unsupported slice",
+			width: 1, height: 1, format: LUMINANCE, typ: UNSIGNED_BYTE,
+			pixels: []int32{1},
+			err:    "unsupported pixel slice type",
+		},
+		{
+			name:  "unsupported format",
+			width: 1, height: 1, format: DEPTH_COMPONENT, typ: UNSIGNED_BYTE,
+			pixels: []byte{1},
+			err:    "unsupported pixel format",
+		},
+		{
+			name:  "unsupported type",
+			width: 1, height: 1, format: LUMINANCE, typ: UNSIGNED_INT,
+			pixels: []byte{1, 2, 3, 4},
+			err:    "unsupported pixel type",
+		},
+	}
+	for _, test := range tests {
+		got, err := packPixels(test.width, test.height, test.format, test.typ, test.pixels, test.opts)
+		if test.err != "" {
+			if err == nil || !strings.Contains(err.Error(), test.err) {
+				t.Errorf("%s: error %v, want one containing %q", test.name, err, test.err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%s: %v", test.name, err)
+			continue
+		}
+		if !reflect.DeepEqual(got, test.want) {
+			t.Errorf("%s: pixels %v, want %v", test.name, got, test.want)
+		}
+	}
+
+	// Pixels that need no change are returned as they are.
+	pixels := []byte{1, 2, 3, 4}
+	got, _ := packPixels(1, 1, RGBA, UNSIGNED_BYTE, pixels, nil)
+	if p := got.([]byte); &p[0] != &pixels[0] {
+		t.Error("packPixels copied pixels that need no change")
+	}
+}
+
+func TestImagePixels(t *testing.T) {
+	rgba := image.NewRGBA(image.Rect(0, 0, 2, 1))
+	rgba.Pix = []byte{128, 64, 0, 128, 10, 20, 30, 255}
+
+	gray := image.NewGray(image.Rect(0, 0, 3, 2))
+	gray.Pix = []byte{1, 2, 3, 4, 5, 6}
+
+	nrgba := image.NewNRGBA(image.Rect(0, 0, 1, 1))
+	nrgba.Pix = []byte{255, 128, 0, 128}
+
+	ycbcr := image.NewYCbCr(image.Rect(0, 0, 1, 1), image.YCbCrSubsampleRatio444)
+	ycbcr.Y[0], ycbcr.Cb[0], ycbcr.Cr[0] = 255, 128, 128
+
+	paletted := image.NewPaletted(image.Rect(0, 0, 1, 1), color.Palette{color.NRGBA{1, 2, 3, 255}})
+
+	tests := []struct {
+		name          string
+		img           image.Image
+		opts          *PixelOptions
+		width, height int
+		format        GLenum
+		pix           []byte
+	}{
+		{
+			name:  "RGBA",
+			img:   rgba,
+			width: 2, height: 1, format: RGBA,
+			pix: []byte{255, 128, 0, 128, 10, 20, 30, 255},
+		},
+		{
+			name:  "premultiplied RGBA",
+			img:   rgba,
+			opts:  &PixelOptions{Premultiply: true},
+			width: 2, height: 1, format: RGBA,
+			pix: []byte{128, 64, 0, 128, 10, 20, 30, 255},
+		},
+		{
+			name:  "RGBA sub-image",
+			img:   rgba.SubImage(image.Rect(1, 0, 2, 1)),
+			width: 1, height: 1, format: RGBA,
+			pix: []byte{10, 20, 30, 255},
+		},
+		{
+			name:  "gray",
+			img:   gray,
+			width: 3, height: 2, format: LUMINANCE,
+			pix: []byte{1, 2, 3, 0, 4, 5, 6},
+		},
+		{
+			name:  "flipped gray",
+			img:   gray,
+			opts:  &PixelOptions{FlipY: true, Alignment: 1},
+			width: 3, height: 2, format: LUMINANCE,
+			pix: []byte{4, 5, 6, 1, 2, 3},
+		},
+		{
+			name:  "NRGBA",
+			img:   nrgba,
+			width: 1, height: 1, format: RGBA,
+			pix: []byte{255, 128, 0, 128},
+		},
+		{
+			name:  "premultiplied NRGBA",
+			img:   nrgba,
+			opts:  &PixelOptions{Premultiply: true},
+			width: 1, height: 1, format: RGBA,
+			pix: []byte{128, 64, 0, 128},
+		},
+		{
+			name:  "YCbCr",
+			img:   ycbcr,
+			width: 1, height: 1, format: RGB,
+			pix: []byte{255, 255, 255},
+		},
+		{
+			name:  "converted",
+			img:   paletted,
+			width: 1, height: 1, format: RGBA,
+			pix: []byte{1, 2, 3, 255},
+		},
+		{
+			name:  "empty",
+			img:   image.NewRGBA(image.Rect(0, 0, 0, 4)),
+			width: 0, height: 4, format: RGBA,
+		},
+	}
+	for _, test := range tests {
+		width, height, format, pix := imagePixels(test.img, test.opts)
+		if width != test.width || height != test.height || format != test.format || !reflect.DeepEqual(pix, test.pix) {
+			t.Errorf("%s: imagePixels = %d, %d, %v, %v, want %d, %d, %v, %v", test.name,
+				width, height, format, pix, test.width, test.height, test.format, test.pix)
+		}
+	}
+}
+
+func TestUnpremultiply(t *testing.T) {
+	pix := []byte{
+		128, 64, 0, 128,
+		10, 20, 30, 0, // transparent
+		10, 20, 30, 255, // opaque
+		200, 50, 0, 100, // red above alpha
+	}
+	want := []byte{
+		255, 128, 0, 128,
+		10, 20, 30, 0,
+		10, 20, 30, 255,
+		255, 128, 0, 100,
+	}
+	unpremultiply(pix)
+	if !reflect.DeepEqual(pix, want) {
+		t.Errorf("unpremultiply = %v, want %v", pix, want)
+	}
+}
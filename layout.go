@@ -0,0 +1,240 @@
+// Copyright 2014 Joseph Hager. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package webgl
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// VertexAttribute is an attribute of the vertices in a VertexLayout.
+type VertexAttribute struct {
+	// Name is the name of the attribute in the vertex shader.
+	Name string
+
+	// Size is the number of components, 1 to 4, of type Type, which is
+	// FLOAT, BYTE, UNSIGNED_BYTE, SHORT or UNSIGNED_SHORT.
+	Size int
+	Type GLenum
+
+	// Normalized maps integer components to [-1, 1] for signed types and
+	// [0, 1] for unsigned ones, instead of converting them to float as is.
+	Normalized bool
+
+	// Offset is the byte offset of the attribute within a vertex.
+	Offset int
+
+	// Divisor makes the attribute advance once per Divisor instances
+	// instead of once per vertex, when drawn by ApplyInstanced. The
+	// vertices then hold per-instance data.
+	Divisor int
+}
+
+// VertexLayout describes the vertices in a buffer of interleaved
+// attributes, so that VertexAttribPointer can be called for all of them
+// at once.
+type VertexLayout struct {
+	Attributes []VertexAttribute
+
+	// Stride is the size of a vertex in bytes.
+	Stride int
+
+	locations map[Program][]int
+}
+
+// NewVertexLayout returns the layout of vertices made of attribs, in that
+// order. Their offsets are computed, aligning each to the size of its
+// type as WebGL requires, as is the stride. An error is returned if the
+// stride is more than 255 bytes, the largest WebGL allows.
+func NewVertexLayout(attribs ...VertexAttribute) (*VertexLayout, error) {
+	l := &VertexLayout{Attributes: append([]VertexAttribute(nil), attribs...)}
+	align := 1
+	for i := range l.Attributes {
+		a := &l.Attributes[i]
+		n := typeSize(a.Type)
+		a.Offset = alignUp(l.Stride, n)
+		l.Stride = a.Offset + a.Size*n
+		if n > align {
+			align = n
+		}
+	}
+	l.Stride = alignUp(l.Stride, align)
+	if l.Stride > 255 {
+		return nil, fmt.Errorf("webgl: vertex of %d attributes is %d bytes, more than the largest stride of 255", len(attribs), l.Stride)
+	}
+	return l, nil
+}
+
+// VertexLayoutOf returns the layout of vertices stored as values of the
+// struct type of v, a struct or a pointer to one. Fields are bound to
+// attributes by their gl tag, with the option normalized for normalized
+// integer attributes:
+//
+//	type Vertex struct {
+//		Position [3]float32 `gl:"a_position"`
+//		Normal   [3]int16   `gl:"a_normal,normalized"`
+//		Color    [4]uint8   `gl:"a_color,normalized"`
+//	}
+//
+// Per-instance attributes take the option divisor=n, which sets their
+// Divisor:
+//
+//	type Instance struct {
+//		Offset [2]float32 `gl:"a_offset,divisor=1"`
+//	}
+//
+// A field holds a scalar or an array of one to four scalars of type
+// float32, int8, uint8, int16 or uint16. Fields without a gl tag, or with
+// the tag "-", are padding. The offsets and stride are those of the Go
+// struct, so a []Vertex can be uploaded to the buffer as is.
+func VertexLayoutOf(v interface{}) (*VertexLayout, error) {
+	t := reflect.TypeOf(v)
+	if t != nil && t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	if t == nil || t.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("webgl: VertexLayoutOf %T, not a struct", v)
+	}
+	if t.Size() > 255 {
+		return nil, fmt.Errorf("webgl: %v is %d bytes, more than the largest stride of 255", t, t.Size())
+	}
+	l := &VertexLayout{Stride: int(t.Size())}
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		tag := f.Tag.Get("gl")
+		if tag == "" || tag == "-" {
+			continue
+		}
+		opts := strings.Split(tag, ",")
+		a := VertexAttribute{Name: opts[0], Offset: int(f.Offset)}
+		for _, opt := range opts[1:] {
+			switch {
+			case opt == "normalized":
+				a.Normalized = true
+			case strings.HasPrefix(opt, "divisor="):
+				n, err := strconv.Atoi(strings.TrimPrefix(opt, "divisor="))
+				if err != nil || n < 0 {
+					return nil, fmt.Errorf("webgl: field %s has an invalid divisor in tag %q", f.Name, tag)
+				}
+				a.Divisor = n
+			default:
+				return nil, fmt.Errorf("webgl: field %s has an unknown option in tag %q", f.Name, tag)
+			}
+		}
+		size, elem := 1, f.Type
+		if elem.Kind() == reflect.Array {
+			size, elem = elem.Len(), elem.Elem()
+		}
+		typ, ok := vertexTypes[elem.Kind()]
+		if !ok || size < 1 || size > 4 {
+			return nil, fmt.Errorf("webgl: field %s of type %v cannot hold a vertex attribute", f.Name, f.Type)
+		}
+		a.Size, a.Type = size, typ
+		l.Attributes = append(l.Attributes, a)
+	}
+	return l, nil
+}
+
+// vertexTypes are the types of attribute components held by Go kinds.
+var vertexTypes = map[reflect.Kind]GLenum{
+	reflect.Float32: FLOAT,
+	reflect.Int8:    BYTE,
+	reflect.Uint8:   UNSIGNED_BYTE,
+	reflect.Int16:   SHORT,
+	reflect.Uint16:  UNSIGNED_SHORT,
+}
+
+// typeSize returns the size in bytes of a component of type typ.
+func typeSize(typ GLenum) int {
+	switch typ {
+	case BYTE, UNSIGNED_BYTE:
+		return 1
+	case SHORT, UNSIGNED_SHORT:
+		return 2
+	}
+	return 4
+}
+
+// Locations returns the location of each attribute in program, or -1 for
+// attributes that are not active in it. The locations are looked up once
+// per program and kept until Invalidate is called for it.
+func (l *VertexLayout) Locations(b Backend, program Program) []int {
+	if locs, ok := l.locations[program]; ok {
+		return locs
+	}
+	locs := make([]int, len(l.Attributes))
+	for i, a := range l.Attributes {
+		locs[i] = b.GetAttribLocation(program, a.Name)
+	}
+	if l.locations == nil {
+		l.locations = make(map[Program][]int)
+	}
+	l.locations[program] = locs
+	return locs
+}
+
+// Invalidate forgets the locations of the attributes in program, to be
+// looked up again on next use. Call it after linking program again, as
+// that can move its attributes, and after deleting it, so that the layout
+// does not keep it.
+func (l *VertexLayout) Invalidate(program Program) {
+	delete(l.locations, program)
+}
+
+// Apply binds buffer to ARRAY_BUFFER and points the attributes of program
+// at the vertices in it, starting at byte offset, enabling their arrays.
+// Attributes the program does not use are left alone.
+func (l *VertexLayout) Apply(b Backend, program Program, buffer Buffer, offset int) {
+	b.BindBuffer(ARRAY_BUFFER, buffer)
+	for i, loc := range l.Locations(b, program) {
+		if loc < 0 {
+			continue
+		}
+		a := l.Attributes[i]
+		b.EnableVertexAttribArray(loc)
+		b.VertexAttribPointer(loc, a.Size, a.Type, a.Normalized, l.Stride, offset+a.Offset)
+	}
+}
+
+// Clear disables the arrays of the attributes of program that Apply
+// enabled.
+func (l *VertexLayout) Clear(b Backend, program Program) {
+	for _, loc := range l.Locations(b, program) {
+		if loc >= 0 {
+			b.DisableVertexAttribArray(loc)
+		}
+	}
+}
+
+// AttribDivisor sets the rate at which vertex attributes advance when
+// drawing instances. It is implemented by InstancedArrays and Context2.
+type AttribDivisor interface {
+	VertexAttribDivisor(index, divisor int)
+}
+
+// ApplyInstanced is Apply for instanced drawing: it also sets the divisor
+// of each attribute of program through d, including those of 0.
+func (l *VertexLayout) ApplyInstanced(b Backend, d AttribDivisor, program Program, buffer Buffer, offset int) {
+	l.Apply(b, program, buffer, offset)
+	for i, loc := range l.Locations(b, program) {
+		if loc >= 0 {
+			d.VertexAttribDivisor(loc, l.Attributes[i].Divisor)
+		}
+	}
+}
+
+// ClearInstanced is Clear for layouts applied with ApplyInstanced: it also
+// resets the divisors of the attributes to 0, so that they do not affect
+// later draws that are not instanced.
+func (l *VertexLayout) ClearInstanced(b Backend, d AttribDivisor, program Program) {
+	for _, loc := range l.Locations(b, program) {
+		if loc >= 0 {
+			b.DisableVertexAttribArray(loc)
+			d.VertexAttribDivisor(loc, 0)
+		}
+	}
+}
@@ -0,0 +1,262 @@
+// Copyright 2014 Joseph Hager. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package webgl
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestNewVertexLayout(t *testing.T) {
+	tests := []struct {
+		name    string
+		attribs []VertexAttribute
+		offsets []int
+		stride  int
+		err     string
+	}{
+		{
+			name: "float then bytes",
+			attribs: []VertexAttribute{
+				{Name: "a_position", Size: 3, Type: FLOAT},
+				{Name: "a_color", Size: 4, Type: UNSIGNED_BYTE, Normalized: true},
+			},
+			offsets: []int{0, 12},
+			stride:  16,
+		},
+		{
+			name: "bytes then float",
+			attribs: []VertexAttribute{
+				{Name: "a_color", Size: 3, Type: UNSIGNED_BYTE},
+				{Name: "a_position", Size: 2, Type: FLOAT},
+			},
+			offsets: []int{0, 4},
+			stride:  12,
+		},
+		{
+			name: "bytes and shorts",
+			attribs: []VertexAttribute{
+				{Name: "a_id", Size: 1, Type: UNSIGNED_BYTE},
+				{Name: "a_uv", Size: 2, Type: SHORT},
+				{Name: "a_flag", Size: 1, Type: BYTE},
+			},
+			offsets: []int{0, 2, 6},
+			stride:  8,
+		},
+		{
+			name: "stride past 255",
+			attribs: []VertexAttribute{
+				{Name: "a_0", Size: 4, Type: FLOAT},
+				{Name: "a_1", Size: 4, Type: FLOAT},
+				{Name: "a_2", Size: 4, Type: FLOAT},
+				{Name: "a_3", Size: 4, Type: FLOAT},
+				{Name: "a_4", Size: 4, Type: FLOAT},
+				{Name: "a_5", Size: 4, Type: FLOAT},
+				{Name: "a_6", Size: 4, Type: FLOAT},
+				{Name: "a_7", Size: 4, Type: FLOAT},
+				{Name: "a_8", Size: 4, Type: FLOAT},
+				{Name: "a_9", Size: 4, Type: FLOAT},
+				{Name: "a_10", Size: 4, Type: FLOAT},
+				{Name: "a_11", Size: 4, Type: FLOAT},
+				{Name: "a_12", Size: 4, Type: FLOAT},
+				{Name: "a_13", Size: 4, Type: FLOAT},
+				{Name: "a_14", Size: 4, Type: FLOAT},
+				{Name: "a_15", Size: 4, Type: FLOAT},
+			},
+			err: "more than the largest stride of 255",
+		},
+	}
+	for _, test := range tests {
+		l, err := NewVertexLayout(test.attribs...)
+		if test.err != "" {
+			if err == nil || !strings.Contains(err.Error(), test.err) {
+				t.Errorf("%s: error %v, want one containing %q", test.name, err, test.err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%s: %v", test.name, err)
+			continue
+		}
+		var offsets []int
+		for _, a := range l.Attributes {
+			offsets = append(offsets, a.Offset)
+		}
+		if !reflect.DeepEqual(offsets, test.offsets) || l.Stride != test.stride {
+			t.Errorf("%s: offsets %v, stride %d, want %v, %d", test.name, offsets, l.Stride, test.offsets, test.stride)
+		}
+	}
+}
+
+type layoutVertex struct {
+	Position [3]float32 `gl:"a_position"`
+	Color    [4]uint8   `gl:"a_color,normalized"`
+	Flag     int8       `gl:"a_flag"`
+	_        int8
+	UV       [2]int16   `gl:"a_uv,normalized"`
+	Index    uint16     `gl:"a_index"`
+	Offset   [2]float32 `gl:"a_offset,divisor=2"`
+	Skipped  float32    `gl:"-"`
+}
+
+func TestVertexLayoutOf(t *testing.T) {
+	want := &VertexLayout{
+		Attributes: []VertexAttribute{
+			{Name: "a_position", Size: 3, Type: FLOAT},
+			{Name: "a_color", Size: 4, Type: UNSIGNED_BYTE, Normalized: true, Offset: 12},
+			{Name: "a_flag", Size: 1, Type: BYTE, Offset: 16},
+			{Name: "a_uv", Size: 2, Type: SHORT, Normalized: true, Offset: 18},
+			{Name: "a_index", Size: 1, Type: UNSIGNED_SHORT, Offset: 22},
+			{Name: "a_offset", Size: 2, Type: FLOAT, Offset: 24, Divisor: 2},
+		},
+		Stride: 36,
+	}
+	for _, v := range []interface{}{layoutVertex{}, &layoutVertex{}} {
+		l, err := VertexLayoutOf(v)
+		if err != nil {
+			t.Errorf("VertexLayoutOf(%T): %v", v, err)
+			continue
+		}
+		if !reflect.DeepEqual(l, want) {
+			t.Errorf("VertexLayoutOf(%T) = %+v, want %+v", v, l, want)
+		}
+	}
+
+	errors := []struct {
+		v   interface{}
+		err string
+	}{
+		{0, "not a struct"},
+		{nil, "not a struct"},
+		{struct {
+			A float32 `gl:"a,normalised"`
+		}{}, "unknown option"},
+		{struct {
+			A float32 `gl:"a,divisor=x"`
+		}{}, "invalid divisor"},
+		{struct {
+			A float32 `gl:"a,divisor=-1"`
+		}{}, "invalid divisor"},
+		{struct {
+			A float64 `gl:"a"`
+		}{}, "cannot hold a vertex attribute"},
+		{struct {
+			A [5]float32 `gl:"a"`
+		}{}, "cannot hold a vertex attribute"},
+		{struct {
+			A [0]float32 `gl:"a"`
+		}{}, "cannot hold a vertex attribute"},
+		{struct {
+			A [4]float32 `gl:"a"`
+			_ [240]byte
+		}{}, "more than the largest stride of 255"},
+	}
+	for _, test := range errors {
+		if _, err := VertexLayoutOf(test.v); err == nil || !strings.Contains(err.Error(), test.err) {
+			t.Errorf("VertexLayoutOf(%T): error %v, want one containing %q", test.v, err, test.err)
+		}
+	}
+}
+
+// recordedDivisor is an AttribDivisor that records its calls in a
+// Recorder.
+type recordedDivisor struct{ *Recorder }
+
+func (d recordedDivisor) VertexAttribDivisor(index, divisor int) {
+	d.record("VertexAttribDivisor", index, divisor)
+}
+
+func TestVertexLayoutApply(t *testing.T) {
+	r := NewRecorder(nil)
+	p := r.CreateProgram()
+	// A standalone Recorder reports the bound locations, so a_unused is
+	// not active in the program.
+	r.BindAttribLocation(p, 0, "a_position")
+	r.BindAttribLocation(p, -1, "a_unused")
+	r.BindAttribLocation(p, 1, "a_offset")
+	buf := r.CreateBuffer()
+	l, err := NewVertexLayout(
+		VertexAttribute{Name: "a_position", Size: 2, Type: FLOAT},
+		VertexAttribute{Name: "a_unused", Size: 4, Type: UNSIGNED_BYTE, Normalized: true},
+		VertexAttribute{Name: "a_offset", Size: 2, Type: SHORT, Divisor: 1},
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name  string
+		calls func()
+		trace []string
+	}{
+		{
+			name:  "apply",
+			calls: func() { l.Apply(r, p, buf, 32) },
+			trace: []string{
+				"BindBuffer(ARRAY_BUFFER, buffer1)",
+				`GetAttribLocation(program1, "a_position") = 0`,
+				`GetAttribLocation(program1, "a_unused") = -1`,
+				`GetAttribLocation(program1, "a_offset") = 1`,
+				"EnableVertexAttribArray(0)",
+				"VertexAttribPointer(0, 2, FLOAT, false, 16, 32)",
+				"EnableVertexAttribArray(1)",
+				"VertexAttribPointer(1, 2, SHORT, false, 16, 44)",
+			},
+		},
+		{
+			name:  "clear",
+			calls: func() { l.Clear(r, p) },
+			trace: []string{
+				"DisableVertexAttribArray(0)",
+				"DisableVertexAttribArray(1)",
+			},
+		},
+		{
+			name:  "apply instanced",
+			calls: func() { l.ApplyInstanced(r, recordedDivisor{r}, p, buf, 0) },
+			trace: []string{
+				"BindBuffer(ARRAY_BUFFER, buffer1)",
+				"EnableVertexAttribArray(0)",
+				"VertexAttribPointer(0, 2, FLOAT, false, 16, 0)",
+				"EnableVertexAttribArray(1)",
+				"VertexAttribPointer(1, 2, SHORT, false, 16, 12)",
+				"VertexAttribDivisor(0, 0)",
+				"VertexAttribDivisor(1, 1)",
+			},
+		},
+		{
+			name:  "clear instanced",
+			calls: func() { l.ClearInstanced(r, recordedDivisor{r}, p) },
+			trace: []string{
+				"DisableVertexAttribArray(0)",
+				"VertexAttribDivisor(0, 0)",
+				"DisableVertexAttribArray(1)",
+				"VertexAttribDivisor(1, 0)",
+			},
+		},
+		{
+			name: "invalidate",
+			calls: func() {
+				l.Invalidate(p)
+				l.Clear(r, p)
+			},
+			trace: []string{
+				`GetAttribLocation(program1, "a_position") = 0`,
+				`GetAttribLocation(program1, "a_unused") = -1`,
+				`GetAttribLocation(program1, "a_offset") = 1`,
+				"DisableVertexAttribArray(0)",
+				"DisableVertexAttribArray(1)",
+			},
+		},
+	}
+	for _, test := range tests {
+		r.Reset()
+		test.calls()
+		if got, want := r.Trace(), strings.Join(test.trace, "\n")+"\n"; got != want {
+			t.Errorf("%s: trace =\n%swant\n%s", test.name, got, want)
+		}
+	}
+}
@@ -0,0 +1,354 @@
+// Copyright 2014 Joseph Hager. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package webgl
+
+import "fmt"
+
+// ParamType is the type of the value WebGL returns for a parameter name
+// passed to GetParameter or one of the other get*Parameter queries.
+type ParamType int
+
+const (
+	ParamBool              ParamType = iota + 1 // bool
+	ParamInt                                    // int
+	ParamEnum                                   // GLenum
+	ParamFloat                                  // float32
+	ParamString                                 // string
+	ParamBools                                  // []bool
+	ParamInts                                   // []int
+	ParamFloats                                 // []float32
+	ParamBuffer                                 // Buffer
+	ParamFramebuffer                            // Framebuffer
+	ParamProgram                                // Program
+	ParamRenderbuffer                           // Renderbuffer
+	ParamTexture                                // Texture
+	ParamObject                                 // Texture or Renderbuffer
+	ParamMask                                   // uint32
+	ParamVertexArray                            // VertexArray
+	ParamInt64                                  // int64
+	ParamSampler                                // Sampler
+	ParamTransformFeedback                      // TransformFeedback
+)
+
+var paramTypeNames = [...]string{
+	ParamBool:              "bool",
+	ParamInt:               "int",
+	ParamEnum:              "enum",
+	ParamFloat:             "float",
+	ParamString:            "string",
+	ParamBools:             "bool array",
+	ParamInts:              "int array",
+	ParamFloats:            "float array",
+	ParamBuffer:            "buffer",
+	ParamFramebuffer:       "framebuffer",
+	ParamProgram:           "program",
+	ParamRenderbuffer:      "renderbuffer",
+	ParamTexture:           "texture",
+	ParamObject:            "object",
+	ParamMask:              "mask",
+	ParamVertexArray:       "vertex array",
+	ParamInt64:             "int64",
+	ParamSampler:           "sampler",
+	ParamTransformFeedback: "transform feedback",
+}
+
+func (t ParamType) String() string {
+	if t > 0 && int(t) < len(paramTypeNames) {
+		return paramTypeNames[t]
+	}
+	return fmt.Sprintf("ParamType(%d)", int(t))
+}
+
+// ParamError is returned by the typed getters, such as GetParameterInt,
+// when the parameter name is not known to the query or its value does not
+// have the type the getter returns.
+type ParamError struct {
+	Method string    // getter called
+	Pname  GLenum    // parameter name passed to it
+	Type   ParamType // type of the parameter, or 0 if it is unknown
+}
+
+func (e *ParamError) Error() string {
+	if e.Type == 0 {
+		return fmt.Sprintf("webgl: %s: unknown parameter %v", e.Method, e.Pname)
+	}
+	return fmt.Sprintf("webgl: %s: %v is a %v parameter", e.Method, e.Pname, e.Type)
+}
+
+// ParameterType returns the type of the value GetParameter returns for
+// pname, or 0 if pname is not a WebGL 1.0 parameter.
+func ParameterType(pname GLenum) ParamType {
+	return parameterTypes[pname]
+}
+
+// ParameterType2 returns the type of the value GetParameter returns for
+// pname on a Context2, or 0 if pname is not a WebGL 2.0 parameter.
+func ParameterType2(pname GLenum) ParamType {
+	return parameterTypes2[pname]
+}
+
+// checkParam returns a *ParamError unless pname has type want in table.
+func checkParam(method string, table map[GLenum]ParamType, pname GLenum, want ParamType) error {
+	if t := table[pname]; t != want {
+		return &ParamError{method, pname, t}
+	}
+	return nil
+}
+
+// uniformTypeError returns the error of a GetUniform getter given a value
+// whose JavaScript constructor is name.
+func uniformTypeError(method, name string) error {
+	return fmt.Errorf("webgl: %s: uniform value is a %s", method, name)
+}
+
+// parameterTypes are the types of the GetParameter values, as listed by
+// the WebGL 1.0 specification and the extensions with enums in this
+// package. Masks such as STENCIL_WRITEMASK hold the bit pattern of the
+// mask, which JavaScript reports as a number up to 2^32-1.
+var parameterTypes = map[GLenum]ParamType{
+	ACTIVE_TEXTURE:                      ParamEnum,
+	ALIASED_LINE_WIDTH_RANGE:            ParamFloats,
+	ALIASED_POINT_SIZE_RANGE:            ParamFloats,
+	ALPHA_BITS:                          ParamInt,
+	ARRAY_BUFFER_BINDING:                ParamBuffer,
+	BLEND:                               ParamBool,
+	BLEND_COLOR:                         ParamFloats,
+	BLEND_DST_ALPHA:                     ParamEnum,
+	BLEND_DST_RGB:                       ParamEnum,
+	BLEND_EQUATION_ALPHA:                ParamEnum,
+	BLEND_EQUATION_RGB:                  ParamEnum,
+	BLEND_SRC_ALPHA:                     ParamEnum,
+	BLEND_SRC_RGB:                       ParamEnum,
+	BLUE_BITS:                           ParamInt,
+	COLOR_CLEAR_VALUE:                   ParamFloats,
+	COLOR_WRITEMASK:                     ParamBools,
+	COMPRESSED_TEXTURE_FORMATS:          ParamInts,
+	CULL_FACE:                           ParamBool,
+	CULL_FACE_MODE:                      ParamEnum,
+	CURRENT_PROGRAM:                     ParamProgram,
+	DEPTH_BITS:                          ParamInt,
+	DEPTH_CLEAR_VALUE:                   ParamFloat,
+	DEPTH_FUNC:                          ParamEnum,
+	DEPTH_RANGE:                         ParamFloats,
+	DEPTH_TEST:                          ParamBool,
+	DEPTH_WRITEMASK:                     ParamBool,
+	DITHER:                              ParamBool,
+	DRAW_BUFFER0_WEBGL:                  ParamEnum,
+	DRAW_BUFFER1_WEBGL:                  ParamEnum,
+	DRAW_BUFFER2_WEBGL:                  ParamEnum,
+	DRAW_BUFFER3_WEBGL:                  ParamEnum,
+	DRAW_BUFFER4_WEBGL:                  ParamEnum,
+	DRAW_BUFFER5_WEBGL:                  ParamEnum,
+	DRAW_BUFFER6_WEBGL:                  ParamEnum,
+	DRAW_BUFFER7_WEBGL:                  ParamEnum,
+	DRAW_BUFFER8_WEBGL:                  ParamEnum,
+	DRAW_BUFFER9_WEBGL:                  ParamEnum,
+	DRAW_BUFFER10_WEBGL:                 ParamEnum,
+	DRAW_BUFFER11_WEBGL:                 ParamEnum,
+	DRAW_BUFFER12_WEBGL:                 ParamEnum,
+	DRAW_BUFFER13_WEBGL:                 ParamEnum,
+	DRAW_BUFFER14_WEBGL:                 ParamEnum,
+	DRAW_BUFFER15_WEBGL:                 ParamEnum,
+	ELEMENT_ARRAY_BUFFER_BINDING:        ParamBuffer,
+	FRAGMENT_SHADER_DERIVATIVE_HINT_OES: ParamEnum,
+	FRAMEBUFFER_BINDING:                 ParamFramebuffer,
+	FRONT_FACE:                          ParamEnum,
+	GENERATE_MIPMAP_HINT:                ParamEnum,
+	GREEN_BITS:                          ParamInt,
+	IMPLEMENTATION_COLOR_READ_FORMAT:    ParamEnum,
+	IMPLEMENTATION_COLOR_READ_TYPE:      ParamEnum,
+	LINE_WIDTH:                          ParamFloat,
+	MAX_COLOR_ATTACHMENTS_WEBGL:         ParamInt,
+	MAX_COMBINED_TEXTURE_IMAGE_UNITS:    ParamInt,
+	MAX_CUBE_MAP_TEXTURE_SIZE:           ParamInt,
+	MAX_DRAW_BUFFERS_WEBGL:              ParamInt,
+	MAX_FRAGMENT_UNIFORM_VECTORS:        ParamInt,
+	MAX_RENDERBUFFER_SIZE:               ParamInt,
+	MAX_TEXTURE_IMAGE_UNITS:             ParamInt,
+	MAX_TEXTURE_SIZE:                    ParamInt,
+	MAX_VARYING_VECTORS:                 ParamInt,
+	MAX_VERTEX_ATTRIBS:                  ParamInt,
+	MAX_VERTEX_TEXTURE_IMAGE_UNITS:      ParamInt,
+	MAX_VERTEX_UNIFORM_VECTORS:          ParamInt,
+	MAX_VIEWPORT_DIMS:                   ParamInts,
+	PACK_ALIGNMENT:                      ParamInt,
+	POLYGON_OFFSET_FACTOR:               ParamFloat,
+	POLYGON_OFFSET_FILL:                 ParamBool,
+	POLYGON_OFFSET_UNITS:                ParamFloat,
+	RED_BITS:                            ParamInt,
+	RENDERBUFFER_BINDING:                ParamRenderbuffer,
+	RENDERER:                            ParamString,
+	SAMPLE_ALPHA_TO_COVERAGE:            ParamBool,
+	SAMPLE_BUFFERS:                      ParamInt,
+	SAMPLE_COVERAGE:                     ParamBool,
+	SAMPLE_COVERAGE_INVERT:              ParamBool,
+	SAMPLE_COVERAGE_VALUE:               ParamFloat,
+	SAMPLES:                             ParamInt,
+	SCISSOR_BOX:                         ParamInts,
+	SCISSOR_TEST:                        ParamBool,
+	SHADING_LANGUAGE_VERSION:            ParamString,
+	STENCIL_BACK_FAIL:                   ParamEnum,
+	STENCIL_BACK_FUNC:                   ParamEnum,
+	STENCIL_BACK_PASS_DEPTH_FAIL:        ParamEnum,
+	STENCIL_BACK_PASS_DEPTH_PASS:        ParamEnum,
+	STENCIL_BACK_REF:                    ParamInt,
+	STENCIL_BACK_VALUE_MASK:             ParamMask,
+	STENCIL_BACK_WRITEMASK:              ParamMask,
+	STENCIL_BITS:                        ParamInt,
+	STENCIL_CLEAR_VALUE:                 ParamInt,
+	STENCIL_FAIL:                        ParamEnum,
+	STENCIL_FUNC:                        ParamEnum,
+	STENCIL_PASS_DEPTH_FAIL:             ParamEnum,
+	STENCIL_PASS_DEPTH_PASS:             ParamEnum,
+	STENCIL_REF:                         ParamInt,
+	STENCIL_TEST:                        ParamBool,
+	STENCIL_VALUE_MASK:                  ParamMask,
+	STENCIL_WRITEMASK:                   ParamMask,
+	SUBPIXEL_BITS:                       ParamInt,
+	TEXTURE_BINDING_2D:                  ParamTexture,
+	TEXTURE_BINDING_CUBE_MAP:            ParamTexture,
+	UNPACK_ALIGNMENT:                    ParamInt,
+	UNPACK_COLORSPACE_CONVERSION_WEBGL:  ParamEnum,
+	UNPACK_FLIP_Y_WEBGL:                 ParamBool,
+	UNPACK_PREMULTIPLY_ALPHA_WEBGL:      ParamBool,
+	UNMASKED_RENDERER_WEBGL:             ParamString,
+	UNMASKED_VENDOR_WEBGL:               ParamString,
+	VENDOR:                              ParamString,
+	VERSION:                             ParamString,
+	VERTEX_ARRAY_BINDING_OES:            ParamVertexArray,
+	VIEWPORT:                            ParamInts,
+}
+
+// parameterTypes2 are the types of the GetParameter values of a WebGL 2.0
+// context: those of parameterTypes and the ones listed by the WebGL 2.0
+// specification. Several of them, such as MAX_DRAW_BUFFERS and
+// VERTEX_ARRAY_BINDING, have the value of an extension name already in
+// parameterTypes. The int64 values can be past the range of an int on
+// 32-bit targets.
+var parameterTypes2 = mergeParamTypes(parameterTypes, map[GLenum]ParamType{
+	COPY_READ_BUFFER_BINDING:                      ParamBuffer,
+	COPY_WRITE_BUFFER_BINDING:                     ParamBuffer,
+	DRAW_FRAMEBUFFER_BINDING:                      ParamFramebuffer,
+	FRAGMENT_SHADER_DERIVATIVE_HINT:               ParamEnum,
+	MAX_3D_TEXTURE_SIZE:                           ParamInt,
+	MAX_ARRAY_TEXTURE_LAYERS:                      ParamInt,
+	MAX_CLIENT_WAIT_TIMEOUT_WEBGL:                 ParamInt64,
+	MAX_COLOR_ATTACHMENTS:                         ParamInt,
+	MAX_COMBINED_FRAGMENT_UNIFORM_COMPONENTS:      ParamInt64,
+	MAX_COMBINED_UNIFORM_BLOCKS:                   ParamInt,
+	MAX_COMBINED_VERTEX_UNIFORM_COMPONENTS:        ParamInt64,
+	MAX_DRAW_BUFFERS:                              ParamInt,
+	MAX_ELEMENT_INDEX:                             ParamInt64,
+	MAX_ELEMENTS_INDICES:                          ParamInt,
+	MAX_ELEMENTS_VERTICES:                         ParamInt,
+	MAX_FRAGMENT_INPUT_COMPONENTS:                 ParamInt,
+	MAX_FRAGMENT_UNIFORM_BLOCKS:                   ParamInt,
+	MAX_FRAGMENT_UNIFORM_COMPONENTS:               ParamInt,
+	MAX_PROGRAM_TEXEL_OFFSET:                      ParamInt,
+	MAX_SAMPLES:                                   ParamInt,
+	MAX_SERVER_WAIT_TIMEOUT:                       ParamInt64,
+	MAX_TEXTURE_LOD_BIAS:                          ParamFloat,
+	MAX_TRANSFORM_FEEDBACK_INTERLEAVED_COMPONENTS: ParamInt,
+	MAX_TRANSFORM_FEEDBACK_SEPARATE_ATTRIBS:       ParamInt,
+	MAX_TRANSFORM_FEEDBACK_SEPARATE_COMPONENTS:    ParamInt,
+	MAX_UNIFORM_BLOCK_SIZE:                        ParamInt64,
+	MAX_UNIFORM_BUFFER_BINDINGS:                   ParamInt,
+	MAX_VARYING_COMPONENTS:                        ParamInt,
+	MAX_VERTEX_OUTPUT_COMPONENTS:                  ParamInt,
+	MAX_VERTEX_UNIFORM_BLOCKS:                     ParamInt,
+	MAX_VERTEX_UNIFORM_COMPONENTS:                 ParamInt,
+	MIN_PROGRAM_TEXEL_OFFSET:                      ParamInt,
+	PACK_ROW_LENGTH:                               ParamInt,
+	PACK_SKIP_PIXELS:                              ParamInt,
+	PACK_SKIP_ROWS:                                ParamInt,
+	PIXEL_PACK_BUFFER_BINDING:                     ParamBuffer,
+	PIXEL_UNPACK_BUFFER_BINDING:                   ParamBuffer,
+	RASTERIZER_DISCARD:                            ParamBool,
+	READ_BUFFER:                                   ParamEnum,
+	READ_FRAMEBUFFER_BINDING:                      ParamFramebuffer,
+	SAMPLER_BINDING:                               ParamSampler,
+	TEXTURE_BINDING_2D_ARRAY:                      ParamTexture,
+	TEXTURE_BINDING_3D:                            ParamTexture,
+	TRANSFORM_FEEDBACK_ACTIVE:                     ParamBool,
+	TRANSFORM_FEEDBACK_BINDING:                    ParamTransformFeedback,
+	TRANSFORM_FEEDBACK_BUFFER_BINDING:             ParamBuffer,
+	TRANSFORM_FEEDBACK_PAUSED:                     ParamBool,
+	UNIFORM_BUFFER_BINDING:                        ParamBuffer,
+	UNIFORM_BUFFER_OFFSET_ALIGNMENT:               ParamInt,
+	UNPACK_IMAGE_HEIGHT:                           ParamInt,
+	UNPACK_ROW_LENGTH:                             ParamInt,
+	UNPACK_SKIP_IMAGES:                            ParamInt,
+	UNPACK_SKIP_PIXELS:                            ParamInt,
+	UNPACK_SKIP_ROWS:                              ParamInt,
+})
+
+// mergeParamTypes returns a table with the entries of base and those of
+// extra, which take precedence.
+func mergeParamTypes(base, extra map[GLenum]ParamType) map[GLenum]ParamType {
+	table := make(map[GLenum]ParamType, len(base)+len(extra))
+	for pname, t := range base {
+		table[pname] = t
+	}
+	for pname, t := range extra {
+		table[pname] = t
+	}
+	return table
+}
+
+// bufferParameterTypes are the types of the GetBufferParameter values.
+var bufferParameterTypes = map[GLenum]ParamType{
+	BUFFER_SIZE:  ParamInt,
+	BUFFER_USAGE: ParamEnum,
+}
+
+// texParameterTypes are the types of the GetTexParameter values.
+var texParameterTypes = map[GLenum]ParamType{
+	TEXTURE_MAG_FILTER: ParamEnum,
+	TEXTURE_MIN_FILTER: ParamEnum,
+	TEXTURE_WRAP_S:     ParamEnum,
+	TEXTURE_WRAP_T:     ParamEnum,
+}
+
+// renderbufferParameterTypes are the types of the GetRenderbufferParameter
+// values.
+var renderbufferParameterTypes = map[GLenum]ParamType{
+	RENDERBUFFER_WIDTH:           ParamInt,
+	RENDERBUFFER_HEIGHT:          ParamInt,
+	RENDERBUFFER_INTERNAL_FORMAT: ParamEnum,
+	RENDERBUFFER_RED_SIZE:        ParamInt,
+	RENDERBUFFER_GREEN_SIZE:      ParamInt,
+	RENDERBUFFER_BLUE_SIZE:       ParamInt,
+	RENDERBUFFER_ALPHA_SIZE:      ParamInt,
+	RENDERBUFFER_DEPTH_SIZE:      ParamInt,
+	RENDERBUFFER_STENCIL_SIZE:    ParamInt,
+}
+
+// framebufferAttachmentParameterTypes are the types of the
+// GetFramebufferAttachmentParameter values.
+var framebufferAttachmentParameterTypes = map[GLenum]ParamType{
+	FRAMEBUFFER_ATTACHMENT_OBJECT_TYPE:           ParamEnum,
+	FRAMEBUFFER_ATTACHMENT_OBJECT_NAME:           ParamObject,
+	FRAMEBUFFER_ATTACHMENT_TEXTURE_LEVEL:         ParamInt,
+	FRAMEBUFFER_ATTACHMENT_TEXTURE_CUBE_MAP_FACE: ParamEnum,
+}
+
+// vertexAttribTypes are the types of the GetVertexAttrib values.
+var vertexAttribTypes = map[GLenum]ParamType{
+	VERTEX_ATTRIB_ARRAY_BUFFER_BINDING: ParamBuffer,
+	VERTEX_ATTRIB_ARRAY_ENABLED:        ParamBool,
+	VERTEX_ATTRIB_ARRAY_SIZE:           ParamInt,
+	VERTEX_ATTRIB_ARRAY_STRIDE:         ParamInt,
+	VERTEX_ATTRIB_ARRAY_TYPE:           ParamEnum,
+	VERTEX_ATTRIB_ARRAY_NORMALIZED:     ParamBool,
+	CURRENT_VERTEX_ATTRIB:              ParamFloats,
+	VERTEX_ATTRIB_ARRAY_DIVISOR_ANGLE:  ParamInt,
+}
+
+// shaderParameterTypes are the types of the GetShaderParameter values.
+var shaderParameterTypes = map[GLenum]ParamType{
+	SHADER_TYPE:    ParamEnum,
+	DELETE_STATUS:  ParamBool,
+	COMPILE_STATUS: ParamBool,
+}
@@ -0,0 +1,41 @@
+// Copyright 2014 Joseph Hager. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package webgl
+
+import "testing"
+
+func TestParameterType2(t *testing.T) {
+	tests := []struct {
+		pname GLenum
+		want  ParamType
+		want1 ParamType // on a WebGL 1.0 context
+	}{
+		{MAX_TEXTURE_SIZE, ParamInt, ParamInt},
+		{MAX_3D_TEXTURE_SIZE, ParamInt, 0},
+		{MAX_UNIFORM_BUFFER_BINDINGS, ParamInt, 0},
+		{MAX_DRAW_BUFFERS, ParamInt, ParamInt},
+		{MAX_ELEMENT_INDEX, ParamInt64, 0},
+		{DRAW_BUFFER3, ParamEnum, ParamEnum},
+		{DRAW_FRAMEBUFFER_BINDING, ParamFramebuffer, ParamFramebuffer},
+		{READ_FRAMEBUFFER_BINDING, ParamFramebuffer, 0},
+		{UNIFORM_BUFFER_BINDING, ParamBuffer, 0},
+		{SAMPLER_BINDING, ParamSampler, 0},
+		{TRANSFORM_FEEDBACK_BINDING, ParamTransformFeedback, 0},
+		{VERTEX_ARRAY_BINDING, ParamVertexArray, ParamVertexArray},
+		{TEXTURE_WRAP_R, 0, 0},
+	}
+	for _, test := range tests {
+		if got := ParameterType2(test.pname); got != test.want {
+			t.Errorf("ParameterType2(%v) = %v, want %v", test.pname, got, test.want)
+		}
+		if got := ParameterType(test.pname); got != test.want1 {
+			t.Errorf("ParameterType(%v) = %v, want %v", test.pname, got, test.want1)
+		}
+	}
+	err := checkParam("GetParameterInt", parameterTypes2, SAMPLER_BINDING, ParamInt)
+	if want := "webgl: GetParameterInt: SAMPLER_BINDING is a sampler parameter"; err == nil || err.Error() != want {
+		t.Errorf("checkParam = %v, want %s", err, want)
+	}
+}
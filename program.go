@@ -0,0 +1,249 @@
+// Copyright 2014 Joseph Hager. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package webgl
+
+import (
+	"bytes"
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// ProgramOptions are the options of BuildProgram. A nil *ProgramOptions
+// uses the zero value.
+type ProgramOptions struct {
+	// AttribLocations binds attributes to locations before the program is
+	// linked. Attributes not listed are assigned locations by the driver.
+	AttribLocations map[string]int
+}
+
+// LogEntry is one message of a shader or program info log.
+type LogEntry struct {
+	// Severity is "ERROR" or "WARNING", or empty if the message has none.
+	Severity string
+
+	// Line and Column locate the message in the shader source, counting
+	// from 1. They are 0 if the driver did not give them.
+	Line, Column int
+
+	Message string
+
+	// Context holds the source lines around Line, numbered, with the line
+	// the message is about marked by '>'. It is empty for link messages.
+	Context []string
+}
+
+// String formats the entry and its context lines.
+func (e LogEntry) String() string {
+	var buf bytes.Buffer
+	if e.Severity != "" {
+		buf.WriteString(e.Severity)
+		buf.WriteByte(' ')
+	}
+	switch {
+	case e.Column > 0:
+		fmt.Fprintf(&buf, "%d:%d: ", e.Line, e.Column)
+	case e.Line > 0:
+		fmt.Fprintf(&buf, "%d: ", e.Line)
+	}
+	buf.WriteString(e.Message)
+	for _, line := range e.Context {
+		buf.WriteString("\n")
+		buf.WriteString(line)
+	}
+	return buf.String()
+}
+
+// StageError is the failure of one stage of building a program.
+type StageError struct {
+	// Stage is VERTEX_SHADER or FRAGMENT_SHADER if that shader failed to
+	// compile, or LINK_STATUS if the program failed to link.
+	Stage GLenum
+
+	// Log is the info log as the driver returned it, and Entries its
+	// messages.
+	Log     string
+	Entries []LogEntry
+}
+
+// ProgramError is the error returned by BuildProgram. If one of the
+// shaders fails to compile, both are reported on; linking is only tried
+// once they compile.
+type ProgramError struct {
+	Stages []StageError
+}
+
+func (e *ProgramError) Error() string {
+	var buf bytes.Buffer
+	for i, s := range e.Stages {
+		if i > 0 {
+			buf.WriteString("\n")
+		}
+		switch s.Stage {
+		case VERTEX_SHADER:
+			buf.WriteString("webgl: vertex shader failed to compile:")
+		case FRAGMENT_SHADER:
+			buf.WriteString("webgl: fragment shader failed to compile:")
+		default:
+			buf.WriteString("webgl: program failed to link:")
+		}
+		for _, entry := range s.Entries {
+			buf.WriteString("\n")
+			buf.WriteString(entry.String())
+		}
+	}
+	return buf.String()
+}
+
+// Compiles vertexSrc and fragmentSrc and links them into a program, as
+// BuildProgram does.
+func (c *Context) NewProgram(vertexSrc, fragmentSrc string, opts *ProgramOptions) (Program, error) {
+	return BuildProgram(c, vertexSrc, fragmentSrc, opts)
+}
+
+// BuildProgram compiles vertexSrc and fragmentSrc and links them into a
+// program. The shaders are deleted once the program is linked, as the
+// program no longer needs them. If a stage fails, everything created so
+// far is deleted and a *ProgramError is returned.
+func BuildProgram(b Backend, vertexSrc, fragmentSrc string, opts *ProgramOptions) (Program, error) {
+	if opts == nil {
+		opts = &ProgramOptions{}
+	}
+
+	vs := compileShader(b, VERTEX_SHADER, vertexSrc)
+	fs := compileShader(b, FRAGMENT_SHADER, fragmentSrc)
+	var perr ProgramError
+	for _, s := range []struct {
+		shader Shader
+		stage  GLenum
+		source string
+	}{{vs, VERTEX_SHADER, vertexSrc}, {fs, FRAGMENT_SHADER, fragmentSrc}} {
+		if !b.GetShaderParameterb(s.shader, COMPILE_STATUS) {
+			log := b.GetShaderInfoLog(s.shader)
+			perr.Stages = append(perr.Stages, StageError{s.stage, log, ParseLog(log, s.source)})
+		}
+	}
+	if len(perr.Stages) > 0 {
+		b.DeleteShader(vs)
+		b.DeleteShader(fs)
+		return Program{}, &perr
+	}
+
+	p := b.CreateProgram()
+	b.AttachShader(p, vs)
+	b.AttachShader(p, fs)
+	names := make([]string, 0, len(opts.AttribLocations))
+	for name := range opts.AttribLocations {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		b.BindAttribLocation(p, opts.AttribLocations[name], name)
+	}
+	b.LinkProgram(p)
+	linked := b.GetProgramParameterb(p, LINK_STATUS)
+	var log string
+	if !linked {
+		log = b.GetProgramInfoLog(p)
+	}
+	b.DetachShader(p, vs)
+	b.DetachShader(p, fs)
+	b.DeleteShader(vs)
+	b.DeleteShader(fs)
+	if !linked {
+		b.DeleteProgram(p)
+		perr.Stages = append(perr.Stages, StageError{LINK_STATUS, log, ParseLog(log, "")})
+		return Program{}, &perr
+	}
+	return p, nil
+}
+
+func compileShader(b Backend, typ GLenum, source string) Shader {
+	s := b.CreateShader(typ)
+	b.ShaderSource(s, source)
+	b.CompileShader(s)
+	return s
+}
+
+// logContextLines is the number of source lines shown before and after
+// the line a log message is about.
+const logContextLines = 2
+
+var (
+	// ERROR: 0:12: 'foo' : undeclared identifier
+	logLine = regexp.MustCompile(`^(ERROR|WARNING):\s*\d+:(\d+):\s*(.*)$`)
+
+	// 0:12(5): error: `foo' undeclared
+	logLineColumn = regexp.MustCompile(`^\d+:(\d+)\((\d+)\):\s*(error|warning):\s*(.*)$`)
+
+	// ERROR: 1 compilation errors.  No code generated.
+	logSeverity = regexp.MustCompile(`^(ERROR|WARNING):\s*(.*)$`)
+)
+
+// ParseLog splits a shader or program info log into its messages. The
+// common formats "ERROR: 0:12: message" and "0:12(5): error: message" are
+// understood; other lines become entries with only a Message. If source
+// is the shader the log is about, each entry located in it gets the
+// surrounding source lines as Context.
+func ParseLog(log, source string) []LogEntry {
+	var lines []string
+	if source != "" {
+		lines = strings.Split(strings.TrimRight(source, "\n"), "\n")
+	}
+	var entries []LogEntry
+	for _, l := range strings.Split(log, "\n") {
+		l = strings.TrimSpace(strings.TrimRight(l, "\x00"))
+		if l == "" {
+			continue
+		}
+		var e LogEntry
+		if m := logLine.FindStringSubmatch(l); m != nil {
+			e.Severity = m[1]
+			e.Line, _ = strconv.Atoi(m[2])
+			e.Message = m[3]
+		} else if m := logLineColumn.FindStringSubmatch(l); m != nil {
+			e.Line, _ = strconv.Atoi(m[1])
+			e.Column, _ = strconv.Atoi(m[2])
+			e.Severity = strings.ToUpper(m[3])
+			e.Message = m[4]
+		} else if m := logSeverity.FindStringSubmatch(l); m != nil {
+			e.Severity = m[1]
+			e.Message = m[2]
+		} else {
+			e.Message = l
+		}
+		e.Context = sourceContext(lines, e.Line)
+		entries = append(entries, e)
+	}
+	return entries
+}
+
+// sourceContext returns the numbered lines around line n of lines, or nil
+// if n is not a line of lines.
+func sourceContext(lines []string, n int) []string {
+	if n < 1 || n > len(lines) {
+		return nil
+	}
+	first, last := n-logContextLines, n+logContextLines
+	if first < 1 {
+		first = 1
+	}
+	if last > len(lines) {
+		last = len(lines)
+	}
+	width := len(strconv.Itoa(last))
+	context := make([]string, 0, last-first+1)
+	for i := first; i <= last; i++ {
+		mark := ' '
+		if i == n {
+			mark = '>'
+		}
+		text := strings.TrimRight(lines[i-1], "\r")
+		context = append(context, fmt.Sprintf("%c %*d | %s", mark, width, i, text))
+	}
+	return context
+}
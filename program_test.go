@@ -0,0 +1,184 @@
+// Copyright 2014 Joseph Hager. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package webgl
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+const logSource = `precision mediump float;
+void main() {
+	gl_FragColor = foo;
+}
+`
+
+func TestParseLog(t *testing.T) {
+	// long has ten lines, so its line numbers are two digits wide.
+	long := strings.Repeat("//\n", 9) + "void main() { x; }\n"
+
+	tests := []struct {
+		name   string
+		log    string
+		source string
+		want   []LogEntry
+	}{
+		{
+			name:   "empty",
+			log:    "",
+			source: logSource,
+			want:   nil,
+		},
+		{
+			name: "ANGLE",
+			log: "ERROR: 0:3: 'foo' : undeclared identifier \n" +
+				"ERROR: 0:3: 'assign' :  cannot convert from 'const mediump float' to 'FragColor mediump 4-component vector of float'\n" +
+				"ERROR: 2 compilation errors.  No code generated.\n\n\x00",
+			source: logSource,
+			want: []LogEntry{
+				{
+					Severity: "ERROR",
+					Line:     3,
+					Message:  "'foo' : undeclared identifier",
+					Context: []string{
+						"  1 | precision mediump float;",
+						"  2 | void main() {",
+						"> 3 | \tgl_FragColor = foo;",
+						"  4 | }",
+					},
+				},
+				{
+					Severity: "ERROR",
+					Line:     3,
+					Message:  "'assign' :  cannot convert from 'const mediump float' to 'FragColor mediump 4-component vector of float'",
+					Context: []string{
+						"  1 | precision mediump float;",
+						"  2 | void main() {",
+						"> 3 | \tgl_FragColor = foo;",
+						"  4 | }",
+					},
+				},
+				{
+					Severity: "ERROR",
+					Message:  "2 compilation errors.  No code generated.",
+				},
+			},
+		},
+		{
+			name: "Mesa",
+			log: "0:3(17): error: `foo' undeclared\n" +
+				"0:3(2): warning: `gl_FragColor' used before being written\n",
+			source: logSource,
+			want: []LogEntry{
+				{
+					Severity: "ERROR",
+					Line:     3,
+					Column:   17,
+					Message:  "`foo' undeclared",
+					Context: []string{
+						"  1 | precision mediump float;",
+						"  2 | void main() {",
+						"> 3 | \tgl_FragColor = foo;",
+						"  4 | }",
+					},
+				},
+				{
+					Severity: "WARNING",
+					Line:     3,
+					Column:   2,
+					Message:  "`gl_FragColor' used before being written",
+					Context: []string{
+						"  1 | precision mediump float;",
+						"  2 | void main() {",
+						"> 3 | \tgl_FragColor = foo;",
+						"  4 | }",
+					},
+				},
+			},
+		},
+		{
+			name:   "NUL terminated",
+			log:    "ERROR: 0:1: '' : syntax error\x00\x00",
+			source: "",
+			want: []LogEntry{
+				{Severity: "ERROR", Line: 1, Message: "'' : syntax error"},
+			},
+		},
+		{
+			name:   "first line",
+			log:    "ERROR: 0:1: 'precision' : syntax error",
+			source: logSource,
+			want: []LogEntry{
+				{
+					Severity: "ERROR",
+					Line:     1,
+					Message:  "'precision' : syntax error",
+					Context: []string{
+						"> 1 | precision mediump float;",
+						"  2 | void main() {",
+						"  3 | \tgl_FragColor = foo;",
+					},
+				},
+			},
+		},
+		{
+			name:   "last line",
+			log:    "ERROR: 0:4: '}' : syntax error",
+			source: logSource,
+			want: []LogEntry{
+				{
+					Severity: "ERROR",
+					Line:     4,
+					Message:  "'}' : syntax error",
+					Context: []string{
+						"  2 | void main() {",
+						"  3 | \tgl_FragColor = foo;",
+						"> 4 | }",
+					},
+				},
+			},
+		},
+		{
+			name:   "past last line",
+			log:    "ERROR: 0:5: '' : unexpected end of file",
+			source: logSource,
+			want: []LogEntry{
+				{Severity: "ERROR", Line: 5, Message: "'' : unexpected end of file"},
+			},
+		},
+		{
+			name:   "line number width",
+			log:    "0:10(15): error: `x' undeclared",
+			source: long,
+			want: []LogEntry{
+				{
+					Severity: "ERROR",
+					Line:     10,
+					Column:   15,
+					Message:  "`x' undeclared",
+					Context: []string{
+						"   8 | //",
+						"   9 | //",
+						"> 10 | void main() { x; }",
+					},
+				},
+			},
+		},
+		{
+			name:   "link",
+			log:    "Varyings with the same name but different type, or statically used varyings in fragment shader are not declared in vertex shader: v_uv\n",
+			source: "",
+			want: []LogEntry{
+				{Message: "Varyings with the same name but different type, or statically used varyings in fragment shader are not declared in vertex shader: v_uv"},
+			},
+		},
+	}
+	for _, test := range tests {
+		if got := ParseLog(test.log, test.source); !reflect.DeepEqual(got, test.want) {
+			t.Errorf("%s: ParseLog =\n%#v\nwant\n%#v", test.name, got, test.want)
+		}
+	}
+}
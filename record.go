@@ -0,0 +1,1451 @@
+// Copyright 2014 Joseph Hager. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package webgl
+
+import (
+	"bytes"
+	"fmt"
+	"hash/crc32"
+	"math"
+	"strings"
+)
+
+// Call is a WebGL call recorded by a Recorder. Handle arguments and results
+// are replaced by stable names such as "buffer1" or "program2.u_color",
+// which number the objects of each kind in the order they were created.
+type Call struct {
+	Method string
+	Args   []interface{}
+
+	// Result is the value returned by the call, or nil if it returns nothing.
+	Result interface{}
+}
+
+// String formats the call as it appears in a trace, for example
+// `BindBuffer(ARRAY_BUFFER, buffer1)` or `CreateShader(VERTEX_SHADER) = shader1`.
+func (c Call) String() string {
+	var buf bytes.Buffer
+	buf.WriteString(c.Method)
+	buf.WriteByte('(')
+	for i, arg := range c.Args {
+		if i > 0 {
+			buf.WriteString(", ")
+		}
+		buf.WriteString(formatArg(arg))
+	}
+	buf.WriteByte(')')
+	if c.Result != nil {
+		buf.WriteString(" = ")
+		buf.WriteString(formatArg(c.Result))
+	}
+	return buf.String()
+}
+
+// ref is the recorded form of a handle.
+type ref string
+
+// maxInlineElements is the longest slice that is formatted element by
+// element. Longer slices are summarized by length and checksum.
+const maxInlineElements = 16
+
+func formatArg(arg interface{}) string {
+	switch v := arg.(type) {
+	case nil:
+		return "null"
+	case ref:
+		return string(v)
+	case string:
+		return fmt.Sprintf("%q", v)
+	case float32:
+		return formatFloat(float64(v))
+	case float64:
+		return formatFloat(v)
+	case []float32:
+		if len(v) > maxInlineElements {
+			buf := make([]byte, 4*len(v))
+			for i, f := range v {
+				putUint32(buf[4*i:], math.Float32bits(f))
+			}
+			return summarize("[]float32", len(v), buf)
+		}
+		s := make([]string, len(v))
+		for i, f := range v {
+			s[i] = formatFloat(float64(f))
+		}
+		return "[" + strings.Join(s, " ") + "]"
+	case []byte:
+		if len(v) > maxInlineElements {
+			return summarize("[]byte", len(v), v)
+		}
+	case []uint16:
+		if len(v) > maxInlineElements {
+			buf := make([]byte, 2*len(v))
+			for i, x := range v {
+				buf[2*i], buf[2*i+1] = byte(x), byte(x>>8)
+			}
+			return summarize("[]uint16", len(v), buf)
+		}
+	case []int32:
+		if len(v) > maxInlineElements {
+			buf := make([]byte, 4*len(v))
+			for i, x := range v {
+				putUint32(buf[4*i:], uint32(x))
+			}
+			return summarize("[]int32", len(v), buf)
+		}
+	case []ref:
+		s := make([]string, len(v))
+		for i, r := range v {
+			s[i] = string(r)
+		}
+		return "[" + strings.Join(s, " ") + "]"
+	case []string:
+		s := make([]string, len(v))
+		for i, x := range v {
+			s[i] = fmt.Sprintf("%q", x)
+		}
+		return "[" + strings.Join(s, " ") + "]"
+	case ContextAttributes:
+		return fmt.Sprintf("%+v", v)
+	case ActiveInfo:
+		return fmt.Sprintf("{Name:%q Size:%d Type:%v}", v.Name, v.Size, v.Type)
+	case StencilState:
+		return fmt.Sprintf("{Func:%v Ref:%d ValueMask:%v WriteMask:%v Fail:%v ZFail:%v ZPass:%v}",
+			v.Func, v.Ref, bitmask(v.ValueMask), bitmask(v.WriteMask), v.Fail, v.ZFail, v.ZPass)
+	}
+	return fmt.Sprint(arg)
+}
+
+func formatFloat(f float64) string {
+	return fmt.Sprintf("%g", f)
+}
+
+func putUint32(b []byte, v uint32) {
+	b[0], b[1], b[2], b[3] = byte(v), byte(v>>8), byte(v>>16), byte(v>>24)
+}
+
+func summarize(typ string, n int, data []byte) string {
+	return fmt.Sprintf("%s{len %d, crc32 %08x}", typ, n, crc32.ChecksumIEEE(data))
+}
+
+// copyArg returns a copy of slice arguments, which callers are free to
+// reuse once the call returns.
+func copyArg(arg interface{}) interface{} {
+	switch v := arg.(type) {
+	case []float32:
+		return append([]float32(nil), v...)
+	case []byte:
+		return append([]byte(nil), v...)
+	case []uint16:
+		return append([]uint16(nil), v...)
+	case []int32:
+		return append([]int32(nil), v...)
+	case []uint32:
+		return append([]uint32(nil), v...)
+	case []int:
+		return append([]int(nil), v...)
+	}
+	return arg
+}
+
+// Recorder is a Backend that records every call made on it, so that the
+// calls issued by rendering code can be inspected or compared against a
+// golden trace in tests with webgltest.CompareGolden.
+//
+// A Recorder either forwards calls to another Backend and returns its
+// results, or, when created without one, stands alone: it then creates
+// handles itself, reports shaders as compiled and programs as linked, and
+// returns zero values from the remaining queries.
+type Recorder struct {
+	backend Backend
+	calls   []Call
+
+	names  map[interface{}]string
+	counts map[string]int
+	nextID uint32
+
+	// State kept by a standalone Recorder to answer queries. It outlives
+	// Reset, as the objects it describes do.
+	created  map[interface{}]bool
+	deleted  map[interface{}]bool
+	sources  map[*object]string
+	attached map[*object][]Shader
+	attribs  map[*object]map[string]int
+}
+
+// NewRecorder returns a Recorder that forwards calls to backend, or a
+// standalone Recorder if backend is nil.
+func NewRecorder(backend Backend) *Recorder {
+	r := &Recorder{
+		backend:  backend,
+		created:  make(map[interface{}]bool),
+		deleted:  make(map[interface{}]bool),
+		sources:  make(map[*object]string),
+		attached: make(map[*object][]Shader),
+		attribs:  make(map[*object]map[string]int),
+	}
+	r.Reset()
+	return r
+}
+
+// Calls returns the calls recorded since the Recorder was created or last reset.
+func (r *Recorder) Calls() []Call {
+	return r.calls
+}
+
+// Trace returns the recorded calls formatted one per line.
+func (r *Recorder) Trace() string {
+	var buf bytes.Buffer
+	for _, c := range r.calls {
+		buf.WriteString(c.String())
+		buf.WriteByte('\n')
+	}
+	return buf.String()
+}
+
+// Reset discards the recorded calls and restarts the numbering of handles.
+// Handles created before the reset keep working but are renamed the next
+// time they are used.
+func (r *Recorder) Reset() {
+	r.calls = nil
+	r.names = make(map[interface{}]string)
+	r.counts = make(map[string]int)
+}
+
+func (r *Recorder) record(method string, args ...interface{}) {
+	for i, arg := range args {
+		args[i] = copyArg(arg)
+	}
+	r.calls = append(r.calls, Call{Method: method, Args: args})
+}
+
+func (r *Recorder) recordResult(result interface{}, method string, args ...interface{}) {
+	r.record(method, args...)
+	r.calls[len(r.calls)-1].Result = result
+}
+
+// key identifies the object behind a handle. Handles of backends that
+// identify objects by id are keyed by id, since such backends may return
+// a new handle for the same object.
+func key(o *object) interface{} {
+	if o.ID() != 0 {
+		return o.ID()
+	}
+	return o
+}
+
+// ref returns the recorded name of o, naming it after kind if it has not
+// been seen before.
+func (r *Recorder) ref(kind string, o *object) ref {
+	if o == nil {
+		return "null"
+	}
+	name, ok := r.names[key(o)]
+	if !ok {
+		r.counts[kind]++
+		name = fmt.Sprintf("%s%d", kind, r.counts[kind])
+		r.names[key(o)] = name
+	}
+	return ref(name)
+}
+
+// create returns o, or a new object if the Recorder stands alone.
+func (r *Recorder) create(o *object) *object {
+	if r.backend != nil {
+		return o
+	}
+	r.nextID++
+	o = handle(r.nextID)
+	r.created[key(o)] = true
+	return o
+}
+
+// alive reports whether o was created by a standalone Recorder and has
+// not been deleted.
+func (r *Recorder) alive(o *object) bool {
+	return r.created[key(o)] && !r.deleted[key(o)]
+}
+
+func (r *Recorder) GetContextAttributes() ContextAttributes {
+	ca := *DefaultAttributes()
+	if r.backend != nil {
+		ca = r.backend.GetContextAttributes()
+	}
+	r.recordResult(ca, "GetContextAttributes")
+	return ca
+}
+
+func (r *Recorder) ActiveTexture(texture GLenum) {
+	r.record("ActiveTexture", texture)
+	if r.backend != nil {
+		r.backend.ActiveTexture(texture)
+	}
+}
+
+func (r *Recorder) AttachShader(program Program, shader Shader) {
+	r.record("AttachShader", r.ref("program", program.object), r.ref("shader", shader.object))
+	if r.backend != nil {
+		r.backend.AttachShader(program, shader)
+		return
+	}
+	r.attached[program.object] = append(r.attached[program.object], shader)
+}
+
+func (r *Recorder) BindAttribLocation(program Program, index int, name string) {
+	r.record("BindAttribLocation", r.ref("program", program.object), index, name)
+	if r.backend != nil {
+		r.backend.BindAttribLocation(program, index, name)
+		return
+	}
+	if r.attribs[program.object] == nil {
+		r.attribs[program.object] = make(map[string]int)
+	}
+	r.attribs[program.object][name] = index
+}
+
+func (r *Recorder) BindBuffer(target GLenum, buffer Buffer) {
+	r.record("BindBuffer", target, r.ref("buffer", buffer.object))
+	if r.backend != nil {
+		r.backend.BindBuffer(target, buffer)
+	}
+}
+
+func (r *Recorder) BindFramebuffer(target GLenum, framebuffer Framebuffer) {
+	r.record("BindFramebuffer", target, r.ref("framebuffer", framebuffer.object))
+	if r.backend != nil {
+		r.backend.BindFramebuffer(target, framebuffer)
+	}
+}
+
+func (r *Recorder) BindRenderbuffer(target GLenum, renderbuffer Renderbuffer) {
+	r.record("BindRenderbuffer", target, r.ref("renderbuffer", renderbuffer.object))
+	if r.backend != nil {
+		r.backend.BindRenderbuffer(target, renderbuffer)
+	}
+}
+
+func (r *Recorder) BindTexture(target GLenum, texture Texture) {
+	r.record("BindTexture", target, r.ref("texture", texture.object))
+	if r.backend != nil {
+		r.backend.BindTexture(target, texture)
+	}
+}
+
+func (r *Recorder) BlendColor(red, green, blue, alpha float64) {
+	r.record("BlendColor", red, green, blue, alpha)
+	if r.backend != nil {
+		r.backend.BlendColor(red, green, blue, alpha)
+	}
+}
+
+func (r *Recorder) BlendEquation(mode GLenum) {
+	r.record("BlendEquation", mode)
+	if r.backend != nil {
+		r.backend.BlendEquation(mode)
+	}
+}
+
+func (r *Recorder) BlendEquationSeparate(modeRGB, modeAlpha GLenum) {
+	r.record("BlendEquationSeparate", modeRGB, modeAlpha)
+	if r.backend != nil {
+		r.backend.BlendEquationSeparate(modeRGB, modeAlpha)
+	}
+}
+
+func (r *Recorder) BlendFunc(sfactor, dfactor GLenum) {
+	r.record("BlendFunc", blendFactor(sfactor), blendFactor(dfactor))
+	if r.backend != nil {
+		r.backend.BlendFunc(sfactor, dfactor)
+	}
+}
+
+func (r *Recorder) BlendFuncSeparate(srcRGB, dstRGB, srcAlpha, dstAlpha GLenum) {
+	r.record("BlendFuncSeparate", blendFactor(srcRGB), blendFactor(dstRGB), blendFactor(srcAlpha), blendFactor(dstAlpha))
+	if r.backend != nil {
+		r.backend.BlendFuncSeparate(srcRGB, dstRGB, srcAlpha, dstAlpha)
+	}
+}
+
+func (r *Recorder) BufferData(target GLenum, data interface{}, usage GLenum) {
+	r.record("BufferData", target, data, usage)
+	if r.backend != nil {
+		r.backend.BufferData(target, data, usage)
+	}
+}
+
+func (r *Recorder) BufferSubData(target GLenum, offset int, data interface{}) {
+	r.record("BufferSubData", target, offset, data)
+	if r.backend != nil {
+		r.backend.BufferSubData(target, offset, data)
+	}
+}
+
+func (r *Recorder) BufferDataSize(target GLenum, size int, usage GLenum) {
+	r.record("BufferDataSize", target, size, usage)
+	if r.backend != nil {
+		r.backend.BufferDataSize(target, size, usage)
+	}
+}
+
+func (r *Recorder) BufferDataBytes(target GLenum, data []byte, usage GLenum) {
+	r.record("BufferDataBytes", target, data, usage)
+	if r.backend != nil {
+		r.backend.BufferDataBytes(target, data, usage)
+	}
+}
+
+func (r *Recorder) BufferDataUint16(target GLenum, data []uint16, usage GLenum) {
+	r.record("BufferDataUint16", target, data, usage)
+	if r.backend != nil {
+		r.backend.BufferDataUint16(target, data, usage)
+	}
+}
+
+func (r *Recorder) BufferDataFloat32(target GLenum, data []float32, usage GLenum) {
+	r.record("BufferDataFloat32", target, data, usage)
+	if r.backend != nil {
+		r.backend.BufferDataFloat32(target, data, usage)
+	}
+}
+
+func (r *Recorder) BufferSubDataBytes(target GLenum, offset int, data []byte) {
+	r.record("BufferSubDataBytes", target, offset, data)
+	if r.backend != nil {
+		r.backend.BufferSubDataBytes(target, offset, data)
+	}
+}
+
+func (r *Recorder) BufferSubDataUint16(target GLenum, offset int, data []uint16) {
+	r.record("BufferSubDataUint16", target, offset, data)
+	if r.backend != nil {
+		r.backend.BufferSubDataUint16(target, offset, data)
+	}
+}
+
+func (r *Recorder) BufferSubDataFloat32(target GLenum, offset int, data []float32) {
+	r.record("BufferSubDataFloat32", target, offset, data)
+	if r.backend != nil {
+		r.backend.BufferSubDataFloat32(target, offset, data)
+	}
+}
+
+func (r *Recorder) CheckFramebufferStatus(target GLenum) GLenum {
+	status := FRAMEBUFFER_COMPLETE
+	if r.backend != nil {
+		status = r.backend.CheckFramebufferStatus(target)
+	}
+	r.recordResult(status, "CheckFramebufferStatus", target)
+	return status
+}
+
+func (r *Recorder) Clear(flags GLenum) {
+	r.record("Clear", clearFlags(flags))
+	if r.backend != nil {
+		r.backend.Clear(flags)
+	}
+}
+
+// output formats a slice the call writes into, whose contents are not
+// known when it is recorded.
+type output struct {
+	typ string
+	n   int
+}
+
+func (o output) String() string {
+	return fmt.Sprintf("%s{len %d}", o.typ, o.n)
+}
+
+// blendFactor formats a blend factor, for which 0 and 1 are ZERO and ONE.
+type blendFactor GLenum
+
+func (f blendFactor) String() string {
+	switch GLenum(f) {
+	case ZERO:
+		return "ZERO"
+	case ONE:
+		return "ONE"
+	}
+	return GLenum(f).String()
+}
+
+// clearFlags formats the mask passed to Clear as its bits.
+type clearFlags GLenum
+
+func (f clearFlags) String() string {
+	var bits []string
+	for _, bit := range []GLenum{COLOR_BUFFER_BIT, DEPTH_BUFFER_BIT, STENCIL_BUFFER_BIT} {
+		if GLenum(f)&bit != 0 {
+			bits = append(bits, bit.String())
+			f &^= clearFlags(bit)
+		}
+	}
+	if f != 0 || len(bits) == 0 {
+		bits = append(bits, fmt.Sprintf("0x%X", int(f)))
+	}
+	return strings.Join(bits, "|")
+}
+
+// bitmask formats a stencil mask in hexadecimal.
+type bitmask uint32
+
+func (m bitmask) String() string {
+	return fmt.Sprintf("0x%X", uint32(m))
+}
+
+func (r *Recorder) ClearColor(red, green, blue, alpha float32) {
+	r.record("ClearColor", red, green, blue, alpha)
+	if r.backend != nil {
+		r.backend.ClearColor(red, green, blue, alpha)
+	}
+}
+
+func (r *Recorder) ClearDepth(depth float64) {
+	r.record("ClearDepth", depth)
+	if r.backend != nil {
+		r.backend.ClearDepth(depth)
+	}
+}
+
+func (r *Recorder) ClearStencil(s int) {
+	r.record("ClearStencil", s)
+	if r.backend != nil {
+		r.backend.ClearStencil(s)
+	}
+}
+
+func (r *Recorder) ColorMask(red, green, blue, alpha bool) {
+	r.record("ColorMask", red, green, blue, alpha)
+	if r.backend != nil {
+		r.backend.ColorMask(red, green, blue, alpha)
+	}
+}
+
+func (r *Recorder) CompileShader(shader Shader) {
+	r.record("CompileShader", r.ref("shader", shader.object))
+	if r.backend != nil {
+		r.backend.CompileShader(shader)
+	}
+}
+
+func (r *Recorder) CopyTexImage2D(target GLenum, level int, internal GLenum, x, y, w, h, border int) {
+	r.record("CopyTexImage2D", target, level, internal, x, y, w, h, border)
+	if r.backend != nil {
+		r.backend.CopyTexImage2D(target, level, internal, x, y, w, h, border)
+	}
+}
+
+func (r *Recorder) CopyTexSubImage2D(target GLenum, level, xoffset, yoffset, x, y, w, h int) {
+	r.record("CopyTexSubImage2D", target, level, xoffset, yoffset, x, y, w, h)
+	if r.backend != nil {
+		r.backend.CopyTexSubImage2D(target, level, xoffset, yoffset, x, y, w, h)
+	}
+}
+
+func (r *Recorder) CreateBuffer() Buffer {
+	var b Buffer
+	if r.backend != nil {
+		b = r.backend.CreateBuffer()
+	}
+	b.object = r.create(b.object)
+	r.recordResult(r.ref("buffer", b.object), "CreateBuffer")
+	return b
+}
+
+func (r *Recorder) CreateFramebuffer() Framebuffer {
+	var fb Framebuffer
+	if r.backend != nil {
+		fb = r.backend.CreateFramebuffer()
+	}
+	fb.object = r.create(fb.object)
+	r.recordResult(r.ref("framebuffer", fb.object), "CreateFramebuffer")
+	return fb
+}
+
+func (r *Recorder) CreateProgram() Program {
+	var p Program
+	if r.backend != nil {
+		p = r.backend.CreateProgram()
+	}
+	p.object = r.create(p.object)
+	r.recordResult(r.ref("program", p.object), "CreateProgram")
+	return p
+}
+
+func (r *Recorder) CreateRenderbuffer() Renderbuffer {
+	var rb Renderbuffer
+	if r.backend != nil {
+		rb = r.backend.CreateRenderbuffer()
+	}
+	rb.object = r.create(rb.object)
+	r.recordResult(r.ref("renderbuffer", rb.object), "CreateRenderbuffer")
+	return rb
+}
+
+func (r *Recorder) CreateShader(typ GLenum) Shader {
+	var s Shader
+	if r.backend != nil {
+		s = r.backend.CreateShader(typ)
+	}
+	s.object = r.create(s.object)
+	r.recordResult(r.ref("shader", s.object), "CreateShader", typ)
+	return s
+}
+
+func (r *Recorder) CreateTexture() Texture {
+	var t Texture
+	if r.backend != nil {
+		t = r.backend.CreateTexture()
+	}
+	t.object = r.create(t.object)
+	r.recordResult(r.ref("texture", t.object), "CreateTexture")
+	return t
+}
+
+func (r *Recorder) CullFace(mode GLenum) {
+	r.record("CullFace", mode)
+	if r.backend != nil {
+		r.backend.CullFace(mode)
+	}
+}
+
+func (r *Recorder) DeleteBuffer(buffer Buffer) {
+	r.record("DeleteBuffer", r.ref("buffer", buffer.object))
+	if r.backend != nil {
+		r.backend.DeleteBuffer(buffer)
+	}
+	r.deleted[key(buffer.object)] = true
+}
+
+func (r *Recorder) DeleteFramebuffer(framebuffer Framebuffer) {
+	r.record("DeleteFramebuffer", r.ref("framebuffer", framebuffer.object))
+	if r.backend != nil {
+		r.backend.DeleteFramebuffer(framebuffer)
+	}
+	r.deleted[key(framebuffer.object)] = true
+}
+
+func (r *Recorder) DeleteProgram(program Program) {
+	r.record("DeleteProgram", r.ref("program", program.object))
+	if r.backend != nil {
+		r.backend.DeleteProgram(program)
+	}
+	r.deleted[key(program.object)] = true
+}
+
+func (r *Recorder) DeleteRenderbuffer(renderbuffer Renderbuffer) {
+	r.record("DeleteRenderbuffer", r.ref("renderbuffer", renderbuffer.object))
+	if r.backend != nil {
+		r.backend.DeleteRenderbuffer(renderbuffer)
+	}
+	r.deleted[key(renderbuffer.object)] = true
+}
+
+func (r *Recorder) DeleteShader(shader Shader) {
+	r.record("DeleteShader", r.ref("shader", shader.object))
+	if r.backend != nil {
+		r.backend.DeleteShader(shader)
+	}
+	r.deleted[key(shader.object)] = true
+}
+
+func (r *Recorder) DeleteTexture(texture Texture) {
+	r.record("DeleteTexture", r.ref("texture", texture.object))
+	if r.backend != nil {
+		r.backend.DeleteTexture(texture)
+	}
+	r.deleted[key(texture.object)] = true
+}
+
+func (r *Recorder) DepthFunc(fun GLenum) {
+	r.record("DepthFunc", fun)
+	if r.backend != nil {
+		r.backend.DepthFunc(fun)
+	}
+}
+
+func (r *Recorder) DepthMask(flag bool) {
+	r.record("DepthMask", flag)
+	if r.backend != nil {
+		r.backend.DepthMask(flag)
+	}
+}
+
+func (r *Recorder) DepthRange(zNear, zFar float64) {
+	r.record("DepthRange", zNear, zFar)
+	if r.backend != nil {
+		r.backend.DepthRange(zNear, zFar)
+	}
+}
+
+func (r *Recorder) DetachShader(program Program, shader Shader) {
+	r.record("DetachShader", r.ref("program", program.object), r.ref("shader", shader.object))
+	if r.backend != nil {
+		r.backend.DetachShader(program, shader)
+		return
+	}
+	shaders := r.attached[program.object]
+	for i, s := range shaders {
+		if s == shader {
+			r.attached[program.object] = append(shaders[:i:i], shaders[i+1:]...)
+			break
+		}
+	}
+}
+
+func (r *Recorder) Disable(cap GLenum) {
+	r.record("Disable", cap)
+	if r.backend != nil {
+		r.backend.Disable(cap)
+	}
+}
+
+func (r *Recorder) DisableVertexAttribArray(index int) {
+	r.record("DisableVertexAttribArray", index)
+	if r.backend != nil {
+		r.backend.DisableVertexAttribArray(index)
+	}
+}
+
+func (r *Recorder) DrawingBufferWidth() int {
+	var width int
+	if r.backend != nil {
+		width = r.backend.DrawingBufferWidth()
+	}
+	r.recordResult(width, "DrawingBufferWidth")
+	return width
+}
+
+func (r *Recorder) DrawingBufferHeight() int {
+	var height int
+	if r.backend != nil {
+		height = r.backend.DrawingBufferHeight()
+	}
+	r.recordResult(height, "DrawingBufferHeight")
+	return height
+}
+
+func (r *Recorder) DrawArrays(mode GLenum, first, count int) {
+	r.record("DrawArrays", mode, first, count)
+	if r.backend != nil {
+		r.backend.DrawArrays(mode, first, count)
+	}
+}
+
+func (r *Recorder) DrawElements(mode GLenum, count int, typ GLenum, offset int) {
+	r.record("DrawElements", mode, count, typ, offset)
+	if r.backend != nil {
+		r.backend.DrawElements(mode, count, typ, offset)
+	}
+}
+
+func (r *Recorder) Enable(cap GLenum) {
+	r.record("Enable", cap)
+	if r.backend != nil {
+		r.backend.Enable(cap)
+	}
+}
+
+func (r *Recorder) EnableVertexAttribArray(index int) {
+	r.record("EnableVertexAttribArray", index)
+	if r.backend != nil {
+		r.backend.EnableVertexAttribArray(index)
+	}
+}
+
+func (r *Recorder) Finish() {
+	r.record("Finish")
+	if r.backend != nil {
+		r.backend.Finish()
+	}
+}
+
+func (r *Recorder) Flush() {
+	r.record("Flush")
+	if r.backend != nil {
+		r.backend.Flush()
+	}
+}
+
+func (r *Recorder) FramebufferRenderbuffer(target, attachment, renderbufferTarget GLenum, renderbuffer Renderbuffer) {
+	r.record("FramebufferRenderbuffer", target, attachment, renderbufferTarget, r.ref("renderbuffer", renderbuffer.object))
+	if r.backend != nil {
+		r.backend.FramebufferRenderbuffer(target, attachment, renderbufferTarget, renderbuffer)
+	}
+}
+
+func (r *Recorder) FramebufferTexture2D(target, attachment, textarget GLenum, texture Texture, level int) {
+	r.record("FramebufferTexture2D", target, attachment, textarget, r.ref("texture", texture.object), level)
+	if r.backend != nil {
+		r.backend.FramebufferTexture2D(target, attachment, textarget, texture, level)
+	}
+}
+
+func (r *Recorder) FrontFace(mode GLenum) {
+	r.record("FrontFace", mode)
+	if r.backend != nil {
+		r.backend.FrontFace(mode)
+	}
+}
+
+func (r *Recorder) GenerateMipmap(target GLenum) {
+	r.record("GenerateMipmap", target)
+	if r.backend != nil {
+		r.backend.GenerateMipmap(target)
+	}
+}
+
+func (r *Recorder) GetActiveAttribInfo(program Program, index int) ActiveInfo {
+	var info ActiveInfo
+	if r.backend != nil {
+		info = r.backend.GetActiveAttribInfo(program, index)
+	}
+	r.recordResult(info, "GetActiveAttribInfo", r.ref("program", program.object), index)
+	return info
+}
+
+func (r *Recorder) GetActiveUniformInfo(program Program, index int) ActiveInfo {
+	var info ActiveInfo
+	if r.backend != nil {
+		info = r.backend.GetActiveUniformInfo(program, index)
+	}
+	r.recordResult(info, "GetActiveUniformInfo", r.ref("program", program.object), index)
+	return info
+}
+
+func (r *Recorder) GetAttachedShaders(program Program) []Shader {
+	var shaders []Shader
+	if r.backend != nil {
+		shaders = r.backend.GetAttachedShaders(program)
+	} else {
+		shaders = append(shaders, r.attached[program.object]...)
+	}
+	refs := make([]ref, len(shaders))
+	for i, s := range shaders {
+		refs[i] = r.ref("shader", s.object)
+	}
+	r.recordResult(refs, "GetAttachedShaders", r.ref("program", program.object))
+	return shaders
+}
+
+func (r *Recorder) GetAttribLocation(program Program, name string) int {
+	var index int
+	if r.backend != nil {
+		index = r.backend.GetAttribLocation(program, name)
+	} else {
+		attribs := r.attribs[program.object]
+		if attribs == nil {
+			attribs = make(map[string]int)
+			r.attribs[program.object] = attribs
+		}
+		i, ok := attribs[name]
+		if !ok {
+			i = len(attribs)
+			attribs[name] = i
+		}
+		index = i
+	}
+	r.recordResult(index, "GetAttribLocation", r.ref("program", program.object), name)
+	return index
+}
+
+func (r *Recorder) GetError() ErrorCode {
+	err := NO_ERROR
+	if r.backend != nil {
+		err = r.backend.GetError()
+	}
+	r.recordResult(err, "GetError")
+	return err
+}
+
+func (r *Recorder) GetProgramParameteri(program Program, pname GLenum) int {
+	var v int
+	if r.backend != nil {
+		v = r.backend.GetProgramParameteri(program, pname)
+	} else if pname == ATTACHED_SHADERS {
+		v = len(r.attached[program.object])
+	}
+	r.recordResult(v, "GetProgramParameteri", r.ref("program", program.object), pname)
+	return v
+}
+
+func (r *Recorder) GetProgramParameterb(program Program, pname GLenum) bool {
+	var v bool
+	if r.backend != nil {
+		v = r.backend.GetProgramParameterb(program, pname)
+	} else {
+		v = pname == LINK_STATUS || pname == VALIDATE_STATUS
+	}
+	r.recordResult(v, "GetProgramParameterb", r.ref("program", program.object), pname)
+	return v
+}
+
+func (r *Recorder) GetProgramInfoLog(program Program) string {
+	var log string
+	if r.backend != nil {
+		log = r.backend.GetProgramInfoLog(program)
+	}
+	r.recordResult(log, "GetProgramInfoLog", r.ref("program", program.object))
+	return log
+}
+
+func (r *Recorder) GetShaderParameterb(shader Shader, pname GLenum) bool {
+	var v bool
+	if r.backend != nil {
+		v = r.backend.GetShaderParameterb(shader, pname)
+	} else {
+		v = pname == COMPILE_STATUS
+	}
+	r.recordResult(v, "GetShaderParameterb", r.ref("shader", shader.object), pname)
+	return v
+}
+
+func (r *Recorder) GetShaderInfoLog(shader Shader) string {
+	var log string
+	if r.backend != nil {
+		log = r.backend.GetShaderInfoLog(shader)
+	}
+	r.recordResult(log, "GetShaderInfoLog", r.ref("shader", shader.object))
+	return log
+}
+
+func (r *Recorder) GetShaderSource(shader Shader) string {
+	var source string
+	if r.backend != nil {
+		source = r.backend.GetShaderSource(shader)
+	} else {
+		source = r.sources[shader.object]
+	}
+	r.recordResult(source, "GetShaderSource", r.ref("shader", shader.object))
+	return source
+}
+
+func (r *Recorder) GetStencilBits() int {
+	var bits int
+	if r.backend != nil {
+		bits = r.backend.GetStencilBits()
+	}
+	r.recordResult(bits, "GetStencilBits")
+	return bits
+}
+
+func (r *Recorder) GetStencilClearValue() int {
+	var s int
+	if r.backend != nil {
+		s = r.backend.GetStencilClearValue()
+	}
+	r.recordResult(s, "GetStencilClearValue")
+	return s
+}
+
+// GetStencilState returns the default state when the Recorder has no
+// backend.
+func (r *Recorder) GetStencilState(face GLenum) StencilState {
+	state := DefaultStencilState()
+	if r.backend != nil {
+		state = r.backend.GetStencilState(face)
+	}
+	r.recordResult(state, "GetStencilState", face)
+	return state
+}
+
+func (r *Recorder) GetSupportedExtensions() []string {
+	var extensions []string
+	if r.backend != nil {
+		extensions = r.backend.GetSupportedExtensions()
+	}
+	r.recordResult(extensions, "GetSupportedExtensions")
+	return extensions
+}
+
+func (r *Recorder) GetUniformLocation(program Program, name string) UniformLocation {
+	var loc UniformLocation
+	if r.backend != nil {
+		loc = r.backend.GetUniformLocation(program, name)
+	}
+	loc.object = r.create(loc.object)
+	if loc.object != nil {
+		// Name locations after their uniform, so that repeated lookups of
+		// the same uniform read the same in the trace.
+		r.names[key(loc.object)] = fmt.Sprintf("%s.%s", r.ref("program", program.object), name)
+	}
+	r.recordResult(r.ref("location", loc.object), "GetUniformLocation", r.ref("program", program.object), name)
+	return loc
+}
+
+func (r *Recorder) GetVertexAttribOffset(index int, pname GLenum) int {
+	var offset int
+	if r.backend != nil {
+		offset = r.backend.GetVertexAttribOffset(index, pname)
+	}
+	r.recordResult(offset, "GetVertexAttribOffset", index, pname)
+	return offset
+}
+
+func (r *Recorder) Hint(target, mode GLenum) {
+	r.record("Hint", target, mode)
+	if r.backend != nil {
+		r.backend.Hint(target, mode)
+	}
+}
+
+func (r *Recorder) IsBuffer(buffer Buffer) bool {
+	v := r.alive(buffer.object)
+	if r.backend != nil {
+		v = r.backend.IsBuffer(buffer)
+	}
+	r.recordResult(v, "IsBuffer", r.ref("buffer", buffer.object))
+	return v
+}
+
+func (r *Recorder) IsContextLost() bool {
+	var v bool
+	if r.backend != nil {
+		v = r.backend.IsContextLost()
+	}
+	r.recordResult(v, "IsContextLost")
+	return v
+}
+
+func (r *Recorder) IsFramebuffer(framebuffer Framebuffer) bool {
+	v := r.alive(framebuffer.object)
+	if r.backend != nil {
+		v = r.backend.IsFramebuffer(framebuffer)
+	}
+	r.recordResult(v, "IsFramebuffer", r.ref("framebuffer", framebuffer.object))
+	return v
+}
+
+func (r *Recorder) IsProgram(program Program) bool {
+	v := r.alive(program.object)
+	if r.backend != nil {
+		v = r.backend.IsProgram(program)
+	}
+	r.recordResult(v, "IsProgram", r.ref("program", program.object))
+	return v
+}
+
+func (r *Recorder) IsRenderbuffer(renderbuffer Renderbuffer) bool {
+	v := r.alive(renderbuffer.object)
+	if r.backend != nil {
+		v = r.backend.IsRenderbuffer(renderbuffer)
+	}
+	r.recordResult(v, "IsRenderbuffer", r.ref("renderbuffer", renderbuffer.object))
+	return v
+}
+
+func (r *Recorder) IsShader(shader Shader) bool {
+	v := r.alive(shader.object)
+	if r.backend != nil {
+		v = r.backend.IsShader(shader)
+	}
+	r.recordResult(v, "IsShader", r.ref("shader", shader.object))
+	return v
+}
+
+func (r *Recorder) IsTexture(texture Texture) bool {
+	v := r.alive(texture.object)
+	if r.backend != nil {
+		v = r.backend.IsTexture(texture)
+	}
+	r.recordResult(v, "IsTexture", r.ref("texture", texture.object))
+	return v
+}
+
+func (r *Recorder) IsEnabled(capability GLenum) bool {
+	v := capability == DITHER
+	if r.backend != nil {
+		v = r.backend.IsEnabled(capability)
+	}
+	r.recordResult(v, "IsEnabled", capability)
+	return v
+}
+
+func (r *Recorder) LineWidth(width float64) {
+	r.record("LineWidth", width)
+	if r.backend != nil {
+		r.backend.LineWidth(width)
+	}
+}
+
+func (r *Recorder) LinkProgram(program Program) {
+	r.record("LinkProgram", r.ref("program", program.object))
+	if r.backend != nil {
+		r.backend.LinkProgram(program)
+	}
+}
+
+func (r *Recorder) PixelStorei(pname GLenum, param int) {
+	r.record("PixelStorei", pname, param)
+	if r.backend != nil {
+		r.backend.PixelStorei(pname, param)
+	}
+}
+
+func (r *Recorder) PolygonOffset(factor, units float64) {
+	r.record("PolygonOffset", factor, units)
+	if r.backend != nil {
+		r.backend.PolygonOffset(factor, units)
+	}
+}
+
+func (r *Recorder) ReadPixelsBytes(x, y, width, height int, format, typ GLenum, pixels []byte) {
+	r.record("ReadPixelsBytes", x, y, width, height, format, typ, output{"[]byte", len(pixels)})
+	if r.backend != nil {
+		r.backend.ReadPixelsBytes(x, y, width, height, format, typ, pixels)
+	}
+}
+
+func (r *Recorder) ReadPixelsFloat32(x, y, width, height int, format, typ GLenum, pixels []float32) {
+	r.record("ReadPixelsFloat32", x, y, width, height, format, typ, output{"[]float32", len(pixels)})
+	if r.backend != nil {
+		r.backend.ReadPixelsFloat32(x, y, width, height, format, typ, pixels)
+	}
+}
+
+func (r *Recorder) RenderbufferStorage(target, internalFormat GLenum, width, height int) {
+	r.record("RenderbufferStorage", target, internalFormat, width, height)
+	if r.backend != nil {
+		r.backend.RenderbufferStorage(target, internalFormat, width, height)
+	}
+}
+
+func (r *Recorder) SampleCoverage(value float64, invert bool) {
+	r.record("SampleCoverage", value, invert)
+	if r.backend != nil {
+		r.backend.SampleCoverage(value, invert)
+	}
+}
+
+func (r *Recorder) Scissor(x, y, width, height int) {
+	r.record("Scissor", x, y, width, height)
+	if r.backend != nil {
+		r.backend.Scissor(x, y, width, height)
+	}
+}
+
+func (r *Recorder) ShaderSource(shader Shader, source string) {
+	r.record("ShaderSource", r.ref("shader", shader.object), source)
+	if r.backend != nil {
+		r.backend.ShaderSource(shader, source)
+		return
+	}
+	r.sources[shader.object] = source
+}
+
+func (r *Recorder) StencilFunc(fun GLenum, ref int, mask uint32) {
+	r.record("StencilFunc", fun, ref, bitmask(mask))
+	if r.backend != nil {
+		r.backend.StencilFunc(fun, ref, mask)
+	}
+}
+
+func (r *Recorder) StencilFuncSeparate(face, fun GLenum, ref int, mask uint32) {
+	r.record("StencilFuncSeparate", face, fun, ref, bitmask(mask))
+	if r.backend != nil {
+		r.backend.StencilFuncSeparate(face, fun, ref, mask)
+	}
+}
+
+func (r *Recorder) StencilMask(mask uint32) {
+	r.record("StencilMask", bitmask(mask))
+	if r.backend != nil {
+		r.backend.StencilMask(mask)
+	}
+}
+
+func (r *Recorder) StencilMaskSeparate(face GLenum, mask uint32) {
+	r.record("StencilMaskSeparate", face, bitmask(mask))
+	if r.backend != nil {
+		r.backend.StencilMaskSeparate(face, mask)
+	}
+}
+
+func (r *Recorder) StencilOp(fail, zfail, zpass GLenum) {
+	r.record("StencilOp", fail, zfail, zpass)
+	if r.backend != nil {
+		r.backend.StencilOp(fail, zfail, zpass)
+	}
+}
+
+func (r *Recorder) StencilOpSeparate(face, fail, zfail, zpass GLenum) {
+	r.record("StencilOpSeparate", face, fail, zfail, zpass)
+	if r.backend != nil {
+		r.backend.StencilOpSeparate(face, fail, zfail, zpass)
+	}
+}
+
+func (r *Recorder) TexImage2DBytes(target GLenum, level int, internalFormat GLenum, width, height, border int, format, typ GLenum, pixels []byte) {
+	r.record("TexImage2DBytes", target, level, internalFormat, width, height, border, format, typ, pixels)
+	if r.backend != nil {
+		r.backend.TexImage2DBytes(target, level, internalFormat, width, height, border, format, typ, pixels)
+	}
+}
+
+func (r *Recorder) TexImage2DUint16(target GLenum, level int, internalFormat GLenum, width, height, border int, format, typ GLenum, pixels []uint16) {
+	r.record("TexImage2DUint16", target, level, internalFormat, width, height, border, format, typ, pixels)
+	if r.backend != nil {
+		r.backend.TexImage2DUint16(target, level, internalFormat, width, height, border, format, typ, pixels)
+	}
+}
+
+func (r *Recorder) TexImage2DFloat32(target GLenum, level int, internalFormat GLenum, width, height, border int, format, typ GLenum, pixels []float32) {
+	r.record("TexImage2DFloat32", target, level, internalFormat, width, height, border, format, typ, pixels)
+	if r.backend != nil {
+		r.backend.TexImage2DFloat32(target, level, internalFormat, width, height, border, format, typ, pixels)
+	}
+}
+
+func (r *Recorder) TexParameteri(target, pname GLenum, param int) {
+	switch pname {
+	case TEXTURE_MAG_FILTER, TEXTURE_MIN_FILTER, TEXTURE_WRAP_S, TEXTURE_WRAP_T:
+		r.record("TexParameteri", target, pname, GLenum(param))
+	default:
+		r.record("TexParameteri", target, pname, param)
+	}
+	if r.backend != nil {
+		r.backend.TexParameteri(target, pname, param)
+	}
+}
+
+func (r *Recorder) TexSubImage2DBytes(target GLenum, level, xoffset, yoffset, width, height int, format, typ GLenum, pixels []byte) {
+	r.record("TexSubImage2DBytes", target, level, xoffset, yoffset, width, height, format, typ, pixels)
+	if r.backend != nil {
+		r.backend.TexSubImage2DBytes(target, level, xoffset, yoffset, width, height, format, typ, pixels)
+	}
+}
+
+func (r *Recorder) TexSubImage2DUint16(target GLenum, level, xoffset, yoffset, width, height int, format, typ GLenum, pixels []uint16) {
+	r.record("TexSubImage2DUint16", target, level, xoffset, yoffset, width, height, format, typ, pixels)
+	if r.backend != nil {
+		r.backend.TexSubImage2DUint16(target, level, xoffset, yoffset, width, height, format, typ, pixels)
+	}
+}
+
+func (r *Recorder) TexSubImage2DFloat32(target GLenum, level, xoffset, yoffset, width, height int, format, typ GLenum, pixels []float32) {
+	r.record("TexSubImage2DFloat32", target, level, xoffset, yoffset, width, height, format, typ, pixels)
+	if r.backend != nil {
+		r.backend.TexSubImage2DFloat32(target, level, xoffset, yoffset, width, height, format, typ, pixels)
+	}
+}
+
+func (r *Recorder) Uniform1f(location UniformLocation, x float32) {
+	r.record("Uniform1f", r.ref("location", location.object), x)
+	if r.backend != nil {
+		r.backend.Uniform1f(location, x)
+	}
+}
+
+func (r *Recorder) Uniform1i(location UniformLocation, x int) {
+	r.record("Uniform1i", r.ref("location", location.object), x)
+	if r.backend != nil {
+		r.backend.Uniform1i(location, x)
+	}
+}
+
+func (r *Recorder) Uniform2f(location UniformLocation, x, y float32) {
+	r.record("Uniform2f", r.ref("location", location.object), x, y)
+	if r.backend != nil {
+		r.backend.Uniform2f(location, x, y)
+	}
+}
+
+func (r *Recorder) Uniform2i(location UniformLocation, x, y int) {
+	r.record("Uniform2i", r.ref("location", location.object), x, y)
+	if r.backend != nil {
+		r.backend.Uniform2i(location, x, y)
+	}
+}
+
+func (r *Recorder) Uniform3f(location UniformLocation, x, y, z float32) {
+	r.record("Uniform3f", r.ref("location", location.object), x, y, z)
+	if r.backend != nil {
+		r.backend.Uniform3f(location, x, y, z)
+	}
+}
+
+func (r *Recorder) Uniform3i(location UniformLocation, x, y, z int) {
+	r.record("Uniform3i", r.ref("location", location.object), x, y, z)
+	if r.backend != nil {
+		r.backend.Uniform3i(location, x, y, z)
+	}
+}
+
+func (r *Recorder) Uniform4f(location UniformLocation, x, y, z, w float32) {
+	r.record("Uniform4f", r.ref("location", location.object), x, y, z, w)
+	if r.backend != nil {
+		r.backend.Uniform4f(location, x, y, z, w)
+	}
+}
+
+func (r *Recorder) Uniform4i(location UniformLocation, x, y, z, w int) {
+	r.record("Uniform4i", r.ref("location", location.object), x, y, z, w)
+	if r.backend != nil {
+		r.backend.Uniform4i(location, x, y, z, w)
+	}
+}
+
+func (r *Recorder) Uniform1fv(location UniformLocation, v []float32) {
+	r.record("Uniform1fv", r.ref("location", location.object), v)
+	if r.backend != nil {
+		r.backend.Uniform1fv(location, v)
+	}
+}
+
+func (r *Recorder) Uniform1iv(location UniformLocation, v []int32) {
+	r.record("Uniform1iv", r.ref("location", location.object), v)
+	if r.backend != nil {
+		r.backend.Uniform1iv(location, v)
+	}
+}
+
+func (r *Recorder) Uniform2fv(location UniformLocation, v []float32) {
+	r.record("Uniform2fv", r.ref("location", location.object), v)
+	if r.backend != nil {
+		r.backend.Uniform2fv(location, v)
+	}
+}
+
+func (r *Recorder) Uniform2iv(location UniformLocation, v []int32) {
+	r.record("Uniform2iv", r.ref("location", location.object), v)
+	if r.backend != nil {
+		r.backend.Uniform2iv(location, v)
+	}
+}
+
+func (r *Recorder) Uniform3fv(location UniformLocation, v []float32) {
+	r.record("Uniform3fv", r.ref("location", location.object), v)
+	if r.backend != nil {
+		r.backend.Uniform3fv(location, v)
+	}
+}
+
+func (r *Recorder) Uniform3iv(location UniformLocation, v []int32) {
+	r.record("Uniform3iv", r.ref("location", location.object), v)
+	if r.backend != nil {
+		r.backend.Uniform3iv(location, v)
+	}
+}
+
+func (r *Recorder) Uniform4fv(location UniformLocation, v []float32) {
+	r.record("Uniform4fv", r.ref("location", location.object), v)
+	if r.backend != nil {
+		r.backend.Uniform4fv(location, v)
+	}
+}
+
+func (r *Recorder) Uniform4iv(location UniformLocation, v []int32) {
+	r.record("Uniform4iv", r.ref("location", location.object), v)
+	if r.backend != nil {
+		r.backend.Uniform4iv(location, v)
+	}
+}
+
+func (r *Recorder) UniformMatrix2fv(location UniformLocation, transpose bool, value []float32) {
+	r.record("UniformMatrix2fv", r.ref("location", location.object), transpose, value)
+	if r.backend != nil {
+		r.backend.UniformMatrix2fv(location, transpose, value)
+	}
+}
+
+func (r *Recorder) UniformMatrix3fv(location UniformLocation, transpose bool, value []float32) {
+	r.record("UniformMatrix3fv", r.ref("location", location.object), transpose, value)
+	if r.backend != nil {
+		r.backend.UniformMatrix3fv(location, transpose, value)
+	}
+}
+
+func (r *Recorder) UniformMatrix4fv(location UniformLocation, transpose bool, value []float32) {
+	r.record("UniformMatrix4fv", r.ref("location", location.object), transpose, value)
+	if r.backend != nil {
+		r.backend.UniformMatrix4fv(location, transpose, value)
+	}
+}
+
+func (r *Recorder) UseProgram(program Program) {
+	r.record("UseProgram", r.ref("program", program.object))
+	if r.backend != nil {
+		r.backend.UseProgram(program)
+	}
+}
+
+func (r *Recorder) ValidateProgram(program Program) {
+	r.record("ValidateProgram", r.ref("program", program.object))
+	if r.backend != nil {
+		r.backend.ValidateProgram(program)
+	}
+}
+
+func (r *Recorder) VertexAttrib1f(index int, x float32) {
+	r.record("VertexAttrib1f", index, x)
+	if r.backend != nil {
+		r.backend.VertexAttrib1f(index, x)
+	}
+}
+
+func (r *Recorder) VertexAttrib2f(index int, x, y float32) {
+	r.record("VertexAttrib2f", index, x, y)
+	if r.backend != nil {
+		r.backend.VertexAttrib2f(index, x, y)
+	}
+}
+
+func (r *Recorder) VertexAttrib3f(index int, x, y, z float32) {
+	r.record("VertexAttrib3f", index, x, y, z)
+	if r.backend != nil {
+		r.backend.VertexAttrib3f(index, x, y, z)
+	}
+}
+
+func (r *Recorder) VertexAttrib4f(index int, x, y, z, w float32) {
+	r.record("VertexAttrib4f", index, x, y, z, w)
+	if r.backend != nil {
+		r.backend.VertexAttrib4f(index, x, y, z, w)
+	}
+}
+
+func (r *Recorder) VertexAttrib1fv(index int, v []float32) {
+	r.record("VertexAttrib1fv", index, v)
+	if r.backend != nil {
+		r.backend.VertexAttrib1fv(index, v)
+	}
+}
+
+func (r *Recorder) VertexAttrib2fv(index int, v []float32) {
+	r.record("VertexAttrib2fv", index, v)
+	if r.backend != nil {
+		r.backend.VertexAttrib2fv(index, v)
+	}
+}
+
+func (r *Recorder) VertexAttrib3fv(index int, v []float32) {
+	r.record("VertexAttrib3fv", index, v)
+	if r.backend != nil {
+		r.backend.VertexAttrib3fv(index, v)
+	}
+}
+
+func (r *Recorder) VertexAttrib4fv(index int, v []float32) {
+	r.record("VertexAttrib4fv", index, v)
+	if r.backend != nil {
+		r.backend.VertexAttrib4fv(index, v)
+	}
+}
+
+func (r *Recorder) VertexAttribPointer(index, size int, typ GLenum, normal bool, stride int, offset int) {
+	r.record("VertexAttribPointer", index, size, typ, normal, stride, offset)
+	if r.backend != nil {
+		r.backend.VertexAttribPointer(index, size, typ, normal, stride, offset)
+	}
+}
+
+func (r *Recorder) Viewport(x, y, width, height int) {
+	r.record("Viewport", x, y, width, height)
+	if r.backend != nil {
+		r.backend.Viewport(x, y, width, height)
+	}
+}
+
+var _ Backend = (*Recorder)(nil)
@@ -0,0 +1,128 @@
+// Copyright 2014 Joseph Hager. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package webgl
+
+import (
+	"flag"
+	"path/filepath"
+	"testing"
+
+	"github.com/gopherjs/webgl/webgltest"
+)
+
+var update = flag.Bool("update", false, "update the golden files in testdata")
+
+// drawTriangle is rendering code of the kind a Recorder is meant to trace.
+func drawTriangle(b Backend) {
+	vs := b.CreateShader(VERTEX_SHADER)
+	b.ShaderSource(vs, "attribute vec2 a_pos; void main() { gl_Position = vec4(a_pos, 0, 1); }")
+	b.CompileShader(vs)
+	fs := b.CreateShader(FRAGMENT_SHADER)
+	b.ShaderSource(fs, "void main() { gl_FragColor = vec4(1); }")
+	b.CompileShader(fs)
+	p := b.CreateProgram()
+	b.AttachShader(p, vs)
+	b.AttachShader(p, fs)
+	b.BindAttribLocation(p, 0, "a_pos")
+	b.LinkProgram(p)
+	if !b.GetProgramParameterb(p, LINK_STATUS) {
+		return
+	}
+	b.UseProgram(p)
+
+	buf := b.CreateBuffer()
+	b.BindBuffer(ARRAY_BUFFER, buf)
+	b.BufferDataFloat32(ARRAY_BUFFER, []float32{-1, -1, 1, -1, 0, 1}, STATIC_DRAW)
+	b.EnableVertexAttribArray(0)
+	b.VertexAttribPointer(0, 2, FLOAT, false, 0, 0)
+	b.ClearColor(0, 0, 0, 1)
+	b.Clear(COLOR_BUFFER_BIT)
+	b.DrawArrays(TRIANGLES, 0, 3)
+
+	b.DeleteBuffer(buf)
+	b.DeleteProgram(p)
+	b.DeleteShader(vs)
+	b.DeleteShader(fs)
+}
+
+// drawBlended exercises the arguments with their own trace formatting.
+func drawBlended(b Backend) {
+	b.Enable(BLEND)
+	b.BlendFuncSeparate(SRC_ALPHA, ONE_MINUS_SRC_ALPHA, ONE, ZERO)
+	b.StencilFunc(EQUAL, 1, 0xff)
+	b.BufferDataUint16(ELEMENT_ARRAY_BUFFER, make([]uint16, 32), STATIC_DRAW)
+	b.DrawElements(TRIANGLES, 32, UNSIGNED_SHORT, 0)
+	b.GetError()
+}
+
+func TestRecorder(t *testing.T) {
+	tests := []struct {
+		name string
+		draw func(b Backend)
+	}{
+		{"triangle", drawTriangle},
+		{"blended", drawBlended},
+	}
+	for _, test := range tests {
+		golden := filepath.Join("testdata", "record", test.name+".trace")
+
+		// A standalone Recorder answers the queries itself.
+		standalone := NewRecorder(nil)
+		test.draw(standalone)
+		if err := webgltest.CompareGolden(golden, standalone.Trace(), *update); err != nil {
+			t.Errorf("%s standalone: %v", test.name, err)
+		}
+
+		// A forwarding Recorder records the calls and results of its
+		// backend, which here records them too.
+		backend := NewRecorder(nil)
+		forwarding := NewRecorder(backend)
+		test.draw(forwarding)
+		if err := webgltest.CompareGolden(golden, forwarding.Trace(), false); err != nil {
+			t.Errorf("%s forwarding: %v", test.name, err)
+		}
+		if got, want := forwarding.Trace(), backend.Trace(); got != want {
+			t.Errorf("%s forwarding: trace differs from its backend:\n%s", test.name, webgltest.Diff(want, got))
+		}
+	}
+}
+
+func TestRecorderReset(t *testing.T) {
+	r := NewRecorder(nil)
+	r.CreateBuffer()
+	buf := r.CreateBuffer()
+	shader := r.CreateShader(VERTEX_SHADER)
+	r.ShaderSource(shader, "void main() {}")
+	deleted := r.CreateTexture()
+	r.DeleteTexture(deleted)
+	r.Reset()
+
+	if len(r.Calls()) != 0 {
+		t.Errorf("Calls after Reset = %v, want none", r.Calls())
+	}
+	if !r.IsBuffer(buf) {
+		t.Errorf("IsBuffer of a buffer created before Reset = false, want true")
+	}
+	if r.IsTexture(deleted) {
+		t.Errorf("IsTexture of a texture deleted before Reset = true, want false")
+	}
+	if got, want := r.GetShaderSource(shader), "void main() {}"; got != want {
+		t.Errorf("GetShaderSource of a shader created before Reset = %q, want %q", got, want)
+	}
+	r.DeleteBuffer(buf)
+	if r.IsBuffer(buf) {
+		t.Errorf("IsBuffer after DeleteBuffer = true, want false")
+	}
+
+	// Handles are numbered again from 1, in the order they are used.
+	want := "IsBuffer(buffer1) = true\n" +
+		"IsTexture(texture1) = false\n" +
+		"GetShaderSource(shader1) = \"void main() {}\"\n" +
+		"DeleteBuffer(buffer1)\n" +
+		"IsBuffer(buffer1) = false\n"
+	if got := r.Trace(); got != want {
+		t.Errorf("trace after Reset:\n%s", webgltest.Diff(want, got))
+	}
+}
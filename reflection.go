@@ -0,0 +1,180 @@
+// Copyright 2014 Joseph Hager. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package webgl
+
+import (
+	"fmt"
+	"strings"
+)
+
+// GLSLType is the type of an active attribute or uniform. Its values are
+// the GLenum values WebGL reports the types with.
+type GLSLType GLenum
+
+// The GLSL ES 1.00 types of attributes and uniforms.
+const (
+	GLSLFloat       = GLSLType(FLOAT)
+	GLSLVec2        = GLSLType(FLOAT_VEC2)
+	GLSLVec3        = GLSLType(FLOAT_VEC3)
+	GLSLVec4        = GLSLType(FLOAT_VEC4)
+	GLSLInt         = GLSLType(INT)
+	GLSLIVec2       = GLSLType(INT_VEC2)
+	GLSLIVec3       = GLSLType(INT_VEC3)
+	GLSLIVec4       = GLSLType(INT_VEC4)
+	GLSLBool        = GLSLType(BOOL)
+	GLSLBVec2       = GLSLType(BOOL_VEC2)
+	GLSLBVec3       = GLSLType(BOOL_VEC3)
+	GLSLBVec4       = GLSLType(BOOL_VEC4)
+	GLSLMat2        = GLSLType(FLOAT_MAT2)
+	GLSLMat3        = GLSLType(FLOAT_MAT3)
+	GLSLMat4        = GLSLType(FLOAT_MAT4)
+	GLSLSampler2D   = GLSLType(SAMPLER_2D)
+	GLSLSamplerCube = GLSLType(SAMPLER_CUBE)
+)
+
+var glslTypeNames = map[GLSLType]string{
+	GLSLFloat:       "float",
+	GLSLVec2:        "vec2",
+	GLSLVec3:        "vec3",
+	GLSLVec4:        "vec4",
+	GLSLInt:         "int",
+	GLSLIVec2:       "ivec2",
+	GLSLIVec3:       "ivec3",
+	GLSLIVec4:       "ivec4",
+	GLSLBool:        "bool",
+	GLSLBVec2:       "bvec2",
+	GLSLBVec3:       "bvec3",
+	GLSLBVec4:       "bvec4",
+	GLSLMat2:        "mat2",
+	GLSLMat3:        "mat3",
+	GLSLMat4:        "mat4",
+	GLSLSampler2D:   "sampler2D",
+	GLSLSamplerCube: "samplerCube",
+}
+
+// ParseGLSLType returns the type named name in GLSL ES 1.00, such as
+// "vec3" or "sampler2D".
+func ParseGLSLType(name string) (GLSLType, bool) {
+	for t, n := range glslTypeNames {
+		if n == name {
+			return t, true
+		}
+	}
+	return 0, false
+}
+
+// String returns the GLSL name of the type. Unknown types are formatted
+// as their GLenum.
+func (t GLSLType) String() string {
+	if name, ok := glslTypeNames[t]; ok {
+		return name
+	}
+	return GLenum(t).String()
+}
+
+// Components returns the number of scalars in a value of the type; a mat3
+// has 9. Samplers have 1, their texture unit.
+func (t GLSLType) Components() int {
+	switch t {
+	case GLSLVec2, GLSLIVec2, GLSLBVec2:
+		return 2
+	case GLSLVec3, GLSLIVec3, GLSLBVec3:
+		return 3
+	case GLSLVec4, GLSLIVec4, GLSLBVec4, GLSLMat2:
+		return 4
+	case GLSLMat3:
+		return 9
+	case GLSLMat4:
+		return 16
+	}
+	return 1
+}
+
+// IsSampler reports whether the type is a sampler.
+func (t GLSLType) IsSampler() bool {
+	return t == GLSLSampler2D || t == GLSLSamplerCube
+}
+
+// ActiveInfo describes an active attribute or uniform, as WebGLActiveInfo
+// does. Arrays are named after their first element, such as "u_lights[0]",
+// with Size their length.
+type ActiveInfo struct {
+	Name string
+	Size int
+	Type GLenum
+}
+
+// Attribute is an active attribute of a linked program.
+type Attribute struct {
+	Name     string
+	Type     GLSLType
+	Size     int
+	Location int
+}
+
+// Uniform is an active uniform of a linked program, or an element of an
+// active uniform array.
+type Uniform struct {
+	Name     string
+	Type     GLSLType
+	Size     int
+	Location UniformLocation
+}
+
+// ActiveAttributes returns the active attributes of program, which must be
+// linked, in the order WebGL reports them.
+func ActiveAttributes(b Backend, program Program) []Attribute {
+	n := b.GetProgramParameteri(program, ACTIVE_ATTRIBUTES)
+	attribs := make([]Attribute, 0, n)
+	for i := 0; i < n; i++ {
+		info := b.GetActiveAttribInfo(program, i)
+		if info.Name == "" {
+			continue
+		}
+		attribs = append(attribs, Attribute{
+			Name:     info.Name,
+			Type:     GLSLType(info.Type),
+			Size:     info.Size,
+			Location: b.GetAttribLocation(program, info.Name),
+		})
+	}
+	return attribs
+}
+
+// ActiveUniforms returns the active uniforms of program, which must be
+// linked, in the order WebGL reports them. Arrays are expanded into one
+// Uniform of Size 1 per element, named name[0] to name[n-1], so each gets
+// its own location.
+func ActiveUniforms(b Backend, program Program) []Uniform {
+	n := b.GetProgramParameteri(program, ACTIVE_UNIFORMS)
+	uniforms := make([]Uniform, 0, n)
+	for i := 0; i < n; i++ {
+		info := b.GetActiveUniformInfo(program, i)
+		if info.Name == "" {
+			continue
+		}
+		typ := GLSLType(info.Type)
+		if info.Size <= 1 {
+			uniforms = append(uniforms, Uniform{
+				Name:     info.Name,
+				Type:     typ,
+				Size:     1,
+				Location: b.GetUniformLocation(program, info.Name),
+			})
+			continue
+		}
+		base := strings.TrimSuffix(info.Name, "[0]")
+		for j := 0; j < info.Size; j++ {
+			name := fmt.Sprintf("%s[%d]", base, j)
+			uniforms = append(uniforms, Uniform{
+				Name:     name,
+				Type:     typ,
+				Size:     1,
+				Location: b.GetUniformLocation(program, name),
+			})
+		}
+	}
+	return uniforms
+}
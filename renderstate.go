@@ -0,0 +1,218 @@
+// Copyright 2014 Joseph Hager. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package webgl
+
+// RenderState is the fixed-function state that decides how fragments are
+// tested and written: blending, the depth and stencil tests, face culling,
+// the color mask and polygon offset. It is a value; a draw declares the
+// state it needs by passing one to SetRenderState instead of changing the
+// state it differs in, so no setting leaks from one draw into the next.
+//
+// RenderState values can be compared with ==. The zero value is not the
+// initial state of a context; start from DefaultRenderState.
+type RenderState struct {
+	Blend                                   bool
+	BlendColor                              [4]float64
+	BlendEquationRGB, BlendEquationAlpha    GLenum
+	BlendSrcRGB, BlendDstRGB                GLenum
+	BlendSrcAlpha, BlendDstAlpha            GLenum
+	DepthTest                               bool
+	DepthFunc                               GLenum
+	DepthMask                               bool
+	StencilTest                             bool
+	StencilFront, StencilBack               StencilState
+	CullFace                                bool
+	CullFaceMode                            GLenum
+	FrontFace                               GLenum
+	ColorMask                               [4]bool
+	PolygonOffsetFill                       bool
+	PolygonOffsetFactor, PolygonOffsetUnits float64
+}
+
+// DefaultRenderState returns the initial render state of a context.
+func DefaultRenderState() RenderState {
+	return RenderState{
+		BlendEquationRGB:   FUNC_ADD,
+		BlendEquationAlpha: FUNC_ADD,
+		BlendSrcRGB:        ONE,
+		BlendDstRGB:        ZERO,
+		BlendSrcAlpha:      ONE,
+		BlendDstAlpha:      ZERO,
+		DepthFunc:          LESS,
+		DepthMask:          true,
+		StencilFront:       DefaultStencilState(),
+		StencilBack:        DefaultStencilState(),
+		CullFaceMode:       BACK,
+		FrontFace:          CCW,
+		ColorMask:          [4]bool{true, true, true, true},
+	}
+}
+
+// Returns the render state of the context, as queried from it.
+func (c *Context) GetRenderState() RenderState {
+	// The parameter names below are all in parameterTypes with the type of
+	// the getter, so the getters cannot fail.
+	enabled := func(pname GLenum) bool {
+		b, _ := c.GetParameterBool(pname)
+		return b
+	}
+	enum := func(pname GLenum) GLenum {
+		e, _ := c.GetParameterEnum(pname)
+		return e
+	}
+	float := func(pname GLenum) float64 {
+		f, _ := c.GetParameterFloat(pname)
+		return float64(f)
+	}
+	s := RenderState{
+		Blend:               enabled(BLEND),
+		BlendEquationRGB:    enum(BLEND_EQUATION_RGB),
+		BlendEquationAlpha:  enum(BLEND_EQUATION_ALPHA),
+		BlendSrcRGB:         enum(BLEND_SRC_RGB),
+		BlendDstRGB:         enum(BLEND_DST_RGB),
+		BlendSrcAlpha:       enum(BLEND_SRC_ALPHA),
+		BlendDstAlpha:       enum(BLEND_DST_ALPHA),
+		DepthTest:           enabled(DEPTH_TEST),
+		DepthFunc:           enum(DEPTH_FUNC),
+		DepthMask:           enabled(DEPTH_WRITEMASK),
+		StencilTest:         enabled(STENCIL_TEST),
+		StencilFront:        c.GetStencilState(FRONT),
+		StencilBack:         c.GetStencilState(BACK),
+		CullFace:            enabled(CULL_FACE),
+		CullFaceMode:        enum(CULL_FACE_MODE),
+		FrontFace:           enum(FRONT_FACE),
+		PolygonOffsetFill:   enabled(POLYGON_OFFSET_FILL),
+		PolygonOffsetFactor: float(POLYGON_OFFSET_FACTOR),
+		PolygonOffsetUnits:  float(POLYGON_OFFSET_UNITS),
+	}
+	color, _ := c.GetParameterFloats(BLEND_COLOR)
+	for i := range s.BlendColor {
+		if i < len(color) {
+			s.BlendColor[i] = float64(color[i])
+		}
+	}
+	mask, _ := c.GetParameterBools(COLOR_WRITEMASK)
+	copy(s.ColorMask[:], mask)
+	return s
+}
+
+// Sets the render state of the context to s. Only the state that differs
+// from the one last set is changed; the first call queries the state of
+// the context to compare with. If the render state is changed by other
+// calls, call InvalidateRenderState before setting it again.
+func (c *Context) SetRenderState(s RenderState) {
+	if c.renderState == nil {
+		current := c.GetRenderState()
+		c.renderState = &current
+	}
+	ApplyRenderState(c, *c.renderState, s)
+	*c.renderState = s
+}
+
+// Makes the next SetRenderState query the render state of the context
+// instead of relying on the one it last set.
+func (c *Context) InvalidateRenderState() {
+	c.renderState = nil
+}
+
+// ApplyRenderState changes the render state of b from from to to, making
+// only the calls needed for the state that differs.
+func ApplyRenderState(b Backend, from, to RenderState) {
+	enable := func(capability GLenum, was, is bool) {
+		switch {
+		case is && !was:
+			b.Enable(capability)
+		case was && !is:
+			b.Disable(capability)
+		}
+	}
+
+	enable(BLEND, from.Blend, to.Blend)
+	if from.BlendColor != to.BlendColor {
+		b.BlendColor(to.BlendColor[0], to.BlendColor[1], to.BlendColor[2], to.BlendColor[3])
+	}
+	if from.BlendEquationRGB != to.BlendEquationRGB || from.BlendEquationAlpha != to.BlendEquationAlpha {
+		b.BlendEquationSeparate(to.BlendEquationRGB, to.BlendEquationAlpha)
+	}
+	if from.BlendSrcRGB != to.BlendSrcRGB || from.BlendDstRGB != to.BlendDstRGB ||
+		from.BlendSrcAlpha != to.BlendSrcAlpha || from.BlendDstAlpha != to.BlendDstAlpha {
+		b.BlendFuncSeparate(to.BlendSrcRGB, to.BlendDstRGB, to.BlendSrcAlpha, to.BlendDstAlpha)
+	}
+
+	enable(DEPTH_TEST, from.DepthTest, to.DepthTest)
+	if from.DepthFunc != to.DepthFunc {
+		b.DepthFunc(to.DepthFunc)
+	}
+	if from.DepthMask != to.DepthMask {
+		b.DepthMask(to.DepthMask)
+	}
+
+	enable(STENCIL_TEST, from.StencilTest, to.StencilTest)
+	for _, g := range stencilGroups {
+		front, back := g.part(to.StencilFront), g.part(to.StencilBack)
+		setFront := g.part(from.StencilFront) != front
+		setBack := g.part(from.StencilBack) != back
+		switch {
+		case setFront && setBack && front == back:
+			g.set(b, FRONT_AND_BACK, front)
+		case setFront:
+			g.set(b, FRONT, front)
+			if setBack {
+				g.set(b, BACK, back)
+			}
+		case setBack:
+			g.set(b, BACK, back)
+		}
+	}
+
+	enable(CULL_FACE, from.CullFace, to.CullFace)
+	if from.CullFaceMode != to.CullFaceMode {
+		b.CullFace(to.CullFaceMode)
+	}
+	if from.FrontFace != to.FrontFace {
+		b.FrontFace(to.FrontFace)
+	}
+
+	if from.ColorMask != to.ColorMask {
+		b.ColorMask(to.ColorMask[0], to.ColorMask[1], to.ColorMask[2], to.ColorMask[3])
+	}
+
+	enable(POLYGON_OFFSET_FILL, from.PolygonOffsetFill, to.PolygonOffsetFill)
+	if from.PolygonOffsetFactor != to.PolygonOffsetFactor || from.PolygonOffsetUnits != to.PolygonOffsetUnits {
+		b.PolygonOffset(to.PolygonOffsetFactor, to.PolygonOffsetUnits)
+	}
+}
+
+// stencilGroups are the parts of a StencilState set by one call each:
+// part keeps only the fields of a part, and set sets them for a face.
+var stencilGroups = [...]struct {
+	part func(s StencilState) StencilState
+	set  func(b Backend, face GLenum, s StencilState)
+}{
+	{
+		func(s StencilState) StencilState {
+			return StencilState{Func: s.Func, Ref: s.Ref, ValueMask: s.ValueMask}
+		},
+		func(b Backend, face GLenum, s StencilState) {
+			b.StencilFuncSeparate(face, s.Func, s.Ref, s.ValueMask)
+		},
+	},
+	{
+		func(s StencilState) StencilState {
+			return StencilState{WriteMask: s.WriteMask}
+		},
+		func(b Backend, face GLenum, s StencilState) {
+			b.StencilMaskSeparate(face, s.WriteMask)
+		},
+	},
+	{
+		func(s StencilState) StencilState {
+			return StencilState{Fail: s.Fail, ZFail: s.ZFail, ZPass: s.ZPass}
+		},
+		func(b Backend, face GLenum, s StencilState) {
+			b.StencilOpSeparate(face, s.Fail, s.ZFail, s.ZPass)
+		},
+	},
+}
@@ -0,0 +1,158 @@
+// Copyright 2014 Joseph Hager. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package webgl
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestApplyRenderState(t *testing.T) {
+	tests := []struct {
+		name     string
+		from, to func(s *RenderState) // changes to DefaultRenderState
+		trace    []string
+	}{
+		{
+			name:  "identical",
+			from:  func(s *RenderState) { s.Blend = true; s.StencilFront.Ref = 1 },
+			to:    func(s *RenderState) { s.Blend = true; s.StencilFront.Ref = 1 },
+			trace: nil,
+		},
+		{
+			name:  "enable",
+			to:    func(s *RenderState) { s.DepthTest = true },
+			trace: []string{"Enable(DEPTH_TEST)"},
+		},
+		{
+			name:  "disable",
+			from:  func(s *RenderState) { s.CullFace = true },
+			trace: []string{"Disable(CULL_FACE)"},
+		},
+		{
+			name:  "blend color",
+			to:    func(s *RenderState) { s.BlendColor = [4]float64{1, 0, 0, 0.5} },
+			trace: []string{"BlendColor(1, 0, 0, 0.5)"},
+		},
+		{
+			name:  "blend equation",
+			to:    func(s *RenderState) { s.BlendEquationAlpha = FUNC_SUBTRACT },
+			trace: []string{"BlendEquationSeparate(FUNC_ADD, FUNC_SUBTRACT)"},
+		},
+		{
+			name:  "blend func",
+			to:    func(s *RenderState) { s.BlendDstRGB = ONE_MINUS_SRC_ALPHA },
+			trace: []string{"BlendFuncSeparate(ONE, ONE_MINUS_SRC_ALPHA, ONE, ZERO)"},
+		},
+		{
+			name:  "depth func",
+			to:    func(s *RenderState) { s.DepthFunc = LEQUAL },
+			trace: []string{"DepthFunc(LEQUAL)"},
+		},
+		{
+			name:  "depth mask",
+			to:    func(s *RenderState) { s.DepthMask = false },
+			trace: []string{"DepthMask(false)"},
+		},
+		{
+			name:  "cull face mode",
+			to:    func(s *RenderState) { s.CullFaceMode = FRONT },
+			trace: []string{"CullFace(FRONT)"},
+		},
+		{
+			name:  "front face",
+			to:    func(s *RenderState) { s.FrontFace = CW },
+			trace: []string{"FrontFace(CW)"},
+		},
+		{
+			name:  "color mask",
+			to:    func(s *RenderState) { s.ColorMask[3] = false },
+			trace: []string{"ColorMask(true, true, true, false)"},
+		},
+		{
+			name:  "polygon offset",
+			to:    func(s *RenderState) { s.PolygonOffsetFill = true; s.PolygonOffsetUnits = 2 },
+			trace: []string{"Enable(POLYGON_OFFSET_FILL)", "PolygonOffset(0, 2)"},
+		},
+		{
+			name: "stencil both faces",
+			to: func(s *RenderState) {
+				s.StencilTest = true
+				s.StencilFront.Func, s.StencilBack.Func = EQUAL, EQUAL
+				s.StencilFront.ZPass, s.StencilBack.ZPass = REPLACE, REPLACE
+			},
+			trace: []string{
+				"Enable(STENCIL_TEST)",
+				"StencilFuncSeparate(FRONT_AND_BACK, EQUAL, 0, 0xFFFFFFFF)",
+				"StencilOpSeparate(FRONT_AND_BACK, KEEP, KEEP, REPLACE)",
+			},
+		},
+		{
+			name: "stencil front",
+			to:   func(s *RenderState) { s.StencilFront.WriteMask = 0xff },
+			trace: []string{
+				"StencilMaskSeparate(FRONT, 0xFF)",
+			},
+		},
+		{
+			name: "stencil back",
+			to:   func(s *RenderState) { s.StencilBack.Fail = INVERT },
+			trace: []string{
+				"StencilOpSeparate(BACK, INVERT, KEEP, KEEP)",
+			},
+		},
+		{
+			name: "stencil faces differ",
+			to: func(s *RenderState) {
+				s.StencilFront.Ref = 1
+				s.StencilBack.Ref = 2
+			},
+			trace: []string{
+				"StencilFuncSeparate(FRONT, ALWAYS, 1, 0xFFFFFFFF)",
+				"StencilFuncSeparate(BACK, ALWAYS, 2, 0xFFFFFFFF)",
+			},
+		},
+		{
+			name: "stencil back catches up",
+			from: func(s *RenderState) { s.StencilFront.WriteMask = 0 },
+			to: func(s *RenderState) {
+				s.StencilFront.WriteMask = 0
+				s.StencilBack.WriteMask = 0
+			},
+			trace: []string{
+				"StencilMaskSeparate(BACK, 0x0)",
+			},
+		},
+		{
+			name: "stencil faces converge",
+			from: func(s *RenderState) { s.StencilFront.ValueMask = 1 },
+			to: func(s *RenderState) {
+				s.StencilFront.ValueMask = 3
+				s.StencilBack.ValueMask = 3
+			},
+			trace: []string{
+				"StencilFuncSeparate(FRONT_AND_BACK, ALWAYS, 0, 0x3)",
+			},
+		},
+	}
+	for _, test := range tests {
+		from, to := DefaultRenderState(), DefaultRenderState()
+		if test.from != nil {
+			test.from(&from)
+		}
+		if test.to != nil {
+			test.to(&to)
+		}
+		r := NewRecorder(nil)
+		ApplyRenderState(r, from, to)
+		want := ""
+		if test.trace != nil {
+			want = strings.Join(test.trace, "\n") + "\n"
+		}
+		if got := r.Trace(); got != want {
+			t.Errorf("%s: trace =\n%swant\n%s", test.name, got, want)
+		}
+	}
+}
@@ -0,0 +1,903 @@
+// Copyright 2014 Joseph Hager. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package webgl
+
+import (
+	"encoding/binary"
+	"math"
+)
+
+// contextHandlers holds the functions registered with OnContextLost and
+// OnContextRestored.
+type contextHandlers struct {
+	lost     []func()
+	restored []func()
+}
+
+// Registers f to be called when the browser loses the context, which
+// mobile browsers do to reclaim GPU memory. The default action of the
+// webglcontextlost event is prevented, so the browser will try to restore
+// the context later. While the context is lost every call is a no-op and
+// the Create* methods return zero handles.
+func (c *Context) OnContextLost(f func()) {
+	c.listen()
+	c.handlers.lost = append(c.handlers.lost, f)
+}
+
+// Registers f to be called when the browser restores a lost context, at
+// which point all WebGL state has been reset to its defaults. If
+// TrackResources was called, the tracked resources have been re-created
+// by the time f runs; everything else, such as framebuffers,
+// renderbuffers, enabled capabilities and vertex attribute pointers, is
+// up to f.
+func (c *Context) OnContextRestored(f func()) {
+	c.listen()
+	c.handlers.restored = append(c.handlers.restored, f)
+}
+
+// Starts remembering how every buffer, texture, shader and program is
+// created and filled, so they can be re-created when a lost context is
+// restored. Existing handles stay valid: after a restore they refer to
+// the new WebGL objects. Call it right after NewContext, as resources
+// created before it are not tracked.
+//
+// The contents of each buffer are copied and kept as one copy, which
+// BufferSubData writes into, for as long as the buffer lives. Texture
+// images uploaded from Go slices are kept likewise, one copy per level,
+// which TexSubImage2D from Go slices writes into. Images and other
+// JavaScript values are kept by reference and uploaded again; a
+// sub-image upload replaces the earlier ones it covers. Textures filled
+// with CopyTexImage2D or by rendering get their storage back but not
+// their contents. Of the uniform locations of a program, only the one
+// most recently returned for each name is looked up again. WebGL 2.0
+// objects are not tracked.
+func (c *Context) TrackResources() {
+	c.listen()
+	if c.resources == nil {
+		c.resources = newResources()
+	}
+}
+
+// contextLost runs the OnContextLost handlers.
+func (c *Context) contextLost() {
+	for _, f := range c.handlers.lost {
+		f()
+	}
+}
+
+// contextRestored re-creates the tracked resources and runs the
+// OnContextRestored handlers.
+func (c *Context) contextRestored() {
+	c.renderState = nil
+	c.vertexArrayOES = nil
+	c.instancedArrays = nil
+	if c.vertexArrays != nil {
+		c.vertexArrays.reset()
+	}
+	if c.resources != nil {
+		c.resources.restore(c)
+	}
+	for _, f := range c.handlers.restored {
+		f()
+	}
+}
+
+// unpackDefaults are the initial values of the PixelStorei parameters
+// that affect texture uploads.
+var unpackDefaults = map[GLenum]int{
+	UNPACK_ALIGNMENT:                   4,
+	UNPACK_FLIP_Y_WEBGL:                0,
+	UNPACK_PREMULTIPLY_ALPHA_WEBGL:     0,
+	UNPACK_COLORSPACE_CONVERSION_WEBGL: int(BROWSER_DEFAULT_WEBGL),
+}
+
+// stepOp is the kind of call a resourceStep replays.
+type stepOp int
+
+const (
+	stepData      stepOp = iota // TexImage2D* or CopyTexImage2D
+	stepSubData                 // TexSubImage2D*
+	stepParameter               // TexParameteri
+	stepMipmap                  // GenerateMipmap
+)
+
+// resourceStep is a call that defines part of the contents of a texture.
+// Steps remember the image they apply to and the unpack parameters that
+// were set when they were made.
+//
+// Uploads of Go slices keep their pixels, converted to bytes, and are
+// replayed by upload rather than run. Data steps whose pixels are tightly
+// packed, with UNPACK_ALIGNMENT 1, are the copy of their image that later
+// sub-image uploads are written into.
+type resourceStep struct {
+	op     stepOp
+	target GLenum
+	level  int
+	pname  GLenum
+	unpack map[GLenum]int
+	run    func()
+
+	// x, y, width and height locate the pixels a step uploads. width and
+	// height are 0 if the size of the image is not known.
+	x, y, width, height int
+
+	internalFormat, format, typ GLenum
+
+	pixels   []byte
+	elemSize int  // 1, 2 or 4 for []byte, []uint16 or []float32
+	packed   bool // pixels is the tightly packed copy of the image
+}
+
+// covers reports whether the pixels of s include those of t.
+func (s *resourceStep) covers(t *resourceStep) bool {
+	return s.width > 0 && t.width > 0 &&
+		s.x <= t.x && t.x+t.width <= s.x+s.width &&
+		s.y <= t.y && t.y+t.height <= s.y+s.height
+}
+
+// overlaps reports whether the pixels of s and t may intersect.
+func (s *resourceStep) overlaps(t *resourceStep) bool {
+	if s.width == 0 || t.width == 0 {
+		return true
+	}
+	return s.x < t.x+t.width && t.x < s.x+s.width &&
+		s.y < t.y+t.height && t.y < s.y+s.height
+}
+
+// resource is a tracked texture. Its steps are replayed in order with the
+// texture bound to target.
+type resource struct {
+	obj    *object
+	target GLenum
+	steps  []resourceStep
+}
+
+// bufferResource is a tracked buffer. Its contents are kept as one copy,
+// which sub-data uploads are written into, so that the registry holds no
+// more than the size of the buffer however often it is updated.
+type bufferResource struct {
+	obj    *object
+	target GLenum
+	data   []byte
+	usage  GLenum
+	sized  bool // BufferData was called
+}
+
+// drop removes the steps for which f returns true.
+func (res *resource) drop(f func(s *resourceStep) bool) {
+	steps := res.steps[:0]
+	for i := range res.steps {
+		if !f(&res.steps[i]) {
+			steps = append(steps, res.steps[i])
+		}
+	}
+	for i := len(steps); i < len(res.steps); i++ {
+		res.steps[i] = resourceStep{}
+	}
+	res.steps = steps
+}
+
+// shaderResource is a tracked shader. A deleted shader is kept while a
+// program still refers to it, since the program is linked with it again
+// on restore.
+type shaderResource struct {
+	obj      *object
+	typ      GLenum
+	source   string
+	compiled string
+	compile  bool
+	deleted  bool
+}
+
+// programResource is a tracked program. The shaders and attribute
+// bindings it was last linked with are kept apart from the current ones,
+// as shaders are commonly detached and deleted after linking.
+type programResource struct {
+	obj           *object
+	attached      []*shaderResource
+	attribs       map[string]int
+	linked        bool
+	linkedShaders []*shaderResource
+	linkedAttribs map[string]int
+	locations     map[string]*object
+}
+
+// textureBinding is a texture target of a texture unit.
+type textureBinding struct {
+	unit, target GLenum
+}
+
+// resources is the registry kept by TrackResources. Besides the resources
+// themselves it follows the bindings and unpack parameters that uploads
+// depend on.
+type resources struct {
+	buffers  map[*object]*bufferResource
+	textures map[*object]*resource
+	shaders  map[*object]*shaderResource
+	programs map[*object]*programResource
+
+	buffersBound  map[GLenum]*object
+	texturesBound map[textureBinding]*object
+	unit          GLenum
+	unpack        map[GLenum]int
+}
+
+func newResources() *resources {
+	r := &resources{
+		buffers:  make(map[*object]*bufferResource),
+		textures: make(map[*object]*resource),
+		shaders:  make(map[*object]*shaderResource),
+		programs: make(map[*object]*programResource),
+	}
+	r.reset()
+	return r
+}
+
+// reset forgets the bindings and unpack parameters, which a restored
+// context starts without.
+func (r *resources) reset() {
+	r.buffersBound = make(map[GLenum]*object)
+	r.texturesBound = make(map[textureBinding]*object)
+	r.unit = TEXTURE0
+	r.unpack = make(map[GLenum]int)
+}
+
+func (r *resources) createBuffer(obj *object) {
+	if obj != nil {
+		r.buffers[obj] = &bufferResource{obj: obj}
+	}
+}
+
+func (r *resources) createTexture(obj *object) {
+	if obj != nil {
+		r.textures[obj] = &resource{obj: obj}
+	}
+}
+
+func (r *resources) createShader(obj *object, typ GLenum) {
+	if obj != nil {
+		r.shaders[obj] = &shaderResource{obj: obj, typ: typ}
+	}
+}
+
+func (r *resources) createProgram(obj *object) {
+	if obj != nil {
+		r.programs[obj] = &programResource{
+			obj:       obj,
+			attribs:   make(map[string]int),
+			locations: make(map[string]*object),
+		}
+	}
+}
+
+func (r *resources) deleteBuffer(obj *object) {
+	delete(r.buffers, obj)
+	for target, bound := range r.buffersBound {
+		if bound == obj {
+			delete(r.buffersBound, target)
+		}
+	}
+}
+
+func (r *resources) deleteTexture(obj *object) {
+	delete(r.textures, obj)
+	for binding, bound := range r.texturesBound {
+		if bound == obj {
+			delete(r.texturesBound, binding)
+		}
+	}
+}
+
+func (r *resources) deleteShader(obj *object) {
+	if s := r.shaders[obj]; s != nil {
+		s.deleted = true
+		r.collect()
+	}
+}
+
+func (r *resources) deleteProgram(obj *object) {
+	delete(r.programs, obj)
+	r.collect()
+}
+
+// collect forgets the deleted shaders no program refers to.
+func (r *resources) collect() {
+	used := make(map[*shaderResource]bool)
+	for _, p := range r.programs {
+		for _, s := range p.attached {
+			used[s] = true
+		}
+		for _, s := range p.linkedShaders {
+			used[s] = true
+		}
+	}
+	for obj, s := range r.shaders {
+		if s.deleted && !used[s] {
+			delete(r.shaders, obj)
+		}
+	}
+}
+
+func (r *resources) bindBuffer(target GLenum, obj *object) {
+	if obj == nil {
+		delete(r.buffersBound, target)
+		return
+	}
+	r.buffersBound[target] = obj
+	if res := r.buffers[obj]; res != nil && res.target == 0 {
+		res.target = target
+	}
+}
+
+func (r *resources) activeTexture(unit GLenum) {
+	r.unit = unit
+}
+
+func (r *resources) bindTexture(target GLenum, obj *object) {
+	binding := textureBinding{r.unit, target}
+	if obj == nil {
+		delete(r.texturesBound, binding)
+		return
+	}
+	r.texturesBound[binding] = obj
+	if res := r.textures[obj]; res != nil && res.target == 0 {
+		res.target = target
+	}
+}
+
+func (r *resources) pixelStore(pname GLenum, param int) {
+	def, ok := unpackDefaults[pname]
+	switch {
+	case !ok:
+	case param == def:
+		delete(r.unpack, pname)
+	default:
+		r.unpack[pname] = param
+	}
+}
+
+// unpackState returns a copy of the unpack parameters that differ from
+// their defaults, or nil if none do.
+func (r *resources) unpackState() map[GLenum]int {
+	if len(r.unpack) == 0 {
+		return nil
+	}
+	unpack := make(map[GLenum]int, len(r.unpack))
+	for pname, param := range r.unpack {
+		unpack[pname] = param
+	}
+	return unpack
+}
+
+// buffer returns the tracked buffer bound to target, or nil.
+func (r *resources) buffer(target GLenum) *bufferResource {
+	return r.buffers[r.buffersBound[target]]
+}
+
+// texture returns the tracked texture bound to target, which may be a cube
+// map face, in the active texture unit, or nil.
+func (r *resources) texture(target GLenum) *resource {
+	if target >= TEXTURE_CUBE_MAP_POSITIVE_X && target <= TEXTURE_CUBE_MAP_NEGATIVE_Z {
+		target = TEXTURE_CUBE_MAP
+	}
+	return r.textures[r.texturesBound[textureBinding{r.unit, target}]]
+}
+
+// bufferData records data, which the caller must not change afterwards,
+// as the contents of the buffer bound to target.
+func (r *resources) bufferData(target GLenum, data []byte, usage GLenum) {
+	if res := r.buffer(target); res != nil {
+		res.data, res.usage, res.sized = data, usage, true
+	}
+}
+
+// bufferRange returns the n bytes at offset of the copy of the buffer
+// bound to target, for a sub-data upload to be written into, or nil if
+// the buffer is not tracked. Uploads outside the buffer fail in WebGL, so
+// they are not kept either.
+func (r *resources) bufferRange(target GLenum, offset, n int) []byte {
+	res := r.buffer(target)
+	if res == nil || offset < 0 || offset+n > len(res.data) {
+		return nil
+	}
+	return res.data[offset : offset+n]
+}
+
+// texImagePixels records the upload of pixels, a []byte, []uint16 or
+// []float32 that may be empty, as the image at level of target. The
+// pixels are copied, tightly packed if their format and type are known.
+func (r *resources) texImagePixels(target GLenum, level int, internalFormat GLenum, width, height int, format, typ GLenum, pixels interface{}) {
+	res := r.texture(target)
+	if res == nil {
+		return
+	}
+	s := resourceStep{
+		op: stepData, target: target, level: level, unpack: r.unpackState(),
+		width: width, height: height, internalFormat: internalFormat, format: format, typ: typ,
+	}
+	s.setPixels(pixels, r.alignment(), false)
+	r.texImageStep(res, s)
+}
+
+// texImage records run as the call that defines the image at level of
+// target, replacing earlier uploads to that image.
+func (r *resources) texImage(target GLenum, level int, run func()) {
+	if res := r.texture(target); res != nil {
+		r.texImageStep(res, resourceStep{op: stepData, target: target, level: level, unpack: r.unpackState(), run: run})
+	}
+}
+
+func (r *resources) texImageStep(res *resource, s resourceStep) {
+	res.drop(func(t *resourceStep) bool {
+		return (t.op == stepData || t.op == stepSubData) && t.target == s.target && t.level == s.level
+	})
+	res.steps = append(res.steps, s)
+}
+
+// texSubImage records run as a call that replaces the width by height
+// pixels at x, y of the image at level of target. width and height are 0
+// if they are not known.
+func (r *resources) texSubImage(target GLenum, level, x, y, width, height int, run func()) {
+	if res := r.texture(target); res != nil {
+		res.subImage(resourceStep{
+			op: stepSubData, target: target, level: level, unpack: r.unpackState(),
+			x: x, y: y, width: width, height: height, run: run,
+		})
+	}
+}
+
+// texSubImagePixels records the upload of pixels, a []byte, []uint16 or
+// []float32, to the width by height pixels at x, y of the image at level
+// of target. They are written into the copy of the image if there is one
+// and nothing uploaded after it is in the way; otherwise they are copied.
+func (r *resources) texSubImagePixels(target GLenum, level, x, y, width, height int, format, typ GLenum, pixels interface{}) {
+	res := r.texture(target)
+	if res == nil {
+		return
+	}
+	s := resourceStep{
+		op: stepSubData, target: target, level: level, unpack: r.unpackState(),
+		x: x, y: y, width: width, height: height, format: format, typ: typ,
+	}
+	if res.writeSubImage(&s, pixels, r.alignment()) {
+		return
+	}
+	s.setPixels(pixels, r.alignment(), true)
+	res.subImage(s)
+}
+
+// alignment returns the current UNPACK_ALIGNMENT.
+func (r *resources) alignment() int {
+	if a, ok := r.unpack[UNPACK_ALIGNMENT]; ok {
+		return a
+	}
+	return unpackDefaults[UNPACK_ALIGNMENT]
+}
+
+// subImage appends the sub-image step s, dropping the earlier sub-image
+// steps it covers.
+func (res *resource) subImage(s resourceStep) {
+	res.drop(func(t *resourceStep) bool {
+		return t.op == stepSubData && t.target == s.target && t.level == s.level && s.covers(t)
+	})
+	res.steps = append(res.steps, s)
+}
+
+// writeSubImage writes pixels, the upload described by s, into the copy
+// of the image they belong to. It reports false, writing nothing, if
+// there is no such copy, if pixels cannot be written into it as they
+// would be uploaded, or if a later step in the way of s would be replayed
+// after it. The later steps s covers are dropped.
+func (res *resource) writeSubImage(s *resourceStep, pixels interface{}, alignment int) bool {
+	var image *resourceStep
+	for i := range res.steps {
+		if t := &res.steps[i]; t.op == stepData && t.target == s.target && t.level == s.level && t.run == nil {
+			image = t
+		}
+	}
+	size := pixelSize(s.format, s.typ)
+	if image == nil || size == 0 || image.format != s.format || image.typ != s.typ ||
+		image.pixels != nil && !image.packed ||
+		s.unpack[UNPACK_PREMULTIPLY_ALPHA_WEBGL] != image.unpack[UNPACK_PREMULTIPLY_ALPHA_WEBGL] ||
+		s.width < 1 || s.height < 1 || s.x < 0 || s.y < 0 ||
+		s.x+s.width > image.width || s.y+s.height > image.height {
+		return false
+	}
+	n, elemSize := pixelsLen(pixels)
+	rowLen := s.width * size
+	stride := alignUp(rowLen, alignment)
+	if elemSize != elemSizeOf(s.typ) || n < (s.height-1)*stride+rowLen {
+		return false
+	}
+	after := false
+	for i := range res.steps {
+		t := &res.steps[i]
+		if t == image {
+			after = true
+		} else if after && t.target == s.target && t.level == s.level && s.overlaps(t) && !s.covers(t) {
+			return false
+		}
+	}
+	res.drop(func(t *resourceStep) bool {
+		return t.op == stepSubData && t.target == s.target && t.level == s.level && s.covers(t)
+	})
+	image = nil
+	for i := range res.steps {
+		if t := &res.steps[i]; t.op == stepData && t.target == s.target && t.level == s.level && t.run == nil {
+			image = t
+		}
+	}
+	if image.pixels == nil {
+		image.pixels = make([]byte, image.width*image.height*size)
+		image.elemSize, image.packed = elemSizeOf(image.typ), true
+		image.unpack = withAlignment(image.unpack, 1)
+	}
+
+	// A flipped upload puts its first row at the top of the image, and
+	// the copy is kept in the order of the upload that made it.
+	flipImage := image.unpack[UNPACK_FLIP_Y_WEBGL] != 0
+	flip := s.unpack[UNPACK_FLIP_Y_WEBGL] != 0
+	for j := 0; j < s.height; j++ {
+		y := s.y + j
+		if flip {
+			y = s.y + s.height - 1 - j
+		}
+		if flipImage {
+			y = image.height - 1 - y
+		}
+		offset := (y*image.width + s.x) * size
+		putPixels(image.pixels[offset:offset+rowLen], pixels, j*stride)
+	}
+	return true
+}
+
+// setPixels sets the pixels of s to a copy of pixels, uploaded with the
+// given alignment. The pixels of data steps of known format and type are
+// tightly packed, unless sub is true, so that sub-images can be written
+// into them.
+func (s *resourceStep) setPixels(pixels interface{}, alignment int, sub bool) {
+	n, elemSize := pixelsLen(pixels)
+	s.elemSize = elemSize
+	if n == 0 {
+		return
+	}
+	size := pixelSize(s.format, s.typ)
+	rowLen := s.width * size
+	stride := alignUp(rowLen, alignment)
+	if sub || size == 0 || elemSize != elemSizeOf(s.typ) || s.height < 1 || n < (s.height-1)*stride+rowLen {
+		s.pixels = make([]byte, n)
+		putPixels(s.pixels, pixels, 0)
+		return
+	}
+	s.pixels = make([]byte, s.height*rowLen)
+	for j := 0; j < s.height; j++ {
+		putPixels(s.pixels[j*rowLen:(j+1)*rowLen], pixels, j*stride)
+	}
+	s.packed = true
+	s.unpack = withAlignment(s.unpack, 1)
+}
+
+// upload makes the call that a step made from a Go slice replays.
+func (s *resourceStep) upload(c *Context) {
+	switch s.elemSize {
+	case 2:
+		pixels := uint16s(s.pixels)
+		if s.op == stepData {
+			c.TexImage2DUint16(s.target, s.level, s.internalFormat, s.width, s.height, 0, s.format, s.typ, pixels)
+		} else {
+			c.TexSubImage2DUint16(s.target, s.level, s.x, s.y, s.width, s.height, s.format, s.typ, pixels)
+		}
+	case 4:
+		pixels := float32s(s.pixels)
+		if s.op == stepData {
+			c.TexImage2DFloat32(s.target, s.level, s.internalFormat, s.width, s.height, 0, s.format, s.typ, pixels)
+		} else {
+			c.TexSubImage2DFloat32(s.target, s.level, s.x, s.y, s.width, s.height, s.format, s.typ, pixels)
+		}
+	default:
+		if s.op == stepData {
+			c.TexImage2DBytes(s.target, s.level, s.internalFormat, s.width, s.height, 0, s.format, s.typ, s.pixels)
+		} else {
+			c.TexSubImage2DBytes(s.target, s.level, s.x, s.y, s.width, s.height, s.format, s.typ, s.pixels)
+		}
+	}
+}
+
+// withAlignment returns unpack, allocated if nil, with UNPACK_ALIGNMENT
+// set to alignment.
+func withAlignment(unpack map[GLenum]int, alignment int) map[GLenum]int {
+	if unpack == nil {
+		unpack = make(map[GLenum]int)
+	}
+	unpack[UNPACK_ALIGNMENT] = alignment
+	return unpack
+}
+
+func (r *resources) texParameter(target, pname GLenum, run func()) {
+	res := r.texture(target)
+	if res == nil {
+		return
+	}
+	res.drop(func(s *resourceStep) bool {
+		return s.op == stepParameter && s.pname == pname
+	})
+	res.steps = append(res.steps, resourceStep{op: stepParameter, pname: pname, run: run})
+}
+
+// generateMipmap records run as the call that defines every level but the
+// first, replacing earlier uploads to those levels.
+func (r *resources) generateMipmap(target GLenum, run func()) {
+	res := r.texture(target)
+	if res == nil {
+		return
+	}
+	res.drop(func(s *resourceStep) bool {
+		return s.op == stepMipmap || (s.op == stepData || s.op == stepSubData) && s.level > 0
+	})
+	res.steps = append(res.steps, resourceStep{op: stepMipmap, run: run})
+}
+
+func (r *resources) shaderSource(obj *object, source string) {
+	if s := r.shaders[obj]; s != nil {
+		s.source = source
+	}
+}
+
+func (r *resources) compileShader(obj *object) {
+	if s := r.shaders[obj]; s != nil {
+		s.compiled = s.source
+		s.compile = true
+	}
+}
+
+func (r *resources) attachShader(program, shader *object) {
+	p, s := r.programs[program], r.shaders[shader]
+	if p != nil && s != nil {
+		p.attached = append(p.attached, s)
+	}
+}
+
+func (r *resources) detachShader(program, shader *object) {
+	p, s := r.programs[program], r.shaders[shader]
+	if p == nil || s == nil {
+		return
+	}
+	for i, a := range p.attached {
+		if a == s {
+			p.attached = append(p.attached[:i], p.attached[i+1:]...)
+			break
+		}
+	}
+	r.collect()
+}
+
+func (r *resources) bindAttribLocation(program *object, index int, name string) {
+	if p := r.programs[program]; p != nil {
+		p.attribs[name] = index
+	}
+}
+
+func (r *resources) linkProgram(program *object) {
+	p := r.programs[program]
+	if p == nil {
+		return
+	}
+	p.linked = true
+	p.linkedShaders = append([]*shaderResource(nil), p.attached...)
+	p.linkedAttribs = make(map[string]int, len(p.attribs))
+	for name, index := range p.attribs {
+		p.linkedAttribs[name] = index
+	}
+	r.collect()
+}
+
+func (r *resources) uniformLocation(program *object, name string, location *object) {
+	if p := r.programs[program]; p != nil && location != nil {
+		p.locations[name] = location
+	}
+}
+
+// restore re-creates the tracked resources in c, which must have just been
+// restored, and points their handles at the new objects. It stops early
+// if the context is lost again.
+func (r *resources) restore(c *Context) {
+	c.resources = nil
+	defer func() { c.resources = r }()
+	r.reset()
+
+	for _, res := range r.buffers {
+		b := c.CreateBuffer()
+		if b.object == nil {
+			return
+		}
+		*res.obj = *b.object
+		if res.target == 0 || !res.sized {
+			continue
+		}
+		c.BindBuffer(res.target, Buffer{res.obj})
+		c.BufferDataBytes(res.target, res.data, res.usage)
+		c.BindBuffer(res.target, Buffer{})
+	}
+
+	for _, res := range r.textures {
+		t := c.CreateTexture()
+		if t.object == nil {
+			return
+		}
+		*res.obj = *t.object
+		if res.target == 0 {
+			continue
+		}
+		c.BindTexture(res.target, Texture{res.obj})
+		for _, s := range res.steps {
+			for pname, param := range s.unpack {
+				c.PixelStorei(pname, param)
+			}
+			if s.run != nil {
+				s.run()
+			} else {
+				s.upload(c)
+			}
+			for pname := range s.unpack {
+				c.PixelStorei(pname, unpackDefaults[pname])
+			}
+		}
+		c.BindTexture(res.target, Texture{})
+	}
+
+	for _, s := range r.shaders {
+		sh := c.CreateShader(s.typ)
+		if sh.object == nil {
+			return
+		}
+		*s.obj = *sh.object
+		if s.compile {
+			c.ShaderSource(sh, s.compiled)
+			c.CompileShader(sh)
+		}
+		if s.source != s.compiled {
+			c.ShaderSource(sh, s.source)
+		}
+	}
+
+	for _, p := range r.programs {
+		prog := c.CreateProgram()
+		if prog.object == nil {
+			return
+		}
+		*p.obj = *prog.object
+		if p.linked {
+			for _, s := range p.linkedShaders {
+				c.AttachShader(prog, Shader{s.obj})
+			}
+			for name, index := range p.linkedAttribs {
+				c.BindAttribLocation(prog, index, name)
+			}
+			c.LinkProgram(prog)
+			for _, s := range p.linkedShaders {
+				c.DetachShader(prog, Shader{s.obj})
+			}
+		}
+		for _, s := range p.attached {
+			c.AttachShader(prog, Shader{s.obj})
+		}
+		for name, index := range p.attribs {
+			c.BindAttribLocation(prog, index, name)
+		}
+		for name, location := range p.locations {
+			if l := c.GetUniformLocation(prog, name); l.object != nil {
+				*location = *l.object
+			}
+		}
+	}
+
+	for _, s := range r.shaders {
+		if s.deleted {
+			c.DeleteShader(Shader{s.obj})
+		}
+	}
+}
+
+// pixelSize returns the size in bytes of a pixel of format and typ, or 0
+// if either is not known.
+func pixelSize(format, typ GLenum) int {
+	switch typ {
+	case UNSIGNED_BYTE:
+		return components(format)
+	case UNSIGNED_SHORT_5_6_5, UNSIGNED_SHORT_4_4_4_4, UNSIGNED_SHORT_5_5_5_1:
+		return 2
+	case FLOAT:
+		return 4 * components(format)
+	}
+	return 0
+}
+
+// elemSizeOf returns the size of the elements of the Go slices that hold
+// pixels of type typ.
+func elemSizeOf(typ GLenum) int {
+	switch typ {
+	case UNSIGNED_SHORT_5_6_5, UNSIGNED_SHORT_4_4_4_4, UNSIGNED_SHORT_5_5_5_1:
+		return 2
+	case FLOAT:
+		return 4
+	}
+	return 1
+}
+
+// pixelsLen returns the size in bytes of pixels, a []byte, []uint16 or
+// []float32, and the size of its elements.
+func pixelsLen(pixels interface{}) (n, elemSize int) {
+	switch pixels := pixels.(type) {
+	case []uint16:
+		return 2 * len(pixels), 2
+	case []float32:
+		return 4 * len(pixels), 4
+	case []byte:
+		return len(pixels), 1
+	}
+	return 0, 1
+}
+
+// putPixels writes to b the bytes of pixels, a []byte, []uint16 or
+// []float32, from byte offset on.
+func putPixels(b []byte, pixels interface{}, offset int) {
+	switch pixels := pixels.(type) {
+	case []uint16:
+		putUint16s(b, pixels[offset/2:offset/2+len(b)/2])
+	case []float32:
+		putFloat32s(b, pixels[offset/4:offset/4+len(b)/4])
+	case []byte:
+		copy(b, pixels[offset:])
+	}
+}
+
+// The conversions below lay out the elements in little-endian order, as
+// the typed arrays of every browser do.
+
+// uint16Bytes returns the bytes of data.
+func uint16Bytes(data []uint16) []byte {
+	b := make([]byte, 2*len(data))
+	putUint16s(b, data)
+	return b
+}
+
+// float32Bytes returns the bytes of data.
+func float32Bytes(data []float32) []byte {
+	b := make([]byte, 4*len(data))
+	putFloat32s(b, data)
+	return b
+}
+
+// putUint16s writes the bytes of data to b.
+func putUint16s(b []byte, data []uint16) {
+	for i, v := range data {
+		binary.LittleEndian.PutUint16(b[2*i:], v)
+	}
+}
+
+// putFloat32s writes the bytes of data to b.
+func putFloat32s(b []byte, data []float32) {
+	for i, v := range data {
+		binary.LittleEndian.PutUint32(b[4*i:], math.Float32bits(v))
+	}
+}
+
+// uint16s returns the elements whose bytes are b.
+func uint16s(b []byte) []uint16 {
+	data := make([]uint16, len(b)/2)
+	for i := range data {
+		data[i] = binary.LittleEndian.Uint16(b[2*i:])
+	}
+	return data
+}
+
+// float32s returns the elements whose bytes are b.
+func float32s(b []byte) []float32 {
+	data := make([]float32, len(b)/4)
+	for i := range data {
+		data[i] = math.Float32frombits(binary.LittleEndian.Uint32(b[4*i:]))
+	}
+	return data
+}
@@ -0,0 +1,160 @@
+// Copyright 2014 Joseph Hager. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package webgl
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestBufferSubData(t *testing.T) {
+	r := newResources()
+	obj := &object{id: 1}
+	r.createBuffer(obj)
+	r.bindBuffer(ARRAY_BUFFER, obj)
+	r.bufferData(ARRAY_BUFFER, make([]byte, 8), STREAM_DRAW)
+
+	// Streaming updates are written into the one copy of the buffer.
+	for i := 0; i < 1000; i++ {
+		putUint16s(r.bufferRange(ARRAY_BUFFER, 2, 4), []uint16{uint16(i), 0xffff})
+	}
+	if got, want := r.buffers[obj].data, []byte{0, 0, 0xe7, 0x03, 0xff, 0xff, 0, 0}; !bytes.Equal(got, want) {
+		t.Errorf("buffer = %v, want %v", got, want)
+	}
+	if b := r.bufferRange(ARRAY_BUFFER, 6, 4); b != nil {
+		t.Errorf("bufferRange past the end = %v, want nil", b)
+	}
+	if b := r.bufferRange(ELEMENT_ARRAY_BUFFER, 0, 4); b != nil {
+		t.Errorf("bufferRange of an unbound target = %v, want nil", b)
+	}
+}
+
+// texture returns resources tracking a texture bound to TEXTURE_2D.
+func texture() (*resources, *resource) {
+	r := newResources()
+	obj := &object{id: 1}
+	r.createTexture(obj)
+	r.bindTexture(TEXTURE_2D, obj)
+	return r, r.textures[obj]
+}
+
+func TestTexSubImage(t *testing.T) {
+	tests := []struct {
+		name   string
+		upload func(r *resources)
+		steps  int
+		pixels []byte // of the first step
+	}{
+		{
+			name: "streaming",
+			upload: func(r *resources) {
+				r.pixelStore(UNPACK_ALIGNMENT, 1)
+				r.texImagePixels(TEXTURE_2D, 0, LUMINANCE, 2, 2, LUMINANCE, UNSIGNED_BYTE, []byte(nil))
+				for i := 0; i < 100; i++ {
+					r.texSubImagePixels(TEXTURE_2D, 0, 0, 0, 2, 2, LUMINANCE, UNSIGNED_BYTE, []byte{byte(i), 1, 2, 3})
+				}
+			},
+			steps:  1,
+			pixels: []byte{99, 1, 2, 3},
+		},
+		{
+			name: "packed",
+			upload: func(r *resources) {
+				// Rows of 3 bytes are padded to 4.
+				r.texImagePixels(TEXTURE_2D, 0, LUMINANCE, 3, 2, LUMINANCE, UNSIGNED_BYTE, []byte{1, 2, 3, 0, 4, 5, 6})
+				r.pixelStore(UNPACK_ALIGNMENT, 1)
+				r.texSubImagePixels(TEXTURE_2D, 0, 1, 1, 2, 1, LUMINANCE, UNSIGNED_BYTE, []byte{8, 9})
+			},
+			steps:  1,
+			pixels: []byte{1, 2, 3, 4, 8, 9},
+		},
+		{
+			name: "flipped",
+			upload: func(r *resources) {
+				r.pixelStore(UNPACK_ALIGNMENT, 1)
+				r.pixelStore(UNPACK_FLIP_Y_WEBGL, 1)
+				r.texImagePixels(TEXTURE_2D, 0, LUMINANCE, 1, 3, LUMINANCE, UNSIGNED_BYTE, []byte{1, 2, 3})
+				r.pixelStore(UNPACK_FLIP_Y_WEBGL, 0)
+				r.texSubImagePixels(TEXTURE_2D, 0, 0, 0, 1, 2, LUMINANCE, UNSIGNED_BYTE, []byte{8, 9})
+			},
+			steps:  1,
+			pixels: []byte{1, 9, 8},
+		},
+		{
+			name: "float",
+			upload: func(r *resources) {
+				r.texImagePixels(TEXTURE_2D, 0, ALPHA, 2, 1, ALPHA, FLOAT, []float32{0, 0})
+				r.texSubImagePixels(TEXTURE_2D, 0, 1, 0, 1, 1, ALPHA, FLOAT, []float32{1})
+			},
+			steps:  1,
+			pixels: []byte{0, 0, 0, 0, 0, 0, 0x80, 0x3f},
+		},
+		{
+			name: "other level",
+			upload: func(r *resources) {
+				r.texImagePixels(TEXTURE_2D, 0, LUMINANCE, 1, 1, LUMINANCE, UNSIGNED_BYTE, []byte{1})
+				r.texSubImagePixels(TEXTURE_2D, 1, 0, 0, 1, 1, LUMINANCE, UNSIGNED_BYTE, []byte{2})
+			},
+			steps:  2,
+			pixels: []byte{1},
+		},
+		{
+			name: "premultiplied",
+			upload: func(r *resources) {
+				r.texImagePixels(TEXTURE_2D, 0, LUMINANCE, 1, 1, LUMINANCE, UNSIGNED_BYTE, []byte{1})
+				r.pixelStore(UNPACK_PREMULTIPLY_ALPHA_WEBGL, 1)
+				r.texSubImagePixels(TEXTURE_2D, 0, 0, 0, 1, 1, LUMINANCE, UNSIGNED_BYTE, []byte{2})
+				r.texSubImagePixels(TEXTURE_2D, 0, 0, 0, 1, 1, LUMINANCE, UNSIGNED_BYTE, []byte{3})
+			},
+			steps:  2,
+			pixels: []byte{1},
+		},
+		{
+			name: "images",
+			upload: func(r *resources) {
+				r.texImage(TEXTURE_2D, 0, func() {})
+				for i := 0; i < 100; i++ {
+					r.texSubImage(TEXTURE_2D, 0, 0, 0, 4, 4, func() {})
+					r.texSubImage(TEXTURE_2D, 0, 1, 1, 2, 2, func() {})
+				}
+			},
+			steps: 3,
+		},
+		{
+			name: "after an image",
+			upload: func(r *resources) {
+				r.texImagePixels(TEXTURE_2D, 0, LUMINANCE, 3, 1, LUMINANCE, UNSIGNED_BYTE, []byte{1, 2, 3})
+				r.texSubImage(TEXTURE_2D, 0, 0, 0, 2, 1, func() {})
+				// Under part of the later image, so replayed after it.
+				r.texSubImagePixels(TEXTURE_2D, 0, 0, 0, 1, 1, LUMINANCE, UNSIGNED_BYTE, []byte{4})
+				// Clear of it, so written into the copy.
+				r.texSubImagePixels(TEXTURE_2D, 0, 2, 0, 1, 1, LUMINANCE, UNSIGNED_BYTE, []byte{5})
+			},
+			steps:  3,
+			pixels: []byte{1, 2, 5},
+		},
+		{
+			name: "covering an image",
+			upload: func(r *resources) {
+				r.texImagePixels(TEXTURE_2D, 0, LUMINANCE, 2, 1, LUMINANCE, UNSIGNED_BYTE, []byte{1, 2})
+				r.texSubImage(TEXTURE_2D, 0, 0, 0, 1, 1, func() {})
+				r.texSubImagePixels(TEXTURE_2D, 0, 0, 0, 2, 1, LUMINANCE, UNSIGNED_BYTE, []byte{3, 4})
+			},
+			steps:  1,
+			pixels: []byte{3, 4},
+		},
+	}
+	for _, test := range tests {
+		r, res := texture()
+		test.upload(r)
+		if len(res.steps) != test.steps {
+			t.Errorf("%s: %d steps, want %d", test.name, len(res.steps), test.steps)
+			continue
+		}
+		if got := res.steps[0].pixels; !bytes.Equal(got, test.pixels) {
+			t.Errorf("%s: pixels = %v, want %v", test.name, got, test.pixels)
+		}
+	}
+}
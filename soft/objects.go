@@ -0,0 +1,1096 @@
+// Copyright 2014 Joseph Hager. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package soft
+
+import (
+	"encoding/binary"
+	"math"
+
+	"github.com/gopherjs/webgl"
+)
+
+// surface is a set of pixel planes of the same size. Rows are stored
+// bottom row first, as WebGL addresses them. A nil plane is absent.
+type surface struct {
+	width, height int
+
+	// color holds 8-bit RGBA pixels.
+	color []byte
+
+	// opaque is set when the color plane has no alpha channel, so alpha
+	// always reads as 1.
+	opaque bool
+
+	depth   []float32
+	stencil []byte
+}
+
+func newCanvas(width, height int, attrs *webgl.ContextAttributes) *surface {
+	s := &surface{width: width, height: height, opaque: !attrs.Alpha}
+	s.color = make([]byte, 4*width*height)
+	if s.opaque {
+		for i := 3; i < len(s.color); i += 4 {
+			s.color[i] = 0xff
+		}
+	}
+	if attrs.Depth {
+		s.depth = make([]float32, width*height)
+		for i := range s.depth {
+			s.depth[i] = 1
+		}
+	}
+	if attrs.Stencil {
+		s.stencil = make([]byte, width*height)
+	}
+	return s
+}
+
+type buffer struct {
+	target webgl.GLenum
+	usage  webgl.GLenum
+	data   []byte
+}
+
+type renderbuffer struct {
+	bound  bool
+	format webgl.GLenum
+	surface
+}
+
+type attachment struct {
+	renderbuffer *renderbuffer
+	texture      *texture
+	face         webgl.GLenum
+}
+
+type framebuffer struct {
+	bound       bool
+	attachments map[webgl.GLenum]attachment
+}
+
+// textureImage is one image of a texture, stored as 8-bit RGBA bottom row
+// first. Formats without all four channels are expanded when the image is
+// defined, so that sampling can read the channels directly.
+type textureImage struct {
+	width, height int
+	format        webgl.GLenum
+	pixels        []byte
+}
+
+type texture struct {
+	target    webgl.GLenum
+	images    map[webgl.GLenum]*textureImage // by face, base level only
+	minFilter webgl.GLenum
+	magFilter webgl.GLenum
+	wrapS     webgl.GLenum
+	wrapT     webgl.GLenum
+	mipmapped bool
+}
+
+type textureUnit struct {
+	texture2D, cubeMap *texture
+}
+
+func (r *Renderer) newID() uint32 {
+	r.nextID++
+	return r.nextID
+}
+
+func (r *Renderer) CreateBuffer() webgl.Buffer {
+	id := r.newID()
+	r.buffers[id] = &buffer{}
+	return webgl.BufferFromID(id)
+}
+
+func (r *Renderer) IsBuffer(b webgl.Buffer) bool {
+	buf := r.buffers[b.ID()]
+	return buf != nil && buf.target != 0
+}
+
+func (r *Renderer) DeleteBuffer(b webgl.Buffer) {
+	buf, ok := r.buffers[b.ID()]
+	if !ok {
+		return
+	}
+	delete(r.buffers, b.ID())
+	if r.arrayBuffer == buf {
+		r.arrayBuffer = nil
+	}
+	if r.elementBuffer == buf {
+		r.elementBuffer = nil
+	}
+	for i := range r.attribs {
+		if r.attribs[i].buffer == buf {
+			r.attribs[i].buffer = nil
+		}
+	}
+}
+
+func (r *Renderer) BindBuffer(target webgl.GLenum, b webgl.Buffer) {
+	var buf *buffer
+	if b.ID() != 0 {
+		var ok bool
+		if buf, ok = r.buffers[b.ID()]; !ok {
+			r.setError(webgl.INVALID_OPERATION)
+			return
+		}
+	}
+	if target != webgl.ARRAY_BUFFER && target != webgl.ELEMENT_ARRAY_BUFFER {
+		r.setError(webgl.INVALID_ENUM)
+		return
+	}
+	if buf != nil {
+		// A buffer cannot change between vertex and index data in WebGL.
+		if buf.target != 0 && buf.target != target {
+			r.setError(webgl.INVALID_OPERATION)
+			return
+		}
+		buf.target = target
+	}
+	if target == webgl.ARRAY_BUFFER {
+		r.arrayBuffer = buf
+	} else {
+		r.elementBuffer = buf
+	}
+}
+
+// boundBuffer returns the buffer bound to target, setting an error and
+// returning nil if there is none.
+func (r *Renderer) boundBuffer(target webgl.GLenum) *buffer {
+	var buf *buffer
+	switch target {
+	case webgl.ARRAY_BUFFER:
+		buf = r.arrayBuffer
+	case webgl.ELEMENT_ARRAY_BUFFER:
+		buf = r.elementBuffer
+	default:
+		r.setError(webgl.INVALID_ENUM)
+		return nil
+	}
+	if buf == nil {
+		r.setError(webgl.INVALID_OPERATION)
+	}
+	return buf
+}
+
+// BufferData accepts a size in bytes or a slice of fixed-size numbers,
+// which is stored in little-endian byte order.
+func (r *Renderer) BufferData(target webgl.GLenum, data interface{}, usage webgl.GLenum) {
+	switch usage {
+	case webgl.STREAM_DRAW, webgl.STATIC_DRAW, webgl.DYNAMIC_DRAW:
+	default:
+		r.setError(webgl.INVALID_ENUM)
+		return
+	}
+	buf := r.boundBuffer(target)
+	if buf == nil {
+		return
+	}
+	var b []byte
+	if size, ok := data.(int); ok {
+		if size < 0 {
+			r.setError(webgl.INVALID_VALUE)
+			return
+		}
+		b = make([]byte, size)
+	} else if b, ok = bytesOf(data); !ok {
+		r.setError(webgl.INVALID_VALUE)
+		return
+	}
+	buf.data, buf.usage = b, usage
+}
+
+func (r *Renderer) BufferSubData(target webgl.GLenum, offset int, data interface{}) {
+	buf := r.boundBuffer(target)
+	if buf == nil {
+		return
+	}
+	b, ok := bytesOf(data)
+	if !ok || offset < 0 || offset+len(b) > len(buf.data) {
+		r.setError(webgl.INVALID_VALUE)
+		return
+	}
+	copy(buf.data[offset:], b)
+}
+
+func (r *Renderer) BufferDataSize(target webgl.GLenum, size int, usage webgl.GLenum) {
+	r.BufferData(target, size, usage)
+}
+
+func (r *Renderer) BufferDataBytes(target webgl.GLenum, data []byte, usage webgl.GLenum) {
+	r.BufferData(target, data, usage)
+}
+
+func (r *Renderer) BufferDataUint16(target webgl.GLenum, data []uint16, usage webgl.GLenum) {
+	r.BufferData(target, data, usage)
+}
+
+func (r *Renderer) BufferDataFloat32(target webgl.GLenum, data []float32, usage webgl.GLenum) {
+	r.BufferData(target, data, usage)
+}
+
+func (r *Renderer) BufferSubDataBytes(target webgl.GLenum, offset int, data []byte) {
+	r.BufferSubData(target, offset, data)
+}
+
+func (r *Renderer) BufferSubDataUint16(target webgl.GLenum, offset int, data []uint16) {
+	r.BufferSubData(target, offset, data)
+}
+
+func (r *Renderer) BufferSubDataFloat32(target webgl.GLenum, offset int, data []float32) {
+	r.BufferSubData(target, offset, data)
+}
+
+// bytesOf returns the bytes of a slice of fixed-size numbers in
+// little-endian order, or false if data is not such a slice.
+func bytesOf(data interface{}) ([]byte, bool) {
+	switch v := data.(type) {
+	case []byte:
+		return append([]byte(nil), v...), true
+	case []int8:
+		b := make([]byte, len(v))
+		for i, x := range v {
+			b[i] = byte(x)
+		}
+		return b, true
+	case []uint16:
+		b := make([]byte, 2*len(v))
+		for i, x := range v {
+			binary.LittleEndian.PutUint16(b[2*i:], x)
+		}
+		return b, true
+	case []int16:
+		b := make([]byte, 2*len(v))
+		for i, x := range v {
+			binary.LittleEndian.PutUint16(b[2*i:], uint16(x))
+		}
+		return b, true
+	case []uint32:
+		b := make([]byte, 4*len(v))
+		for i, x := range v {
+			binary.LittleEndian.PutUint32(b[4*i:], x)
+		}
+		return b, true
+	case []int32:
+		b := make([]byte, 4*len(v))
+		for i, x := range v {
+			binary.LittleEndian.PutUint32(b[4*i:], uint32(x))
+		}
+		return b, true
+	case []float32:
+		b := make([]byte, 4*len(v))
+		for i, x := range v {
+			binary.LittleEndian.PutUint32(b[4*i:], math.Float32bits(x))
+		}
+		return b, true
+	case []float64:
+		b := make([]byte, 8*len(v))
+		for i, x := range v {
+			binary.LittleEndian.PutUint64(b[8*i:], math.Float64bits(x))
+		}
+		return b, true
+	}
+	return nil, false
+}
+
+func (r *Renderer) CreateTexture() webgl.Texture {
+	id := r.newID()
+	r.textures[id] = &texture{
+		images:    make(map[webgl.GLenum]*textureImage),
+		minFilter: webgl.NEAREST_MIPMAP_LINEAR,
+		magFilter: webgl.LINEAR,
+		wrapS:     webgl.REPEAT,
+		wrapT:     webgl.REPEAT,
+	}
+	return webgl.TextureFromID(id)
+}
+
+func (r *Renderer) IsTexture(t webgl.Texture) bool {
+	tex := r.textures[t.ID()]
+	return tex != nil && tex.target != 0
+}
+
+func (r *Renderer) DeleteTexture(t webgl.Texture) {
+	tex, ok := r.textures[t.ID()]
+	if !ok {
+		return
+	}
+	delete(r.textures, t.ID())
+	for i := range r.textureUnits {
+		u := &r.textureUnits[i]
+		if u.texture2D == tex {
+			u.texture2D = nil
+		}
+		if u.cubeMap == tex {
+			u.cubeMap = nil
+		}
+	}
+	if fb := r.boundFramebuffer; fb != nil {
+		for point, a := range fb.attachments {
+			if a.texture == tex {
+				delete(fb.attachments, point)
+			}
+		}
+	}
+}
+
+func (r *Renderer) ActiveTexture(unit webgl.GLenum) {
+	i := int(unit - webgl.TEXTURE0)
+	if i < 0 || i >= MaxTextureImageUnits {
+		r.setError(webgl.INVALID_ENUM)
+		return
+	}
+	r.activeTexture = i
+}
+
+func (r *Renderer) BindTexture(target webgl.GLenum, t webgl.Texture) {
+	var tex *texture
+	if t.ID() != 0 {
+		var ok bool
+		if tex, ok = r.textures[t.ID()]; !ok {
+			r.setError(webgl.INVALID_OPERATION)
+			return
+		}
+	}
+	if target != webgl.TEXTURE_2D && target != webgl.TEXTURE_CUBE_MAP {
+		r.setError(webgl.INVALID_ENUM)
+		return
+	}
+	if tex != nil {
+		if tex.target != 0 && tex.target != target {
+			r.setError(webgl.INVALID_OPERATION)
+			return
+		}
+		tex.target = target
+	}
+	u := &r.textureUnits[r.activeTexture]
+	if target == webgl.TEXTURE_2D {
+		u.texture2D = tex
+	} else {
+		u.cubeMap = tex
+	}
+}
+
+// boundTexture returns the texture bound to target on the active unit,
+// setting an error and returning nil if there is none.
+func (r *Renderer) boundTexture(target webgl.GLenum) *texture {
+	var tex *texture
+	switch target {
+	case webgl.TEXTURE_2D:
+		tex = r.textureUnits[r.activeTexture].texture2D
+	case webgl.TEXTURE_CUBE_MAP:
+		tex = r.textureUnits[r.activeTexture].cubeMap
+	default:
+		r.setError(webgl.INVALID_ENUM)
+		return nil
+	}
+	if tex == nil {
+		r.setError(webgl.INVALID_OPERATION)
+	}
+	return tex
+}
+
+// imageTarget returns the texture bound for an image target, which is
+// TEXTURE_2D or one of the cube map faces.
+func (r *Renderer) imageTarget(target webgl.GLenum) *texture {
+	switch target {
+	case webgl.TEXTURE_2D:
+		return r.boundTexture(webgl.TEXTURE_2D)
+	case webgl.TEXTURE_CUBE_MAP_POSITIVE_X, webgl.TEXTURE_CUBE_MAP_NEGATIVE_X,
+		webgl.TEXTURE_CUBE_MAP_POSITIVE_Y, webgl.TEXTURE_CUBE_MAP_NEGATIVE_Y,
+		webgl.TEXTURE_CUBE_MAP_POSITIVE_Z, webgl.TEXTURE_CUBE_MAP_NEGATIVE_Z:
+		return r.boundTexture(webgl.TEXTURE_CUBE_MAP)
+	}
+	r.setError(webgl.INVALID_ENUM)
+	return nil
+}
+
+func (r *Renderer) TexParameteri(target, pname webgl.GLenum, param int) {
+	tex := r.boundTexture(target)
+	if tex == nil {
+		return
+	}
+	p := webgl.GLenum(param)
+	switch pname {
+	case webgl.TEXTURE_MIN_FILTER:
+		switch p {
+		case webgl.NEAREST, webgl.LINEAR,
+			webgl.NEAREST_MIPMAP_NEAREST, webgl.LINEAR_MIPMAP_NEAREST,
+			webgl.NEAREST_MIPMAP_LINEAR, webgl.LINEAR_MIPMAP_LINEAR:
+			tex.minFilter = p
+			return
+		}
+	case webgl.TEXTURE_MAG_FILTER:
+		if p == webgl.NEAREST || p == webgl.LINEAR {
+			tex.magFilter = p
+			return
+		}
+	case webgl.TEXTURE_WRAP_S, webgl.TEXTURE_WRAP_T:
+		switch p {
+		case webgl.REPEAT, webgl.CLAMP_TO_EDGE, webgl.MIRRORED_REPEAT:
+			if pname == webgl.TEXTURE_WRAP_S {
+				tex.wrapS = p
+			} else {
+				tex.wrapT = p
+			}
+			return
+		}
+	}
+	r.setError(webgl.INVALID_ENUM)
+}
+
+// GenerateMipmap only marks the texture as mipmapped, since sampling
+// always reads the base level.
+func (r *Renderer) GenerateMipmap(target webgl.GLenum) {
+	tex := r.boundTexture(target)
+	if tex == nil {
+		return
+	}
+	base := tex.images[webgl.TEXTURE_2D]
+	if target == webgl.TEXTURE_CUBE_MAP {
+		base = tex.images[webgl.TEXTURE_CUBE_MAP_POSITIVE_X]
+	}
+	if base == nil || !isPowerOfTwo(base.width) || !isPowerOfTwo(base.height) {
+		r.setError(webgl.INVALID_OPERATION)
+		return
+	}
+	tex.mipmapped = true
+}
+
+// complete reports whether a 2D texture can be sampled. Incomplete
+// textures sample as opaque black.
+func (t *texture) complete() bool {
+	img := t.images[webgl.TEXTURE_2D]
+	if img == nil {
+		return false
+	}
+	mipmaps := t.minFilter != webgl.NEAREST && t.minFilter != webgl.LINEAR
+	if mipmaps && !t.mipmapped {
+		return false
+	}
+	if !isPowerOfTwo(img.width) || !isPowerOfTwo(img.height) {
+		// WebGL 1.0 restricts non-power-of-two textures.
+		return !mipmaps && t.wrapS == webgl.CLAMP_TO_EDGE && t.wrapT == webgl.CLAMP_TO_EDGE
+	}
+	return true
+}
+
+func (r *Renderer) CopyTexImage2D(target webgl.GLenum, level int, internal webgl.GLenum, x, y, w, h, border int) {
+	tex := r.imageTarget(target)
+	if tex == nil {
+		return
+	}
+	if !isTextureFormat(internal) {
+		r.setError(webgl.INVALID_ENUM)
+		return
+	}
+	if level < 0 || border != 0 || w < 0 || h < 0 || w > MaxTextureSize || h > MaxTextureSize {
+		r.setError(webgl.INVALID_VALUE)
+		return
+	}
+	if level != 0 {
+		// Only the base level is stored.
+		return
+	}
+	src, ok := r.drawTarget()
+	if !ok {
+		return
+	}
+	if src.color == nil {
+		r.setError(webgl.INVALID_OPERATION)
+		return
+	}
+	img := &textureImage{width: w, height: h, format: internal, pixels: make([]byte, 4*w*h)}
+	copyPixels(img, 0, 0, src, x, y, w, h)
+	tex.images[target] = img
+	if target == webgl.TEXTURE_2D || target == webgl.TEXTURE_CUBE_MAP_POSITIVE_X {
+		tex.mipmapped = false
+	}
+}
+
+func (r *Renderer) CopyTexSubImage2D(target webgl.GLenum, level, xoffset, yoffset, x, y, w, h int) {
+	tex := r.imageTarget(target)
+	if tex == nil {
+		return
+	}
+	if level != 0 {
+		if level < 0 {
+			r.setError(webgl.INVALID_VALUE)
+		}
+		return
+	}
+	img := tex.images[target]
+	if img == nil {
+		r.setError(webgl.INVALID_OPERATION)
+		return
+	}
+	if xoffset < 0 || yoffset < 0 || w < 0 || h < 0 || xoffset+w > img.width || yoffset+h > img.height {
+		r.setError(webgl.INVALID_VALUE)
+		return
+	}
+	src, ok := r.drawTarget()
+	if !ok {
+		return
+	}
+	if src.color == nil {
+		r.setError(webgl.INVALID_OPERATION)
+		return
+	}
+	copyPixels(img, xoffset, yoffset, src, x, y, w, h)
+}
+
+func (r *Renderer) TexImage2DBytes(target webgl.GLenum, level int, internal webgl.GLenum, width, height, border int, format, typ webgl.GLenum, pixels []byte) {
+	r.texImage2D(target, level, internal, width, height, border, format, typ, pixels, typ == webgl.UNSIGNED_BYTE)
+}
+
+func (r *Renderer) TexImage2DUint16(target webgl.GLenum, level int, internal webgl.GLenum, width, height, border int, format, typ webgl.GLenum, pixels []uint16) {
+	b, _ := bytesOf(pixels)
+	r.texImage2D(target, level, internal, width, height, border, format, typ, b, typ != webgl.UNSIGNED_BYTE)
+}
+
+// TexImage2DFloat32 always fails, since the Renderer does not support
+// OES_texture_float.
+func (r *Renderer) TexImage2DFloat32(target webgl.GLenum, level int, internal webgl.GLenum, width, height, border int, format, typ webgl.GLenum, pixels []float32) {
+	r.texImage2D(target, level, internal, width, height, border, format, typ, nil, false)
+}
+
+func (r *Renderer) TexSubImage2DBytes(target webgl.GLenum, level, xoffset, yoffset, width, height int, format, typ webgl.GLenum, pixels []byte) {
+	r.texSubImage2D(target, level, xoffset, yoffset, width, height, format, typ, pixels, typ == webgl.UNSIGNED_BYTE)
+}
+
+func (r *Renderer) TexSubImage2DUint16(target webgl.GLenum, level, xoffset, yoffset, width, height int, format, typ webgl.GLenum, pixels []uint16) {
+	b, _ := bytesOf(pixels)
+	r.texSubImage2D(target, level, xoffset, yoffset, width, height, format, typ, b, typ != webgl.UNSIGNED_BYTE)
+}
+
+// TexSubImage2DFloat32 always fails, since the Renderer does not support
+// OES_texture_float.
+func (r *Renderer) TexSubImage2DFloat32(target webgl.GLenum, level, xoffset, yoffset, width, height int, format, typ webgl.GLenum, pixels []float32) {
+	if len(pixels) == 0 {
+		return
+	}
+	r.texSubImage2D(target, level, xoffset, yoffset, width, height, format, typ, nil, false)
+}
+
+// texImage2D defines a texture image from pixels, which hold the bytes of
+// the uploaded slice. typeMatches reports whether the slice element type
+// suits typ.
+func (r *Renderer) texImage2D(target webgl.GLenum, level int, internal webgl.GLenum, width, height, border int, format, typ webgl.GLenum, pixels []byte, typeMatches bool) {
+	tex := r.imageTarget(target)
+	if tex == nil {
+		return
+	}
+	size := pixelSize(format, typ)
+	if size == 0 || !isTextureFormat(internal) {
+		r.setError(webgl.INVALID_ENUM)
+		return
+	}
+	if level < 0 || border != 0 || width < 0 || height < 0 || width > MaxTextureSize || height > MaxTextureSize ||
+		target != webgl.TEXTURE_2D && width != height {
+		r.setError(webgl.INVALID_VALUE)
+		return
+	}
+	if internal != format || !typeMatches {
+		r.setError(webgl.INVALID_OPERATION)
+		return
+	}
+	if len(pixels) > 0 && len(pixels) < r.unpackLength(width, height, size) {
+		r.setError(webgl.INVALID_OPERATION)
+		return
+	}
+	if level != 0 {
+		// Only the base level is stored.
+		return
+	}
+	img := &textureImage{width: width, height: height, format: format, pixels: make([]byte, 4*width*height)}
+	if len(pixels) == 0 {
+		// Cleared images sample as they would in the format.
+		for i := 0; i < len(img.pixels); i += 4 {
+			expand(img.pixels[i:], [4]byte{}, format)
+		}
+	} else {
+		r.unpack(img, 0, 0, width, height, typ, pixels, size)
+	}
+	tex.images[target] = img
+	if target == webgl.TEXTURE_2D || target == webgl.TEXTURE_CUBE_MAP_POSITIVE_X {
+		tex.mipmapped = false
+	}
+}
+
+func (r *Renderer) texSubImage2D(target webgl.GLenum, level, xoffset, yoffset, width, height int, format, typ webgl.GLenum, pixels []byte, typeMatches bool) {
+	tex := r.imageTarget(target)
+	if tex == nil {
+		return
+	}
+	size := pixelSize(format, typ)
+	if size == 0 {
+		r.setError(webgl.INVALID_ENUM)
+		return
+	}
+	if level < 0 || width < 0 || height < 0 {
+		r.setError(webgl.INVALID_VALUE)
+		return
+	}
+	if level != 0 {
+		return
+	}
+	img := tex.images[target]
+	if img == nil {
+		r.setError(webgl.INVALID_OPERATION)
+		return
+	}
+	if xoffset < 0 || yoffset < 0 || xoffset+width > img.width || yoffset+height > img.height {
+		r.setError(webgl.INVALID_VALUE)
+		return
+	}
+	if format != img.format || !typeMatches || len(pixels) < r.unpackLength(width, height, size) {
+		r.setError(webgl.INVALID_OPERATION)
+		return
+	}
+	r.unpack(img, xoffset, yoffset, width, height, typ, pixels, size)
+}
+
+// unpackLength returns the number of bytes needed for width by height
+// pixels of size bytes, whose rows are padded to UNPACK_ALIGNMENT.
+func (r *Renderer) unpackLength(width, height, size int) int {
+	if width == 0 || height == 0 {
+		return 0
+	}
+	return (height-1)*r.unpackStride(width, size) + width*size
+}
+
+func (r *Renderer) unpackStride(width, size int) int {
+	a := r.unpackAlignment
+	return (width*size + a - 1) / a * a
+}
+
+// unpack stores width by height pixels of the given type and size into
+// img at xoffset, yoffset, flipping and premultiplying them as the pixel
+// storage parameters say.
+func (r *Renderer) unpack(img *textureImage, xoffset, yoffset, width, height int, typ webgl.GLenum, pixels []byte, size int) {
+	stride := r.unpackStride(width, size)
+	for j := 0; j < height; j++ {
+		src := pixels[j*stride:]
+		y := j
+		if r.unpackFlipY {
+			y = height - 1 - j
+		}
+		for i := 0; i < width; i++ {
+			c := decodePixel(src[i*size:], img.format, typ)
+			if r.unpackPremultiply {
+				for k := 0; k < 3; k++ {
+					c[k] = byte((int(c[k])*int(c[3]) + 127) / 255)
+				}
+			}
+			expand(img.pixels[4*((yoffset+y)*img.width+xoffset+i):], c, img.format)
+		}
+	}
+}
+
+// pixelSize returns the size in bytes of a pixel of the given format and
+// type, or 0 if they are not supported.
+func pixelSize(format, typ webgl.GLenum) int {
+	switch typ {
+	case webgl.UNSIGNED_BYTE:
+		switch format {
+		case webgl.ALPHA, webgl.LUMINANCE:
+			return 1
+		case webgl.LUMINANCE_ALPHA:
+			return 2
+		case webgl.RGB:
+			return 3
+		case webgl.RGBA:
+			return 4
+		}
+	case webgl.UNSIGNED_SHORT_5_6_5:
+		if format == webgl.RGB {
+			return 2
+		}
+	case webgl.UNSIGNED_SHORT_4_4_4_4, webgl.UNSIGNED_SHORT_5_5_5_1:
+		if format == webgl.RGBA {
+			return 2
+		}
+	}
+	return 0
+}
+
+// decodePixel returns the color of the pixel at the start of p, with the
+// channels the format lacks filled as expand does.
+func decodePixel(p []byte, format, typ webgl.GLenum) [4]byte {
+	if typ != webgl.UNSIGNED_BYTE {
+		v := int(binary.LittleEndian.Uint16(p))
+		switch typ {
+		case webgl.UNSIGNED_SHORT_5_6_5:
+			return [4]byte{scale(v>>11, 31), scale(v>>5&63, 63), scale(v&31, 31), 0xff}
+		case webgl.UNSIGNED_SHORT_4_4_4_4:
+			return [4]byte{scale(v>>12, 15), scale(v>>8&15, 15), scale(v>>4&15, 15), scale(v&15, 15)}
+		default:
+			return [4]byte{scale(v>>11, 31), scale(v>>6&31, 31), scale(v>>1&31, 31), scale(v&1, 1)}
+		}
+	}
+	switch format {
+	case webgl.ALPHA:
+		return [4]byte{0, 0, 0, p[0]}
+	case webgl.LUMINANCE:
+		return [4]byte{p[0], p[0], p[0], 0xff}
+	case webgl.LUMINANCE_ALPHA:
+		return [4]byte{p[0], p[0], p[0], p[1]}
+	case webgl.RGB:
+		return [4]byte{p[0], p[1], p[2], 0xff}
+	}
+	return [4]byte{p[0], p[1], p[2], p[3]}
+}
+
+// scale converts v in [0, max] to a byte.
+func scale(v, max int) byte {
+	return byte((v*255 + max/2) / max)
+}
+
+// copyPixels copies a w by h rectangle at x, y of src into img at xoffset,
+// yoffset, converting it to the image format. Pixels outside src are left
+// as they are.
+func copyPixels(img *textureImage, xoffset, yoffset int, src *planes, x, y, w, h int) {
+	for j := 0; j < h; j++ {
+		sy := y + j
+		if sy < 0 || sy >= src.height {
+			continue
+		}
+		for i := 0; i < w; i++ {
+			sx := x + i
+			if sx < 0 || sx >= src.width {
+				continue
+			}
+			s := src.color[4*(sy*src.width+sx):]
+			c := [4]byte{s[0], s[1], s[2], s[3]}
+			if src.opaque {
+				c[3] = 0xff
+			}
+			d := img.pixels[4*((yoffset+j)*img.width+xoffset+i):]
+			expand(d, c, img.format)
+		}
+	}
+}
+
+// expand stores c in d as it samples in the given format.
+func expand(d []byte, c [4]byte, format webgl.GLenum) {
+	switch format {
+	case webgl.ALPHA:
+		d[0], d[1], d[2], d[3] = 0, 0, 0, c[3]
+	case webgl.LUMINANCE:
+		d[0], d[1], d[2], d[3] = c[0], c[0], c[0], 0xff
+	case webgl.LUMINANCE_ALPHA:
+		d[0], d[1], d[2], d[3] = c[0], c[0], c[0], c[3]
+	case webgl.RGB:
+		d[0], d[1], d[2], d[3] = c[0], c[1], c[2], 0xff
+	default:
+		d[0], d[1], d[2], d[3] = c[0], c[1], c[2], c[3]
+	}
+}
+
+func isTextureFormat(format webgl.GLenum) bool {
+	switch format {
+	case webgl.ALPHA, webgl.LUMINANCE, webgl.LUMINANCE_ALPHA, webgl.RGB, webgl.RGBA:
+		return true
+	}
+	return false
+}
+
+func isPowerOfTwo(n int) bool {
+	return n > 0 && n&(n-1) == 0
+}
+
+func (r *Renderer) CreateRenderbuffer() webgl.Renderbuffer {
+	id := r.newID()
+	r.renderbuffers[id] = &renderbuffer{}
+	return webgl.RenderbufferFromID(id)
+}
+
+func (r *Renderer) IsRenderbuffer(rb webgl.Renderbuffer) bool {
+	buf := r.renderbuffers[rb.ID()]
+	return buf != nil && buf.bound
+}
+
+func (r *Renderer) DeleteRenderbuffer(rb webgl.Renderbuffer) {
+	buf, ok := r.renderbuffers[rb.ID()]
+	if !ok {
+		return
+	}
+	delete(r.renderbuffers, rb.ID())
+	if r.boundRenderbuffer == buf {
+		r.boundRenderbuffer = nil
+	}
+	if fb := r.boundFramebuffer; fb != nil {
+		for point, a := range fb.attachments {
+			if a.renderbuffer == buf {
+				delete(fb.attachments, point)
+			}
+		}
+	}
+}
+
+func (r *Renderer) BindRenderbuffer(target webgl.GLenum, rb webgl.Renderbuffer) {
+	if target != webgl.RENDERBUFFER {
+		r.setError(webgl.INVALID_ENUM)
+		return
+	}
+	var buf *renderbuffer
+	if rb.ID() != 0 {
+		var ok bool
+		if buf, ok = r.renderbuffers[rb.ID()]; !ok {
+			r.setError(webgl.INVALID_OPERATION)
+			return
+		}
+		buf.bound = true
+	}
+	r.boundRenderbuffer = buf
+}
+
+func (r *Renderer) RenderbufferStorage(target, internalFormat webgl.GLenum, width, height int) {
+	if target != webgl.RENDERBUFFER {
+		r.setError(webgl.INVALID_ENUM)
+		return
+	}
+	buf := r.boundRenderbuffer
+	if buf == nil {
+		r.setError(webgl.INVALID_OPERATION)
+		return
+	}
+	if width < 0 || height < 0 || width > MaxRenderbufferSize || height > MaxRenderbufferSize {
+		r.setError(webgl.INVALID_VALUE)
+		return
+	}
+	s := surface{width: width, height: height}
+	n := width * height
+	switch internalFormat {
+	case webgl.RGBA4, webgl.RGB5_A1:
+		s.color = make([]byte, 4*n)
+	case webgl.RGB565:
+		s.color = make([]byte, 4*n)
+		s.opaque = true
+		for i := 3; i < len(s.color); i += 4 {
+			s.color[i] = 0xff
+		}
+	case webgl.DEPTH_COMPONENT16:
+		s.depth = make([]float32, n)
+	case webgl.STENCIL_INDEX8:
+		s.stencil = make([]byte, n)
+	case webgl.DEPTH_STENCIL:
+		s.depth = make([]float32, n)
+		s.stencil = make([]byte, n)
+	default:
+		r.setError(webgl.INVALID_ENUM)
+		return
+	}
+	buf.format = internalFormat
+	buf.surface = s
+}
+
+func (r *Renderer) CreateFramebuffer() webgl.Framebuffer {
+	id := r.newID()
+	r.framebuffers[id] = &framebuffer{attachments: make(map[webgl.GLenum]attachment)}
+	return webgl.FramebufferFromID(id)
+}
+
+func (r *Renderer) IsFramebuffer(fb webgl.Framebuffer) bool {
+	f := r.framebuffers[fb.ID()]
+	return f != nil && f.bound
+}
+
+func (r *Renderer) DeleteFramebuffer(fb webgl.Framebuffer) {
+	f, ok := r.framebuffers[fb.ID()]
+	if !ok {
+		return
+	}
+	delete(r.framebuffers, fb.ID())
+	if r.boundFramebuffer == f {
+		r.boundFramebuffer = nil
+	}
+}
+
+func (r *Renderer) BindFramebuffer(target webgl.GLenum, fb webgl.Framebuffer) {
+	if target != webgl.FRAMEBUFFER {
+		r.setError(webgl.INVALID_ENUM)
+		return
+	}
+	var f *framebuffer
+	if fb.ID() != 0 {
+		var ok bool
+		if f, ok = r.framebuffers[fb.ID()]; !ok {
+			r.setError(webgl.INVALID_OPERATION)
+			return
+		}
+		f.bound = true
+	}
+	r.boundFramebuffer = f
+}
+
+// boundAttachmentPoint checks the target and attachment point of a
+// framebuffer attachment call, returning the bound framebuffer.
+func (r *Renderer) boundAttachmentPoint(target, point webgl.GLenum) *framebuffer {
+	if target != webgl.FRAMEBUFFER {
+		r.setError(webgl.INVALID_ENUM)
+		return nil
+	}
+	switch point {
+	case webgl.COLOR_ATTACHMENT0, webgl.DEPTH_ATTACHMENT,
+		webgl.STENCIL_ATTACHMENT, webgl.DEPTH_STENCIL_ATTACHMENT:
+	default:
+		r.setError(webgl.INVALID_ENUM)
+		return nil
+	}
+	if r.boundFramebuffer == nil {
+		r.setError(webgl.INVALID_OPERATION)
+	}
+	return r.boundFramebuffer
+}
+
+func (r *Renderer) FramebufferRenderbuffer(target, point, renderbufferTarget webgl.GLenum, rb webgl.Renderbuffer) {
+	fb := r.boundAttachmentPoint(target, point)
+	if fb == nil {
+		return
+	}
+	if renderbufferTarget != webgl.RENDERBUFFER {
+		r.setError(webgl.INVALID_ENUM)
+		return
+	}
+	if rb.ID() == 0 {
+		delete(fb.attachments, point)
+		return
+	}
+	buf, ok := r.renderbuffers[rb.ID()]
+	if !ok {
+		r.setError(webgl.INVALID_OPERATION)
+		return
+	}
+	fb.attachments[point] = attachment{renderbuffer: buf}
+}
+
+func (r *Renderer) FramebufferTexture2D(target, point, textarget webgl.GLenum, t webgl.Texture, level int) {
+	fb := r.boundAttachmentPoint(target, point)
+	if fb == nil {
+		return
+	}
+	if t.ID() == 0 {
+		delete(fb.attachments, point)
+		return
+	}
+	tex, ok := r.textures[t.ID()]
+	if !ok {
+		r.setError(webgl.INVALID_OPERATION)
+		return
+	}
+	if level != 0 {
+		r.setError(webgl.INVALID_VALUE)
+		return
+	}
+	wantTarget := webgl.TEXTURE_CUBE_MAP
+	if textarget == webgl.TEXTURE_2D {
+		wantTarget = webgl.TEXTURE_2D
+	} else if textarget < webgl.TEXTURE_CUBE_MAP_POSITIVE_X || textarget > webgl.TEXTURE_CUBE_MAP_NEGATIVE_Z {
+		r.setError(webgl.INVALID_ENUM)
+		return
+	}
+	if tex.target != wantTarget {
+		r.setError(webgl.INVALID_OPERATION)
+		return
+	}
+	fb.attachments[point] = attachment{texture: tex, face: textarget}
+}
+
+func (r *Renderer) CheckFramebufferStatus(target webgl.GLenum) webgl.GLenum {
+	if target != webgl.FRAMEBUFFER {
+		r.setError(webgl.INVALID_ENUM)
+		return 0
+	}
+	if r.boundFramebuffer == nil {
+		return webgl.FRAMEBUFFER_COMPLETE
+	}
+	_, status := r.boundFramebuffer.planes()
+	return status
+}
+
+// planes are the pixel planes drawn into.
+type planes struct {
+	width, height int
+	color         []byte
+	opaque        bool
+	depth         []float32
+	stencil       []byte
+}
+
+// drawTarget returns the planes of the bound framebuffer, setting an error
+// and returning false if it is incomplete.
+func (r *Renderer) drawTarget() (*planes, bool) {
+	if r.boundFramebuffer == nil {
+		c := r.canvas
+		return &planes{c.width, c.height, c.color, c.opaque, c.depth, c.stencil}, true
+	}
+	t, status := r.boundFramebuffer.planes()
+	if status != webgl.FRAMEBUFFER_COMPLETE {
+		r.setError(webgl.INVALID_FRAMEBUFFER_OPERATION)
+		return nil, false
+	}
+	return t, true
+}
+
+// planes returns the planes of the framebuffer and its completeness status.
+func (fb *framebuffer) planes() (*planes, webgl.GLenum) {
+	if len(fb.attachments) == 0 {
+		return nil, webgl.FRAMEBUFFER_INCOMPLETE_MISSING_ATTACHMENT
+	}
+	_, depth := fb.attachments[webgl.DEPTH_ATTACHMENT]
+	_, stencil := fb.attachments[webgl.STENCIL_ATTACHMENT]
+	_, depthStencil := fb.attachments[webgl.DEPTH_STENCIL_ATTACHMENT]
+	if depthStencil && (depth || stencil) || depth && stencil {
+		return nil, webgl.FRAMEBUFFER_UNSUPPORTED
+	}
+	p := &planes{width: -1}
+	points := []webgl.GLenum{webgl.COLOR_ATTACHMENT0, webgl.DEPTH_ATTACHMENT, webgl.STENCIL_ATTACHMENT, webgl.DEPTH_STENCIL_ATTACHMENT}
+	for _, point := range points {
+		a, ok := fb.attachments[point]
+		if !ok {
+			continue
+		}
+		var s surface
+		if a.renderbuffer != nil {
+			s = a.renderbuffer.surface
+		} else if img := a.texture.images[a.face]; img != nil {
+			if img.format != webgl.RGBA && img.format != webgl.RGB {
+				return nil, webgl.FRAMEBUFFER_INCOMPLETE_ATTACHMENT
+			}
+			s = surface{width: img.width, height: img.height, color: img.pixels, opaque: img.format == webgl.RGB}
+		}
+		if s.width == 0 || s.height == 0 {
+			return nil, webgl.FRAMEBUFFER_INCOMPLETE_ATTACHMENT
+		}
+		switch point {
+		case webgl.COLOR_ATTACHMENT0:
+			if s.color == nil {
+				return nil, webgl.FRAMEBUFFER_INCOMPLETE_ATTACHMENT
+			}
+			p.color, p.opaque = s.color, s.opaque
+		case webgl.DEPTH_ATTACHMENT:
+			if s.depth == nil || s.stencil != nil {
+				return nil, webgl.FRAMEBUFFER_INCOMPLETE_ATTACHMENT
+			}
+			p.depth = s.depth
+		case webgl.STENCIL_ATTACHMENT:
+			if s.stencil == nil || s.depth != nil {
+				return nil, webgl.FRAMEBUFFER_INCOMPLETE_ATTACHMENT
+			}
+			p.stencil = s.stencil
+		case webgl.DEPTH_STENCIL_ATTACHMENT:
+			if s.depth == nil || s.stencil == nil {
+				return nil, webgl.FRAMEBUFFER_INCOMPLETE_ATTACHMENT
+			}
+			p.depth, p.stencil = s.depth, s.stencil
+		}
+		if p.width >= 0 && (s.width != p.width || s.height != p.height) {
+			return nil, webgl.FRAMEBUFFER_INCOMPLETE_DIMENSIONS
+		}
+		p.width, p.height = s.width, s.height
+	}
+	return p, webgl.FRAMEBUFFER_COMPLETE
+}
@@ -0,0 +1,883 @@
+// Copyright 2014 Joseph Hager. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package soft
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/gopherjs/webgl"
+)
+
+// Vec4 is a GLSL vec4. Shorter vectors use the leading components.
+type Vec4 [4]float32
+
+// Mat4 is a GLSL mat4 in column-major order, as passed to UniformMatrix4fv.
+type Mat4 [16]float32
+
+// Mul returns the product m * v.
+func (m Mat4) Mul(v Vec4) Vec4 {
+	var p Vec4
+	for i := 0; i < 4; i++ {
+		p[i] = m[i]*v[0] + m[4+i]*v[1] + m[8+i]*v[2] + m[12+i]*v[3]
+	}
+	return p
+}
+
+// VertexShader is the Go implementation of a GLSL vertex shader. It is
+// called with the attributes of one vertex, in the order they are declared
+// in the shader source, and returns gl_Position and the varyings to
+// interpolate. Every call for a draw must return the same number of
+// varyings.
+type VertexShader func(attribs []Vec4, u *Uniforms) (position Vec4, varyings []float32)
+
+// FragmentShader is the Go implementation of a GLSL fragment shader. It
+// returns gl_FragColor, or false to discard the fragment. The Fragment
+// passed to it is only valid during the call.
+type FragmentShader func(in *Fragment, u *Uniforms) (color Vec4, ok bool)
+
+// Fragment is the input of a FragmentShader.
+type Fragment struct {
+	// Varyings are the interpolated varyings returned by the vertex shader.
+	Varyings []float32
+
+	// FragCoord is gl_FragCoord: the window coordinates of the pixel
+	// center, its depth, and 1/w.
+	FragCoord Vec4
+
+	// FrontFacing is gl_FrontFacing.
+	FrontFacing bool
+}
+
+// DefineVertexShader sets the Go implementation used for vertex shaders
+// with the given GLSL source. It must be called before the source is
+// compiled.
+func (r *Renderer) DefineVertexShader(source string, main VertexShader) {
+	r.vertexSources[source] = main
+}
+
+// DefineFragmentShader sets the Go implementation used for fragment shaders
+// with the given GLSL source. It must be called before the source is
+// compiled.
+func (r *Renderer) DefineFragmentShader(source string, main FragmentShader) {
+	r.fragmentSources[source] = main
+}
+
+type shader struct {
+	id       uint32
+	typ      webgl.GLenum
+	source   string
+	compiled bool
+	deleted  bool
+	log      string
+	decls    declarations
+	vertex   VertexShader
+	fragment FragmentShader
+}
+
+type program struct {
+	shaders []*shader
+	deleted bool
+	linked  bool
+	valid   bool
+	log     string
+
+	// bindings are the locations set with BindAttribLocation, applied at
+	// the next link.
+	bindings map[string]int
+
+	// The state below is set by a successful link.
+	vertex    VertexShader
+	fragment  FragmentShader
+	attribs   []string
+	locations []int // location of each of attribs
+	uniforms  map[string]bool
+	active    activeInfo
+	values    map[string][]float32
+}
+
+type uniformLocation struct {
+	program *program
+	name    string
+}
+
+// attrib is the state of a generic vertex attribute.
+type attrib struct {
+	enabled    bool
+	buffer     *buffer
+	size       int
+	typ        webgl.GLenum
+	normalized bool
+	stride     int
+	offset     int
+	current    Vec4
+}
+
+// declarations are the attributes and uniforms declared in a shader source.
+type declarations struct {
+	attribs  []string
+	uniforms []string
+	active   activeInfo
+}
+
+// activeInfo describes the declared attributes and uniforms as
+// GetActiveAttribInfo and GetActiveUniformInfo report them.
+type activeInfo struct {
+	attribs  []webgl.ActiveInfo
+	uniforms []webgl.ActiveInfo
+}
+
+var (
+	commentRegexp     = regexp.MustCompile(`(?s)//[^\n]*|/\*.*?\*/`)
+	declarationRegexp = regexp.MustCompile(`\b(attribute|uniform)\s+(?:(?:lowp|mediump|highp)\s+)?(\w+)\s+([^;]+);`)
+	arrayRegexp       = regexp.MustCompile(`^(\w+)\s*\[\s*(\d+)\s*\]$`)
+)
+
+// parseDeclarations reads the attribute and uniform declarations of a
+// GLSL ES 1.00 source. Uniform arrays are listed by the names of their
+// elements, name[0] through name[n-1].
+func parseDeclarations(source string) declarations {
+	var d declarations
+	source = commentRegexp.ReplaceAllString(source, " ")
+	for _, m := range declarationRegexp.FindAllStringSubmatch(source, -1) {
+		typ, _ := webgl.ParseGLSLType(m[2])
+		for _, name := range strings.Split(m[3], ",") {
+			name = strings.TrimSpace(name)
+			if m[1] == "attribute" {
+				d.attribs = append(d.attribs, name)
+				d.active.attribs = append(d.active.attribs, webgl.ActiveInfo{Name: name, Size: 1, Type: webgl.GLenum(typ)})
+				continue
+			}
+			if a := arrayRegexp.FindStringSubmatch(name); a != nil {
+				n, _ := strconv.Atoi(a[2])
+				for i := 0; i < n; i++ {
+					d.uniforms = append(d.uniforms, fmt.Sprintf("%s[%d]", a[1], i))
+				}
+				d.active.uniforms = append(d.active.uniforms, webgl.ActiveInfo{Name: a[1] + "[0]", Size: n, Type: webgl.GLenum(typ)})
+				continue
+			}
+			d.uniforms = append(d.uniforms, name)
+			d.active.uniforms = append(d.active.uniforms, webgl.ActiveInfo{Name: name, Size: 1, Type: webgl.GLenum(typ)})
+		}
+	}
+	return d
+}
+
+// declares reports whether the shader declares a uniform whose
+// ActiveInfo is named name.
+func (sh *shader) declares(name string) bool {
+	for _, info := range sh.decls.active.uniforms {
+		if info.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
+func (r *Renderer) CreateShader(typ webgl.GLenum) webgl.Shader {
+	if typ != webgl.VERTEX_SHADER && typ != webgl.FRAGMENT_SHADER {
+		r.setError(webgl.INVALID_ENUM)
+		return webgl.Shader{}
+	}
+	id := r.newID()
+	r.shaders[id] = &shader{id: id, typ: typ}
+	return webgl.ShaderFromID(id)
+}
+
+// shader returns the shader for s, setting an error if there is none.
+func (r *Renderer) shader(s webgl.Shader) *shader {
+	sh := r.shaders[s.ID()]
+	if sh == nil || sh.deleted {
+		r.setError(webgl.INVALID_VALUE)
+		return nil
+	}
+	return sh
+}
+
+func (r *Renderer) IsShader(s webgl.Shader) bool {
+	sh := r.shaders[s.ID()]
+	return sh != nil && !sh.deleted
+}
+
+func (r *Renderer) DeleteShader(s webgl.Shader) {
+	if sh := r.shaders[s.ID()]; sh != nil {
+		sh.deleted = true
+	}
+}
+
+func (r *Renderer) ShaderSource(s webgl.Shader, source string) {
+	if sh := r.shader(s); sh != nil {
+		sh.source = source
+	}
+}
+
+func (r *Renderer) GetShaderSource(s webgl.Shader) string {
+	if sh := r.shader(s); sh != nil {
+		return sh.source
+	}
+	return ""
+}
+
+// CompileShader succeeds if a Go implementation was defined for the
+// shader source.
+func (r *Renderer) CompileShader(s webgl.Shader) {
+	sh := r.shader(s)
+	if sh == nil {
+		return
+	}
+	sh.vertex, sh.fragment = nil, nil
+	if sh.typ == webgl.VERTEX_SHADER {
+		sh.vertex = r.vertexSources[sh.source]
+		sh.compiled = sh.vertex != nil
+	} else {
+		sh.fragment = r.fragmentSources[sh.source]
+		sh.compiled = sh.fragment != nil
+	}
+	sh.log = ""
+	if !sh.compiled {
+		sh.log = "ERROR: 0:0: no Go implementation defined for this shader source"
+		return
+	}
+	sh.decls = parseDeclarations(sh.source)
+}
+
+func (r *Renderer) GetShaderParameterb(s webgl.Shader, pname webgl.GLenum) bool {
+	sh := r.shader(s)
+	if sh == nil {
+		return false
+	}
+	switch pname {
+	case webgl.COMPILE_STATUS:
+		return sh.compiled
+	case webgl.DELETE_STATUS:
+		return sh.deleted
+	}
+	r.setError(webgl.INVALID_ENUM)
+	return false
+}
+
+func (r *Renderer) GetShaderInfoLog(s webgl.Shader) string {
+	if sh := r.shader(s); sh != nil {
+		return sh.log
+	}
+	return ""
+}
+
+func (r *Renderer) CreateProgram() webgl.Program {
+	id := r.newID()
+	r.programs[id] = &program{bindings: make(map[string]int)}
+	return webgl.ProgramFromID(id)
+}
+
+// program returns the program for p, setting an error if there is none.
+func (r *Renderer) program(p webgl.Program) *program {
+	prog := r.programs[p.ID()]
+	if prog == nil || prog.deleted {
+		r.setError(webgl.INVALID_VALUE)
+		return nil
+	}
+	return prog
+}
+
+func (r *Renderer) IsProgram(p webgl.Program) bool {
+	prog := r.programs[p.ID()]
+	return prog != nil && !prog.deleted
+}
+
+func (r *Renderer) DeleteProgram(p webgl.Program) {
+	if prog := r.programs[p.ID()]; prog != nil {
+		prog.deleted = true
+	}
+}
+
+func (r *Renderer) AttachShader(p webgl.Program, s webgl.Shader) {
+	prog, sh := r.program(p), r.shader(s)
+	if prog == nil || sh == nil {
+		return
+	}
+	for _, attached := range prog.shaders {
+		if attached.typ == sh.typ {
+			r.setError(webgl.INVALID_OPERATION)
+			return
+		}
+	}
+	prog.shaders = append(prog.shaders, sh)
+}
+
+func (r *Renderer) DetachShader(p webgl.Program, s webgl.Shader) {
+	prog, sh := r.program(p), r.shader(s)
+	if prog == nil || sh == nil {
+		return
+	}
+	for i, attached := range prog.shaders {
+		if attached == sh {
+			prog.shaders = append(prog.shaders[:i:i], prog.shaders[i+1:]...)
+			return
+		}
+	}
+	r.setError(webgl.INVALID_OPERATION)
+}
+
+func (r *Renderer) GetActiveAttribInfo(p webgl.Program, index int) webgl.ActiveInfo {
+	prog := r.program(p)
+	if prog == nil {
+		return webgl.ActiveInfo{}
+	}
+	if index < 0 || index >= len(prog.active.attribs) {
+		r.setError(webgl.INVALID_VALUE)
+		return webgl.ActiveInfo{}
+	}
+	return prog.active.attribs[index]
+}
+
+func (r *Renderer) GetActiveUniformInfo(p webgl.Program, index int) webgl.ActiveInfo {
+	prog := r.program(p)
+	if prog == nil {
+		return webgl.ActiveInfo{}
+	}
+	if index < 0 || index >= len(prog.active.uniforms) {
+		r.setError(webgl.INVALID_VALUE)
+		return webgl.ActiveInfo{}
+	}
+	return prog.active.uniforms[index]
+}
+
+func (r *Renderer) GetAttachedShaders(p webgl.Program) []webgl.Shader {
+	prog := r.program(p)
+	if prog == nil {
+		return nil
+	}
+	shaders := make([]webgl.Shader, len(prog.shaders))
+	for i, sh := range prog.shaders {
+		shaders[i] = webgl.ShaderFromID(sh.id)
+	}
+	return shaders
+}
+
+func (r *Renderer) BindAttribLocation(p webgl.Program, index int, name string) {
+	prog := r.program(p)
+	if prog == nil {
+		return
+	}
+	if index < 0 || index >= MaxVertexAttribs {
+		r.setError(webgl.INVALID_VALUE)
+		return
+	}
+	if strings.HasPrefix(name, "gl_") || strings.HasPrefix(name, "webgl_") {
+		r.setError(webgl.INVALID_OPERATION)
+		return
+	}
+	prog.bindings[name] = index
+}
+
+func (r *Renderer) LinkProgram(p webgl.Program) {
+	prog := r.program(p)
+	if prog == nil {
+		return
+	}
+	prog.linked, prog.valid = false, false
+	var vs, fs *shader
+	for _, sh := range prog.shaders {
+		if sh.typ == webgl.VERTEX_SHADER {
+			vs = sh
+		} else {
+			fs = sh
+		}
+	}
+	switch {
+	case vs == nil || fs == nil:
+		prog.log = "ERROR: a vertex and a fragment shader must be attached"
+		return
+	case !vs.compiled || !fs.compiled:
+		prog.log = "ERROR: attached shaders must be compiled"
+		return
+	}
+
+	// Assign locations, bound ones first, then the lowest free ones in
+	// declaration order.
+	used := make(map[int]bool)
+	locations := make([]int, len(vs.decls.attribs))
+	for i, name := range vs.decls.attribs {
+		locations[i] = -1
+		if loc, ok := prog.bindings[name]; ok {
+			if used[loc] {
+				prog.log = fmt.Sprintf("ERROR: attributes aliased at location %d", loc)
+				return
+			}
+			locations[i] = loc
+			used[loc] = true
+		}
+	}
+	next := 0
+	for i := range locations {
+		if locations[i] >= 0 {
+			continue
+		}
+		for used[next] {
+			next++
+		}
+		if next >= MaxVertexAttribs {
+			prog.log = "ERROR: too many attributes"
+			return
+		}
+		locations[i] = next
+		used[next] = true
+	}
+
+	prog.vertex, prog.fragment = vs.vertex, fs.fragment
+	prog.attribs, prog.locations = vs.decls.attribs, locations
+	prog.uniforms = make(map[string]bool)
+	prog.active = activeInfo{attribs: vs.decls.active.attribs}
+	for _, sh := range []*shader{vs, fs} {
+		for _, name := range sh.decls.uniforms {
+			prog.uniforms[name] = true
+		}
+		// Uniforms declared in both shaders are one uniform.
+		for _, info := range sh.decls.active.uniforms {
+			if sh == fs && vs.declares(info.Name) {
+				continue
+			}
+			prog.active.uniforms = append(prog.active.uniforms, info)
+		}
+	}
+	prog.values = make(map[string][]float32)
+	prog.linked, prog.log = true, ""
+}
+
+func (r *Renderer) ValidateProgram(p webgl.Program) {
+	prog := r.program(p)
+	if prog == nil {
+		return
+	}
+	prog.valid = prog.linked
+	prog.log = ""
+	if !prog.valid {
+		prog.log = "ERROR: program is not linked"
+	}
+}
+
+func (r *Renderer) GetProgramParameterb(p webgl.Program, pname webgl.GLenum) bool {
+	prog := r.program(p)
+	if prog == nil {
+		return false
+	}
+	switch pname {
+	case webgl.LINK_STATUS:
+		return prog.linked
+	case webgl.VALIDATE_STATUS:
+		return prog.valid
+	case webgl.DELETE_STATUS:
+		return prog.deleted
+	}
+	r.setError(webgl.INVALID_ENUM)
+	return false
+}
+
+func (r *Renderer) GetProgramParameteri(p webgl.Program, pname webgl.GLenum) int {
+	prog := r.program(p)
+	if prog == nil {
+		return 0
+	}
+	switch pname {
+	case webgl.ATTACHED_SHADERS:
+		return len(prog.shaders)
+	case webgl.ACTIVE_ATTRIBUTES:
+		return len(prog.attribs)
+	case webgl.ACTIVE_UNIFORMS:
+		return len(prog.active.uniforms)
+	}
+	r.setError(webgl.INVALID_ENUM)
+	return 0
+}
+
+func (r *Renderer) GetProgramInfoLog(p webgl.Program) string {
+	if prog := r.program(p); prog != nil {
+		return prog.log
+	}
+	return ""
+}
+
+func (r *Renderer) UseProgram(p webgl.Program) {
+	if p.ID() == 0 {
+		r.currentProgram = nil
+		return
+	}
+	prog := r.program(p)
+	if prog == nil {
+		return
+	}
+	if !prog.linked {
+		r.setError(webgl.INVALID_OPERATION)
+		return
+	}
+	r.currentProgram = prog
+}
+
+func (r *Renderer) GetAttribLocation(p webgl.Program, name string) int {
+	prog := r.program(p)
+	if prog == nil {
+		return -1
+	}
+	if !prog.linked {
+		r.setError(webgl.INVALID_OPERATION)
+		return -1
+	}
+	for i, attrib := range prog.attribs {
+		if attrib == name {
+			return prog.locations[i]
+		}
+	}
+	return -1
+}
+
+// GetUniformLocation returns the zero UniformLocation for names not
+// declared in the program's shaders. An array uniform can be located by
+// its name or the name of any element.
+func (r *Renderer) GetUniformLocation(p webgl.Program, name string) webgl.UniformLocation {
+	prog := r.program(p)
+	if prog == nil {
+		return webgl.UniformLocation{}
+	}
+	if !prog.linked {
+		r.setError(webgl.INVALID_OPERATION)
+		return webgl.UniformLocation{}
+	}
+	if !prog.uniforms[name] {
+		if !prog.uniforms[name+"[0]"] {
+			return webgl.UniformLocation{}
+		}
+		name += "[0]"
+	}
+	for id, loc := range r.locations {
+		if loc.program == prog && loc.name == name {
+			return webgl.UniformLocationFromID(id)
+		}
+	}
+	id := r.newID()
+	r.locations[id] = uniformLocation{prog, name}
+	return webgl.UniformLocationFromID(id)
+}
+
+// location returns the uniform location behind a handle, setting an error
+// if it does not belong to the current program. The zero location is
+// silently ignored.
+func (r *Renderer) location(location webgl.UniformLocation) (uniformLocation, bool) {
+	if location.ID() == 0 {
+		return uniformLocation{}, false
+	}
+	loc, ok := r.locations[location.ID()]
+	if !ok || r.currentProgram == nil || loc.program != r.currentProgram {
+		r.setError(webgl.INVALID_OPERATION)
+		return uniformLocation{}, false
+	}
+	return loc, true
+}
+
+// uniform stores values for location.
+func (r *Renderer) uniform(location webgl.UniformLocation, values ...float32) {
+	if loc, ok := r.location(location); ok {
+		loc.program.values[loc.name] = values
+	}
+}
+
+// uniformv stores values, made of consecutive values of n components, for
+// location and the array elements after it. Values past the end of the
+// array are ignored.
+func (r *Renderer) uniformv(location webgl.UniformLocation, values []float32, n int) {
+	if len(values) == 0 || len(values)%n != 0 {
+		r.setError(webgl.INVALID_VALUE)
+		return
+	}
+	loc, ok := r.location(location)
+	if !ok {
+		return
+	}
+	base, index := loc.name, -1
+	if i := strings.LastIndex(base, "["); i >= 0 && strings.HasSuffix(base, "]") {
+		index, _ = strconv.Atoi(base[i+1 : len(base)-1])
+		base = base[:i]
+	}
+	if index < 0 && len(values) > n {
+		r.setError(webgl.INVALID_OPERATION)
+		return
+	}
+	for j := 0; j < len(values)/n; j++ {
+		name := loc.name
+		if index >= 0 {
+			name = fmt.Sprintf("%s[%d]", base, index+j)
+		}
+		if !loc.program.uniforms[name] {
+			break
+		}
+		loc.program.values[name] = append([]float32(nil), values[j*n:(j+1)*n]...)
+	}
+}
+
+func (r *Renderer) Uniform1f(location webgl.UniformLocation, x float32) {
+	r.uniform(location, x)
+}
+
+func (r *Renderer) Uniform2f(location webgl.UniformLocation, x, y float32) {
+	r.uniform(location, x, y)
+}
+
+func (r *Renderer) Uniform3f(location webgl.UniformLocation, x, y, z float32) {
+	r.uniform(location, x, y, z)
+}
+
+func (r *Renderer) Uniform4f(location webgl.UniformLocation, x, y, z, w float32) {
+	r.uniform(location, x, y, z, w)
+}
+
+func (r *Renderer) Uniform1i(location webgl.UniformLocation, x int) {
+	r.uniform(location, float32(x))
+}
+
+func (r *Renderer) Uniform2i(location webgl.UniformLocation, x, y int) {
+	r.uniform(location, float32(x), float32(y))
+}
+
+func (r *Renderer) Uniform3i(location webgl.UniformLocation, x, y, z int) {
+	r.uniform(location, float32(x), float32(y), float32(z))
+}
+
+func (r *Renderer) Uniform4i(location webgl.UniformLocation, x, y, z, w int) {
+	r.uniform(location, float32(x), float32(y), float32(z), float32(w))
+}
+
+func (r *Renderer) Uniform1fv(location webgl.UniformLocation, v []float32) {
+	r.uniformv(location, v, 1)
+}
+
+func (r *Renderer) Uniform2fv(location webgl.UniformLocation, v []float32) {
+	r.uniformv(location, v, 2)
+}
+
+func (r *Renderer) Uniform3fv(location webgl.UniformLocation, v []float32) {
+	r.uniformv(location, v, 3)
+}
+
+func (r *Renderer) Uniform4fv(location webgl.UniformLocation, v []float32) {
+	r.uniformv(location, v, 4)
+}
+
+func (r *Renderer) Uniform1iv(location webgl.UniformLocation, v []int32) {
+	r.uniformv(location, int32sToFloats(v), 1)
+}
+
+func (r *Renderer) Uniform2iv(location webgl.UniformLocation, v []int32) {
+	r.uniformv(location, int32sToFloats(v), 2)
+}
+
+func (r *Renderer) Uniform3iv(location webgl.UniformLocation, v []int32) {
+	r.uniformv(location, int32sToFloats(v), 3)
+}
+
+func (r *Renderer) Uniform4iv(location webgl.UniformLocation, v []int32) {
+	r.uniformv(location, int32sToFloats(v), 4)
+}
+
+func int32sToFloats(v []int32) []float32 {
+	f := make([]float32, len(v))
+	for i, x := range v {
+		f[i] = float32(x)
+	}
+	return f
+}
+
+func (r *Renderer) uniformMatrix(location webgl.UniformLocation, transpose bool, value []float32, n int) {
+	if transpose {
+		r.setError(webgl.INVALID_VALUE)
+		return
+	}
+	r.uniformv(location, value, n*n)
+}
+
+func (r *Renderer) UniformMatrix2fv(location webgl.UniformLocation, transpose bool, value []float32) {
+	r.uniformMatrix(location, transpose, value, 2)
+}
+
+func (r *Renderer) UniformMatrix3fv(location webgl.UniformLocation, transpose bool, value []float32) {
+	r.uniformMatrix(location, transpose, value, 3)
+}
+
+func (r *Renderer) UniformMatrix4fv(location webgl.UniformLocation, transpose bool, value []float32) {
+	r.uniformMatrix(location, transpose, value, 4)
+}
+
+func (r *Renderer) EnableVertexAttribArray(index int) {
+	if index < 0 || index >= MaxVertexAttribs {
+		r.setError(webgl.INVALID_VALUE)
+		return
+	}
+	r.attribs[index].enabled = true
+}
+
+func (r *Renderer) DisableVertexAttribArray(index int) {
+	if index < 0 || index >= MaxVertexAttribs {
+		r.setError(webgl.INVALID_VALUE)
+		return
+	}
+	r.attribs[index].enabled = false
+}
+
+// vertexAttrib sets the current value of attribute index, used while its
+// array is disabled.
+func (r *Renderer) vertexAttrib(index int, v Vec4) {
+	if index < 0 || index >= MaxVertexAttribs {
+		r.setError(webgl.INVALID_VALUE)
+		return
+	}
+	r.attribs[index].current = v
+}
+
+func (r *Renderer) VertexAttrib1f(index int, x float32) {
+	r.vertexAttrib(index, Vec4{x, 0, 0, 1})
+}
+
+func (r *Renderer) VertexAttrib2f(index int, x, y float32) {
+	r.vertexAttrib(index, Vec4{x, y, 0, 1})
+}
+
+func (r *Renderer) VertexAttrib3f(index int, x, y, z float32) {
+	r.vertexAttrib(index, Vec4{x, y, z, 1})
+}
+
+func (r *Renderer) VertexAttrib4f(index int, x, y, z, w float32) {
+	r.vertexAttrib(index, Vec4{x, y, z, w})
+}
+
+func (r *Renderer) VertexAttrib1fv(index int, v []float32) {
+	r.vertexAttribv(index, v, 1)
+}
+
+func (r *Renderer) VertexAttrib2fv(index int, v []float32) {
+	r.vertexAttribv(index, v, 2)
+}
+
+func (r *Renderer) VertexAttrib3fv(index int, v []float32) {
+	r.vertexAttribv(index, v, 3)
+}
+
+func (r *Renderer) VertexAttrib4fv(index int, v []float32) {
+	r.vertexAttribv(index, v, 4)
+}
+
+func (r *Renderer) vertexAttribv(index int, v []float32, n int) {
+	if len(v) < n {
+		r.setError(webgl.INVALID_VALUE)
+		return
+	}
+	c := Vec4{0, 0, 0, 1}
+	copy(c[:n], v)
+	r.vertexAttrib(index, c)
+}
+
+func (r *Renderer) VertexAttribPointer(index, size int, typ webgl.GLenum, normal bool, stride int, offset int) {
+	if index < 0 || index >= MaxVertexAttribs || size < 1 || size > 4 || stride < 0 || stride > 255 || offset < 0 {
+		r.setError(webgl.INVALID_VALUE)
+		return
+	}
+	n := typeSize(typ)
+	if n == 0 || typ == webgl.UNSIGNED_INT || typ == webgl.INT {
+		r.setError(webgl.INVALID_ENUM)
+		return
+	}
+	if r.arrayBuffer == nil || offset%n != 0 || stride%n != 0 {
+		r.setError(webgl.INVALID_OPERATION)
+		return
+	}
+	r.attribs[index] = attrib{
+		enabled:    r.attribs[index].enabled,
+		buffer:     r.arrayBuffer,
+		size:       size,
+		typ:        typ,
+		normalized: normal,
+		stride:     stride,
+		offset:     offset,
+		current:    r.attribs[index].current,
+	}
+}
+
+func (r *Renderer) GetVertexAttribOffset(index int, pname webgl.GLenum) int {
+	if index < 0 || index >= MaxVertexAttribs {
+		r.setError(webgl.INVALID_VALUE)
+		return 0
+	}
+	if pname != webgl.VERTEX_ATTRIB_ARRAY_POINTER {
+		r.setError(webgl.INVALID_ENUM)
+		return 0
+	}
+	return r.attribs[index].offset
+}
+
+// typeSize returns the size in bytes of a vertex or index component type,
+// or 0 if typ is not one.
+func typeSize(typ webgl.GLenum) int {
+	switch typ {
+	case webgl.BYTE, webgl.UNSIGNED_BYTE:
+		return 1
+	case webgl.SHORT, webgl.UNSIGNED_SHORT:
+		return 2
+	case webgl.INT, webgl.UNSIGNED_INT, webgl.FLOAT:
+		return 4
+	}
+	return 0
+}
+
+// Uniforms gives shaders the uniform values of the program being drawn.
+// Uniforms that were never set read as zero.
+type Uniforms struct {
+	r    *Renderer
+	prog *program
+}
+
+func (u *Uniforms) get(name string, n int) []float32 {
+	v := u.prog.values[name]
+	if len(v) < n {
+		v = append(v[:len(v):len(v)], make([]float32, n-len(v))...)
+	}
+	return v
+}
+
+// Float returns a float uniform.
+func (u *Uniforms) Float(name string) float32 {
+	return u.get(name, 1)[0]
+}
+
+// Int returns an int, bool, or sampler uniform.
+func (u *Uniforms) Int(name string) int {
+	return int(u.get(name, 1)[0])
+}
+
+// Vec returns a vector uniform of any size; unused components are zero.
+func (u *Uniforms) Vec(name string) Vec4 {
+	var v Vec4
+	copy(v[:], u.get(name, 4))
+	return v
+}
+
+// Mat2 returns a mat2 uniform in column-major order.
+func (u *Uniforms) Mat2(name string) [4]float32 {
+	var m [4]float32
+	copy(m[:], u.get(name, 4))
+	return m
+}
+
+// Mat3 returns a mat3 uniform in column-major order.
+func (u *Uniforms) Mat3(name string) [9]float32 {
+	var m [9]float32
+	copy(m[:], u.get(name, 9))
+	return m
+}
+
+// Mat4 returns a mat4 uniform.
+func (u *Uniforms) Mat4(name string) Mat4 {
+	var m Mat4
+	copy(m[:], u.get(name, 16))
+	return m
+}
@@ -0,0 +1,98 @@
+// Copyright 2014 Joseph Hager. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package soft
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/gopherjs/webgl"
+)
+
+func TestParseDeclarations(t *testing.T) {
+	tests := []struct {
+		name     string
+		source   string
+		attribs  []webgl.ActiveInfo
+		uniforms []webgl.ActiveInfo
+	}{
+		{
+			name:   "none",
+			source: "precision mediump float;\nvoid main() { gl_FragColor = vec4(1.0); }",
+		},
+		{
+			name:    "attribute",
+			source:  "attribute vec3 a_position;",
+			attribs: []webgl.ActiveInfo{{Name: "a_position", Size: 1, Type: webgl.FLOAT_VEC3}},
+		},
+		{
+			name:   "precision qualifiers",
+			source: "attribute highp vec4 a_pos;\nuniform lowp sampler2D u_tex;\nuniform mediump float u_t;",
+			attribs: []webgl.ActiveInfo{
+				{Name: "a_pos", Size: 1, Type: webgl.FLOAT_VEC4},
+			},
+			uniforms: []webgl.ActiveInfo{
+				{Name: "u_tex", Size: 1, Type: webgl.SAMPLER_2D},
+				{Name: "u_t", Size: 1, Type: webgl.FLOAT},
+			},
+		},
+		{
+			name:   "several names",
+			source: "uniform vec2 u_a, u_b ,u_c;",
+			uniforms: []webgl.ActiveInfo{
+				{Name: "u_a", Size: 1, Type: webgl.FLOAT_VEC2},
+				{Name: "u_b", Size: 1, Type: webgl.FLOAT_VEC2},
+				{Name: "u_c", Size: 1, Type: webgl.FLOAT_VEC2},
+			},
+		},
+		{
+			name:   "arrays",
+			source: "uniform mat4 u_bones[ 3 ];\nuniform float u_w, u_ws[2];",
+			uniforms: []webgl.ActiveInfo{
+				{Name: "u_bones[0]", Size: 3, Type: webgl.FLOAT_MAT4},
+				{Name: "u_w", Size: 1, Type: webgl.FLOAT},
+				{Name: "u_ws[0]", Size: 2, Type: webgl.FLOAT},
+			},
+		},
+		{
+			name: "comments",
+			source: "// uniform vec4 u_line;\n" +
+				"/* uniform vec4 u_block;\n attribute vec4 a_block; */\n" +
+				"uniform /* inline */ vec4 u_color; // trailing",
+			uniforms: []webgl.ActiveInfo{
+				{Name: "u_color", Size: 1, Type: webgl.FLOAT_VEC4},
+			},
+		},
+		{
+			name:   "across lines",
+			source: "attribute\n\tvec2\n\ta_uv\n;",
+			attribs: []webgl.ActiveInfo{
+				{Name: "a_uv", Size: 1, Type: webgl.FLOAT_VEC2},
+			},
+		},
+		{
+			name:   "identifiers containing keywords",
+			source: "float my_uniform_scale; vec4 xattribute;\nuniform bool u_on;",
+			uniforms: []webgl.ActiveInfo{
+				{Name: "u_on", Size: 1, Type: webgl.BOOL},
+			},
+		},
+	}
+	for _, test := range tests {
+		d := parseDeclarations(test.source)
+		if !reflect.DeepEqual(d.active.attribs, test.attribs) {
+			t.Errorf("%s: attributes = %+v, want %+v", test.name, d.active.attribs, test.attribs)
+		}
+		if !reflect.DeepEqual(d.active.uniforms, test.uniforms) {
+			t.Errorf("%s: uniforms = %+v, want %+v", test.name, d.active.uniforms, test.uniforms)
+		}
+	}
+
+	// Uniform arrays are located by the names of their elements.
+	d := parseDeclarations("uniform vec4 u_colors[2];")
+	if want := []string{"u_colors[0]", "u_colors[1]"}; !reflect.DeepEqual(d.uniforms, want) {
+		t.Errorf("uniform names = %q, want %q", d.uniforms, want)
+	}
+}
@@ -0,0 +1,879 @@
+// Copyright 2014 Joseph Hager. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package soft
+
+import (
+	"encoding/binary"
+	"image"
+	"math"
+
+	"github.com/gopherjs/webgl"
+)
+
+// depthResolution is the minimum resolvable depth difference used for the
+// units of PolygonOffset, matching a 24-bit depth buffer.
+const depthResolution = 1.0 / (1 << 24)
+
+func (r *Renderer) Clear(flags webgl.GLenum) {
+	if flags&^(webgl.COLOR_BUFFER_BIT|webgl.DEPTH_BUFFER_BIT|webgl.STENCIL_BUFFER_BIT) != 0 {
+		r.setError(webgl.INVALID_VALUE)
+		return
+	}
+	dst, ok := r.drawTarget()
+	if !ok {
+		return
+	}
+	rect := image.Rect(0, 0, dst.width, dst.height)
+	if r.capabilities[webgl.SCISSOR_TEST] {
+		rect = rect.Intersect(r.scissor)
+	}
+	var color [4]byte
+	for i, c := range r.clearColor {
+		color[i] = quantize(c)
+	}
+	for y := rect.Min.Y; y < rect.Max.Y; y++ {
+		for x := rect.Min.X; x < rect.Max.X; x++ {
+			i := y*dst.width + x
+			if flags&webgl.COLOR_BUFFER_BIT != 0 && dst.color != nil {
+				dst.writeColor(i, color, r.colorMask)
+			}
+			if flags&webgl.DEPTH_BUFFER_BIT != 0 && dst.depth != nil && r.depthMask {
+				dst.depth[i] = float32(r.clearDepth)
+			}
+			if flags&webgl.STENCIL_BUFFER_BIT != 0 && dst.stencil != nil {
+				dst.stencil[i] = writeStencil(dst.stencil[i], byte(r.clearStencil), r.stencil[0].WriteMask)
+			}
+		}
+	}
+}
+
+// writeColor stores the color of pixel i through the color mask.
+func (t *planes) writeColor(i int, color [4]byte, mask [4]bool) {
+	p := t.color[4*i : 4*i+4]
+	for c := 0; c < 4; c++ {
+		if mask[c] && !(c == 3 && t.opaque) {
+			p[c] = color[c]
+		}
+	}
+}
+
+func (r *Renderer) DrawArrays(mode webgl.GLenum, first, count int) {
+	if !isDrawMode(mode) {
+		r.setError(webgl.INVALID_ENUM)
+		return
+	}
+	if first < 0 || count < 0 {
+		r.setError(webgl.INVALID_VALUE)
+		return
+	}
+	indices := make([]int, count)
+	for i := range indices {
+		indices[i] = first + i
+	}
+	r.draw(mode, indices)
+}
+
+func (r *Renderer) DrawElements(mode webgl.GLenum, count int, typ webgl.GLenum, offset int) {
+	if !isDrawMode(mode) {
+		r.setError(webgl.INVALID_ENUM)
+		return
+	}
+	n := typeSize(typ)
+	if typ != webgl.UNSIGNED_BYTE && typ != webgl.UNSIGNED_SHORT {
+		r.setError(webgl.INVALID_ENUM)
+		return
+	}
+	if count < 0 || offset < 0 {
+		r.setError(webgl.INVALID_VALUE)
+		return
+	}
+	buf := r.elementBuffer
+	if buf == nil || offset%n != 0 || offset+count*n > len(buf.data) {
+		r.setError(webgl.INVALID_OPERATION)
+		return
+	}
+	indices := make([]int, count)
+	for i := range indices {
+		if n == 1 {
+			indices[i] = int(buf.data[offset+i])
+		} else {
+			indices[i] = int(binary.LittleEndian.Uint16(buf.data[offset+2*i:]))
+		}
+	}
+	r.draw(mode, indices)
+}
+
+func isDrawMode(mode webgl.GLenum) bool {
+	switch mode {
+	case webgl.POINTS, webgl.LINES, webgl.LINE_STRIP, webgl.LINE_LOOP,
+		webgl.TRIANGLES, webgl.TRIANGLE_STRIP, webgl.TRIANGLE_FAN:
+		return true
+	}
+	return false
+}
+
+// vertex is a shaded vertex in clip coordinates.
+type vertex struct {
+	pos      [4]float64
+	varyings []float32
+}
+
+// windowVertex is a vertex in window coordinates.
+type windowVertex struct {
+	x, y, z  float64
+	invW     float64
+	varyings []float32
+}
+
+// raster holds the state of one draw call.
+type raster struct {
+	r        *Renderer
+	dst      *planes
+	bounds   image.Rectangle
+	uniforms Uniforms
+	fragment FragmentShader
+	frag     Fragment
+	varyings int
+}
+
+func (r *Renderer) draw(mode webgl.GLenum, indices []int) {
+	prog := r.currentProgram
+	if prog == nil {
+		r.setError(webgl.INVALID_OPERATION)
+		return
+	}
+	dst, ok := r.drawTarget()
+	if !ok {
+		return
+	}
+	if dst.stencil != nil && !r.stencilConsistent() {
+		r.setError(webgl.INVALID_OPERATION)
+		return
+	}
+	maxIndex := -1
+	for _, i := range indices {
+		if i > maxIndex {
+			maxIndex = i
+		}
+	}
+	for _, loc := range prog.locations {
+		a := &r.attribs[loc]
+		if !a.enabled {
+			continue
+		}
+		if a.buffer == nil {
+			r.setError(webgl.INVALID_OPERATION)
+			return
+		}
+		if maxIndex >= 0 && a.offset+maxIndex*a.byteStride()+a.size*typeSize(a.typ) > len(a.buffer.data) {
+			r.setError(webgl.INVALID_OPERATION)
+			return
+		}
+	}
+	if len(indices) == 0 {
+		return
+	}
+
+	rs := &raster{
+		r:        r,
+		dst:      dst,
+		uniforms: Uniforms{r: r, prog: prog},
+		fragment: prog.fragment,
+	}
+	rs.bounds = image.Rect(0, 0, dst.width, dst.height).Intersect(r.viewport)
+	if r.capabilities[webgl.SCISSOR_TEST] {
+		rs.bounds = rs.bounds.Intersect(r.scissor)
+	}
+
+	// Shade each distinct vertex once.
+	shaded := make(map[int]*vertex)
+	attribs := make([]Vec4, len(prog.attribs))
+	shade := func(index int) *vertex {
+		if v, ok := shaded[index]; ok {
+			return v
+		}
+		for i, loc := range prog.locations {
+			attribs[i] = r.attribs[loc].fetch(index)
+		}
+		pos, varyings := prog.vertex(attribs, &rs.uniforms)
+		// Copy the varyings, which may alias attribs.
+		v := &vertex{varyings: append([]float32(nil), varyings...)}
+		for i, c := range pos {
+			v.pos[i] = float64(c)
+		}
+		shaded[index] = v
+		rs.varyings = len(varyings)
+		return v
+	}
+	vs := make([]*vertex, len(indices))
+	for i, index := range indices {
+		vs[i] = shade(index)
+	}
+	rs.frag.Varyings = make([]float32, rs.varyings)
+
+	switch mode {
+	case webgl.POINTS:
+		for _, v := range vs {
+			rs.point(v)
+		}
+	case webgl.LINES:
+		for i := 0; i+1 < len(vs); i += 2 {
+			rs.line(vs[i], vs[i+1])
+		}
+	case webgl.LINE_STRIP, webgl.LINE_LOOP:
+		for i := 0; i+1 < len(vs); i++ {
+			rs.line(vs[i], vs[i+1])
+		}
+		if mode == webgl.LINE_LOOP && len(vs) > 2 {
+			rs.line(vs[len(vs)-1], vs[0])
+		}
+	case webgl.TRIANGLES:
+		for i := 0; i+2 < len(vs); i += 3 {
+			rs.triangle(vs[i], vs[i+1], vs[i+2])
+		}
+	case webgl.TRIANGLE_STRIP:
+		for i := 0; i+2 < len(vs); i++ {
+			if i%2 == 0 {
+				rs.triangle(vs[i], vs[i+1], vs[i+2])
+			} else {
+				rs.triangle(vs[i+1], vs[i], vs[i+2])
+			}
+		}
+	case webgl.TRIANGLE_FAN:
+		for i := 1; i+1 < len(vs); i++ {
+			rs.triangle(vs[0], vs[i], vs[i+1])
+		}
+	}
+}
+
+func (a *attrib) byteStride() int {
+	if a.stride != 0 {
+		return a.stride
+	}
+	return a.size * typeSize(a.typ)
+}
+
+// fetch returns the value of the attribute for vertex index.
+func (a *attrib) fetch(index int) Vec4 {
+	if !a.enabled {
+		return a.current
+	}
+	v := Vec4{0, 0, 0, 1}
+	n := typeSize(a.typ)
+	data := a.buffer.data[a.offset+index*a.byteStride():]
+	for i := 0; i < a.size; i++ {
+		b := data[i*n:]
+		var f float32
+		switch a.typ {
+		case webgl.BYTE:
+			f = normalize(float32(int8(b[0])), 8, true, a.normalized)
+		case webgl.UNSIGNED_BYTE:
+			f = normalize(float32(b[0]), 8, false, a.normalized)
+		case webgl.SHORT:
+			f = normalize(float32(int16(binary.LittleEndian.Uint16(b))), 16, true, a.normalized)
+		case webgl.UNSIGNED_SHORT:
+			f = normalize(float32(binary.LittleEndian.Uint16(b)), 16, false, a.normalized)
+		case webgl.FLOAT:
+			f = math.Float32frombits(binary.LittleEndian.Uint32(b))
+		}
+		v[i] = f
+	}
+	return v
+}
+
+// normalize maps an integer component with the given number of bits to
+// [0, 1] or [-1, 1] as OpenGL ES 2.0 does, if normalized is set.
+func normalize(c float32, bits uint, signed, normalized bool) float32 {
+	if !normalized {
+		return c
+	}
+	max := float32(uint32(1)<<bits - 1)
+	if signed {
+		return (2*c + 1) / max
+	}
+	return c / max
+}
+
+// toWindow maps a clipped vertex to window coordinates.
+func (rs *raster) toWindow(v *vertex) windowVertex {
+	r := rs.r
+	invW := 1 / v.pos[3]
+	x, y, z := v.pos[0]*invW, v.pos[1]*invW, v.pos[2]*invW
+	vp := r.viewport
+	return windowVertex{
+		x:        (x+1)*float64(vp.Dx())/2 + float64(vp.Min.X),
+		y:        (y+1)*float64(vp.Dy())/2 + float64(vp.Min.Y),
+		z:        z*(r.depthFar-r.depthNear)/2 + (r.depthNear+r.depthFar)/2,
+		invW:     invW,
+		varyings: v.varyings,
+	}
+}
+
+// inside returns the signed distance of v to clip plane p, which is
+// non-negative inside the view volume. Planes 0 through 5 are -w <= x,
+// x <= w, -w <= y, y <= w, -w <= z and z <= w.
+func (v *vertex) inside(p int) float64 {
+	if p%2 == 0 {
+		return v.pos[3] + v.pos[p/2]
+	}
+	return v.pos[3] - v.pos[p/2]
+}
+
+// lerp returns the vertex at t along a to b.
+func lerp(a, b *vertex, t float64) *vertex {
+	v := &vertex{varyings: make([]float32, len(a.varyings))}
+	for i := range v.pos {
+		v.pos[i] = a.pos[i] + t*(b.pos[i]-a.pos[i])
+	}
+	for i := range v.varyings {
+		v.varyings[i] = a.varyings[i] + float32(t)*(b.varyings[i]-a.varyings[i])
+	}
+	return v
+}
+
+func (rs *raster) point(v *vertex) {
+	for p := 0; p < 6; p++ {
+		if v.inside(p) < 0 {
+			return
+		}
+	}
+	if v.pos[3] <= 0 {
+		return
+	}
+	w := rs.toWindow(v)
+	rs.shade(int(math.Floor(w.x)), int(math.Floor(w.y)), w.z, w.invW, true, func(out []float32) {
+		copy(out, w.varyings)
+	})
+}
+
+func (rs *raster) line(a, b *vertex) {
+	// Clip the segment to the view volume.
+	t0, t1 := 0.0, 1.0
+	for p := 0; p < 6; p++ {
+		da, db := a.inside(p), b.inside(p)
+		switch {
+		case da < 0 && db < 0:
+			return
+		case da < 0:
+			t0 = math.Max(t0, da/(da-db))
+		case db < 0:
+			t1 = math.Min(t1, da/(da-db))
+		}
+	}
+	if t0 >= t1 {
+		return
+	}
+	ca, cb := a, b
+	if t0 > 0 {
+		ca = lerp(a, b, t0)
+	}
+	if t1 < 1 {
+		cb = lerp(a, b, t1)
+	}
+	if ca.pos[3] <= 0 || cb.pos[3] <= 0 {
+		return
+	}
+	wa, wb := rs.toWindow(ca), rs.toWindow(cb)
+
+	// Step along the major axis, covering the pixel centers from the first
+	// endpoint up to but not including the last one.
+	dx, dy := wb.x-wa.x, wb.y-wa.y
+	xMajor := math.Abs(dx) >= math.Abs(dy)
+	from, to, d := wa.x, wb.x, dx
+	if !xMajor {
+		from, to, d = wa.y, wb.y, dy
+	}
+	if d == 0 {
+		return
+	}
+	var first, last int
+	if d > 0 {
+		first, last = int(math.Ceil(from-0.5)), int(math.Ceil(to-0.5))-1
+	} else {
+		first, last = int(math.Floor(to-0.5))+1, int(math.Floor(from-0.5))
+	}
+	for i := first; i <= last; i++ {
+		t := (float64(i) + 0.5 - from) / d
+		var px, py int
+		if xMajor {
+			px, py = i, int(math.Floor(wa.y+t*dy))
+		} else {
+			px, py = int(math.Floor(wa.x+t*dx)), i
+		}
+		z := wa.z + t*(wb.z-wa.z)
+		// Interpolate varyings in clip space for perspective correctness.
+		qa, qb := (1-t)*wa.invW, t*wb.invW
+		q := qa + qb
+		rs.shade(px, py, z, q, true, func(out []float32) {
+			for k := range out {
+				out[k] = float32((qa*float64(wa.varyings[k]) + qb*float64(wb.varyings[k])) / q)
+			}
+		})
+	}
+}
+
+func (rs *raster) triangle(a, b, c *vertex) {
+	// Clip the triangle to the view volume, one plane at a time.
+	poly := []*vertex{a, b, c}
+	for p := 0; p < 6 && len(poly) > 0; p++ {
+		var out []*vertex
+		for i, v := range poly {
+			u := poly[(i+1)%len(poly)]
+			dv, du := v.inside(p), u.inside(p)
+			if dv >= 0 {
+				out = append(out, v)
+			}
+			if dv >= 0 != (du >= 0) {
+				out = append(out, lerp(v, u, dv/(dv-du)))
+			}
+		}
+		poly = out
+	}
+	if len(poly) < 3 {
+		return
+	}
+	ws := make([]windowVertex, len(poly))
+	for i, v := range poly {
+		if v.pos[3] <= 0 {
+			return
+		}
+		ws[i] = rs.toWindow(v)
+	}
+
+	// Decide facing from the signed area of the whole polygon.
+	var area float64
+	for i := range ws {
+		j := (i + 1) % len(ws)
+		area += ws[i].x*ws[j].y - ws[j].x*ws[i].y
+	}
+	if area == 0 {
+		return
+	}
+	front := area > 0 == (rs.r.frontFace == webgl.CCW)
+	if rs.r.capabilities[webgl.CULL_FACE] {
+		switch rs.r.cullFace {
+		case webgl.FRONT_AND_BACK:
+			return
+		case webgl.FRONT:
+			if front {
+				return
+			}
+		case webgl.BACK:
+			if !front {
+				return
+			}
+		}
+	}
+	for i := 1; i+1 < len(ws); i++ {
+		rs.fill(&ws[0], &ws[i], &ws[i+1], front)
+	}
+}
+
+// edge returns twice the signed area of the triangle a, b, (x, y), which
+// is positive when (x, y) is to the left of a to b.
+func edge(a, b *windowVertex, x, y float64) float64 {
+	return (b.x-a.x)*(y-a.y) - (b.y-a.y)*(x-a.x)
+}
+
+// owns reports whether pixel centers exactly on the edge a to b belong to
+// the triangle, so that pixels on an edge shared by two triangles are
+// drawn once.
+func owns(a, b *windowVertex) bool {
+	dx, dy := b.x-a.x, b.y-a.y
+	return dy > 0 || dy == 0 && dx < 0
+}
+
+// fill rasterizes a triangle in window coordinates.
+func (rs *raster) fill(v0, v1, v2 *windowVertex, front bool) {
+	area := edge(v0, v1, v2.x, v2.y)
+	if area == 0 {
+		return
+	}
+	if area < 0 {
+		v1, v2 = v2, v1
+		area = -area
+	}
+
+	var offset float64
+	if rs.r.capabilities[webgl.POLYGON_OFFSET_FILL] {
+		dzdx := ((v1.z-v0.z)*(v2.y-v0.y) - (v2.z-v0.z)*(v1.y-v0.y)) / area
+		dzdy := ((v2.z-v0.z)*(v1.x-v0.x) - (v1.z-v0.z)*(v2.x-v0.x)) / area
+		offset = rs.r.polygonOffset[0]*math.Max(math.Abs(dzdx), math.Abs(dzdy)) + rs.r.polygonOffset[1]*depthResolution
+	}
+
+	minX := math.Min(v0.x, math.Min(v1.x, v2.x))
+	maxX := math.Max(v0.x, math.Max(v1.x, v2.x))
+	minY := math.Min(v0.y, math.Min(v1.y, v2.y))
+	maxY := math.Max(v0.y, math.Max(v1.y, v2.y))
+	box := image.Rect(int(math.Floor(minX)), int(math.Floor(minY)), int(math.Ceil(maxX)), int(math.Ceil(maxY)))
+	box = box.Intersect(rs.bounds)
+
+	own0, own1, own2 := owns(v1, v2), owns(v2, v0), owns(v0, v1)
+	for py := box.Min.Y; py < box.Max.Y; py++ {
+		y := float64(py) + 0.5
+		for px := box.Min.X; px < box.Max.X; px++ {
+			x := float64(px) + 0.5
+			e0, e1, e2 := edge(v1, v2, x, y), edge(v2, v0, x, y), edge(v0, v1, x, y)
+			if e0 < 0 || e1 < 0 || e2 < 0 || e0 == 0 && !own0 || e1 == 0 && !own1 || e2 == 0 && !own2 {
+				continue
+			}
+			l0, l1, l2 := e0/area, e1/area, e2/area
+			z := l0*v0.z + l1*v1.z + l2*v2.z + offset
+			q0, q1, q2 := l0*v0.invW, l1*v1.invW, l2*v2.invW
+			q := q0 + q1 + q2
+			rs.shade(px, py, z, q, front, func(out []float32) {
+				for k := range out {
+					out[k] = float32((q0*float64(v0.varyings[k]) + q1*float64(v1.varyings[k]) + q2*float64(v2.varyings[k])) / q)
+				}
+			})
+		}
+	}
+}
+
+// shade runs the per-fragment operations for pixel (x, y). interpolate
+// fills in the varyings if the fragment survives the early tests.
+func (rs *raster) shade(x, y int, z, invW float64, front bool, interpolate func(out []float32)) {
+	if !image.Pt(x, y).In(rs.bounds) {
+		return
+	}
+	r, dst := rs.r, rs.dst
+	i := y*dst.width + x
+	depth := float32(clamp64(z))
+	stencil := r.capabilities[webgl.STENCIL_TEST] && dst.stencil != nil
+	depthPass := !r.capabilities[webgl.DEPTH_TEST] || dst.depth == nil || compare(r.depthFunc, depth, dst.depth[i])
+
+	// The fragment shader has no side effects on the framebuffer, so it
+	// can be skipped if the depth test fails and there are no stencil
+	// operations to run.
+	if !depthPass && !stencil {
+		return
+	}
+
+	interpolate(rs.frag.Varyings)
+	rs.frag.FragCoord = Vec4{float32(x) + 0.5, float32(y) + 0.5, depth, float32(invW)}
+	rs.frag.FrontFacing = front
+	color, ok := rs.fragment(&rs.frag, &rs.uniforms)
+	if !ok {
+		return
+	}
+
+	if stencil {
+		s := &r.stencil[0]
+		if !front {
+			s = &r.stencil[1]
+		}
+		pass := stencilTest(s, dst.stencil[i])
+		op := s.ZPass
+		if !pass {
+			op = s.Fail
+		} else if !depthPass {
+			op = s.ZFail
+		}
+		dst.stencil[i] = writeStencil(dst.stencil[i], stencilOp(op, s, dst.stencil[i]), s.WriteMask)
+		if !pass {
+			return
+		}
+	}
+	if !depthPass {
+		return
+	}
+	if r.capabilities[webgl.DEPTH_TEST] && dst.depth != nil && r.depthMask {
+		dst.depth[i] = depth
+	}
+	if dst.color == nil {
+		return
+	}
+	for c := range color {
+		color[c] = clamp(color[c])
+	}
+	if r.capabilities[webgl.BLEND] {
+		color = r.blend(color, dst.pixel(i))
+	}
+	var out [4]byte
+	for c := range color {
+		out[c] = quantize(color[c])
+	}
+	dst.writeColor(i, out, r.colorMask)
+}
+
+// stencilRef returns the reference value of s clamped to the 8-bit
+// stencil buffer.
+func stencilRef(s *webgl.StencilState) byte {
+	switch {
+	case s.Ref < 0:
+		return 0
+	case s.Ref > 0xff:
+		return 0xff
+	}
+	return byte(s.Ref)
+}
+
+func stencilTest(s *webgl.StencilState, stored byte) bool {
+	mask := byte(s.ValueMask)
+	return compare(s.Func, float32(stencilRef(s)&mask), float32(stored&mask))
+}
+
+// stencilOp returns the stencil value that op makes of v.
+func stencilOp(op webgl.GLenum, s *webgl.StencilState, v byte) byte {
+	switch op {
+	case webgl.ZERO:
+		return 0
+	case webgl.REPLACE:
+		return stencilRef(s)
+	case webgl.INCR:
+		if v < 0xff {
+			return v + 1
+		}
+	case webgl.DECR:
+		if v > 0 {
+			return v - 1
+		}
+	case webgl.INVERT:
+		return ^v
+	case webgl.INCR_WRAP:
+		return v + 1
+	case webgl.DECR_WRAP:
+		return v - 1
+	}
+	return v
+}
+
+// writeStencil returns old with the bits of mask replaced by those of v.
+func writeStencil(old, v byte, mask uint32) byte {
+	m := byte(mask)
+	return old&^m | v&m
+}
+
+// stencilConsistent reports whether the front and back faces use the same
+// reference value and masks, which WebGL requires for drawing.
+func (r *Renderer) stencilConsistent() bool {
+	f, b := &r.stencil[0], &r.stencil[1]
+	return stencilRef(f) == stencilRef(b) &&
+		byte(f.ValueMask) == byte(b.ValueMask) && byte(f.WriteMask) == byte(b.WriteMask)
+}
+
+// pixel returns the color of pixel i.
+func (t *planes) pixel(i int) Vec4 {
+	p := t.color[4*i : 4*i+4]
+	return Vec4{float32(p[0]) / 255, float32(p[1]) / 255, float32(p[2]) / 255, float32(p[3]) / 255}
+}
+
+func compare(fun webgl.GLenum, a, b float32) bool {
+	switch fun {
+	case webgl.NEVER:
+		return false
+	case webgl.LESS:
+		return a < b
+	case webgl.EQUAL:
+		return a == b
+	case webgl.LEQUAL:
+		return a <= b
+	case webgl.GREATER:
+		return a > b
+	case webgl.NOTEQUAL:
+		return a != b
+	case webgl.GEQUAL:
+		return a >= b
+	}
+	return true
+}
+
+func (r *Renderer) blend(src, dst Vec4) Vec4 {
+	var out Vec4
+	for c := 0; c < 4; c++ {
+		sf, df := r.blendSrcRGB, r.blendDstRGB
+		eq := r.blendEquationRGB
+		if c == 3 {
+			sf, df = r.blendSrcAlpha, r.blendDstAlpha
+			eq = r.blendEquationAlpha
+		}
+		s := src[c] * r.blendFactor(sf, c, src, dst)
+		d := dst[c] * r.blendFactor(df, c, src, dst)
+		switch eq {
+		case webgl.FUNC_ADD:
+			out[c] = s + d
+		case webgl.FUNC_SUBTRACT:
+			out[c] = s - d
+		case webgl.FUNC_REVERSE_SUBTRACT:
+			out[c] = d - s
+		}
+		out[c] = clamp(out[c])
+	}
+	return out
+}
+
+// blendFactor returns the factor f for channel c.
+func (r *Renderer) blendFactor(f webgl.GLenum, c int, src, dst Vec4) float32 {
+	switch f {
+	case webgl.ZERO:
+		return 0
+	case webgl.ONE:
+		return 1
+	case webgl.SRC_COLOR:
+		return src[c]
+	case webgl.ONE_MINUS_SRC_COLOR:
+		return 1 - src[c]
+	case webgl.DST_COLOR:
+		return dst[c]
+	case webgl.ONE_MINUS_DST_COLOR:
+		return 1 - dst[c]
+	case webgl.SRC_ALPHA:
+		return src[3]
+	case webgl.ONE_MINUS_SRC_ALPHA:
+		return 1 - src[3]
+	case webgl.DST_ALPHA:
+		return dst[3]
+	case webgl.ONE_MINUS_DST_ALPHA:
+		return 1 - dst[3]
+	case webgl.CONSTANT_COLOR:
+		return r.blendColor[c]
+	case webgl.ONE_MINUS_CONSTANT_COLOR:
+		return 1 - r.blendColor[c]
+	case webgl.CONSTANT_ALPHA:
+		return r.blendColor[3]
+	case webgl.ONE_MINUS_CONSTANT_ALPHA:
+		return 1 - r.blendColor[3]
+	case webgl.SRC_ALPHA_SATURATE:
+		if c == 3 {
+			return 1
+		}
+		return float32(math.Min(float64(src[3]), float64(1-dst[3])))
+	}
+	return 0
+}
+
+// quantize converts a color component in [0, 1] to 8 bits.
+func quantize(c float32) byte {
+	return byte(clamp(c)*255 + 0.5)
+}
+
+// ReadPixelsBytes reads a rectangle of the bound framebuffer into pixels,
+// bottom row first, with rows padded to PACK_ALIGNMENT. Only the RGBA,
+// UNSIGNED_BYTE combination is supported. Pixels outside the framebuffer
+// are left unchanged.
+func (r *Renderer) ReadPixelsBytes(x, y, width, height int, format, typ webgl.GLenum, pixels []byte) {
+	if width < 0 || height < 0 {
+		r.setError(webgl.INVALID_VALUE)
+		return
+	}
+	if format != webgl.RGBA || typ != webgl.UNSIGNED_BYTE {
+		r.setError(webgl.INVALID_OPERATION)
+		return
+	}
+	src, ok := r.drawTarget()
+	if !ok {
+		return
+	}
+	if src.color == nil {
+		r.setError(webgl.INVALID_OPERATION)
+		return
+	}
+	stride := (4*width + r.packAlignment - 1) / r.packAlignment * r.packAlignment
+	if height > 0 && len(pixels) < stride*(height-1)+4*width {
+		r.setError(webgl.INVALID_OPERATION)
+		return
+	}
+	for j := 0; j < height; j++ {
+		sy := y + j
+		if sy < 0 || sy >= src.height {
+			continue
+		}
+		for i := 0; i < width; i++ {
+			sx := x + i
+			if sx < 0 || sx >= src.width {
+				continue
+			}
+			copy(pixels[j*stride+4*i:j*stride+4*i+4], src.color[4*(sy*src.width+sx):])
+		}
+	}
+}
+
+// ReadPixelsFloat32 always fails with INVALID_OPERATION, since the
+// Renderer has no floating-point color buffers.
+func (r *Renderer) ReadPixelsFloat32(x, y, width, height int, format, typ webgl.GLenum, pixels []float32) {
+	r.setError(webgl.INVALID_OPERATION)
+}
+
+// Image returns a copy of the color buffer of the bound framebuffer, with
+// the top row first as image.RGBA expects. It returns nil if the
+// framebuffer is incomplete or has no color buffer.
+func (r *Renderer) Image() *image.RGBA {
+	src, ok := r.drawTarget()
+	if !ok || src.color == nil {
+		return nil
+	}
+	img := image.NewRGBA(image.Rect(0, 0, src.width, src.height))
+	row := 4 * src.width
+	for y := 0; y < src.height; y++ {
+		copy(img.Pix[y*img.Stride:y*img.Stride+row], src.color[(src.height-1-y)*row:])
+	}
+	return img
+}
+
+// Texture2D samples the 2D texture bound to the texture unit named by a
+// sampler2D uniform, as texture2D does in GLSL. Without derivatives the
+// level of detail is unknown, so the base level is always sampled with
+// TEXTURE_MAG_FILTER. Incomplete textures sample as opaque black.
+func (u *Uniforms) Texture2D(sampler string, s, t float32) Vec4 {
+	unit := u.Int(sampler)
+	if unit < 0 || unit >= MaxTextureImageUnits {
+		return Vec4{0, 0, 0, 1}
+	}
+	tex := u.r.textureUnits[unit].texture2D
+	if tex == nil || !tex.complete() {
+		return Vec4{0, 0, 0, 1}
+	}
+	img := tex.images[webgl.TEXTURE_2D]
+	if tex.magFilter == webgl.NEAREST {
+		i := wrap(int(math.Floor(float64(s)*float64(img.width))), img.width, tex.wrapS)
+		j := wrap(int(math.Floor(float64(t)*float64(img.height))), img.height, tex.wrapT)
+		return img.texel(i, j)
+	}
+	fu := float64(s)*float64(img.width) - 0.5
+	fv := float64(t)*float64(img.height) - 0.5
+	i0, j0 := int(math.Floor(fu)), int(math.Floor(fv))
+	a, b := float32(fu-math.Floor(fu)), float32(fv-math.Floor(fv))
+	i1, j1 := wrap(i0+1, img.width, tex.wrapS), wrap(j0+1, img.height, tex.wrapT)
+	i0, j0 = wrap(i0, img.width, tex.wrapS), wrap(j0, img.height, tex.wrapT)
+	t00, t10 := img.texel(i0, j0), img.texel(i1, j0)
+	t01, t11 := img.texel(i0, j1), img.texel(i1, j1)
+	var v Vec4
+	for c := range v {
+		v[c] = (1-a)*(1-b)*t00[c] + a*(1-b)*t10[c] + (1-a)*b*t01[c] + a*b*t11[c]
+	}
+	return v
+}
+
+func (img *textureImage) texel(i, j int) Vec4 {
+	p := img.pixels[4*(j*img.width+i):]
+	return Vec4{float32(p[0]) / 255, float32(p[1]) / 255, float32(p[2]) / 255, float32(p[3]) / 255}
+}
+
+// wrap maps texel coordinate i into [0, size) with the given wrap mode.
+func wrap(i, size int, mode webgl.GLenum) int {
+	switch mode {
+	case webgl.REPEAT:
+		i %= size
+		if i < 0 {
+			i += size
+		}
+	case webgl.MIRRORED_REPEAT:
+		i %= 2 * size
+		if i < 0 {
+			i += 2 * size
+		}
+		if i >= size {
+			i = 2*size - 1 - i
+		}
+	default:
+		if i < 0 {
+			i = 0
+		}
+		if i >= size {
+			i = size - 1
+		}
+	}
+	return i
+}
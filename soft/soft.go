@@ -0,0 +1,572 @@
+// Copyright 2014 Joseph Hager. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package soft is a software implementation of the WebGL 1.0 pipeline. Its
+// Renderer implements webgl.Backend in pure Go, so code written against
+// webgl.Backend can be run and its pixels checked under go test, without a
+// browser or a GPU.
+//
+// GLSL is not interpreted. Instead, each shader source passed to
+// ShaderSource must be given a Go implementation beforehand with
+// DefineVertexShader or DefineFragmentShader; compiling a source without
+// one fails with an info log saying so. Attribute and uniform declarations
+// are still read from the source, so attribute locations and uniform
+// locations behave as they do in a browser.
+//
+// Rendering is deterministic: the same calls always produce the same
+// pixels, which can be read back with ReadPixelsBytes or Image and compared
+// against PNG goldens with webgltest.CompareGoldenPNG. There is no
+// multisampling, dithering, or mipmap level selection. Points are drawn as
+// single pixels, since a VertexShader cannot set gl_PointSize, and lines
+// are one pixel wide whatever the LineWidth.
+package soft
+
+import (
+	"image"
+
+	"github.com/gopherjs/webgl"
+)
+
+// Limits reported by the Renderer.
+const (
+	MaxVertexAttribs     = 16
+	MaxTextureImageUnits = 8
+	MaxTextureSize       = 4096
+	MaxRenderbufferSize  = 4096
+	MaxViewportDimension = 4096
+)
+
+// Renderer is a software WebGL 1.0 context drawing into an in-memory
+// framebuffer.
+type Renderer struct {
+	attrs webgl.ContextAttributes
+	err   webgl.ErrorCode
+
+	// canvas is the default framebuffer.
+	canvas *surface
+
+	vertexSources   map[string]VertexShader
+	fragmentSources map[string]FragmentShader
+
+	nextID        uint32
+	buffers       map[uint32]*buffer
+	textures      map[uint32]*texture
+	renderbuffers map[uint32]*renderbuffer
+	framebuffers  map[uint32]*framebuffer
+	shaders       map[uint32]*shader
+	programs      map[uint32]*program
+	locations     map[uint32]uniformLocation
+
+	arrayBuffer        *buffer
+	elementBuffer      *buffer
+	boundRenderbuffer  *renderbuffer
+	boundFramebuffer   *framebuffer
+	currentProgram     *program
+	activeTexture      int
+	textureUnits       [MaxTextureImageUnits]textureUnit
+	attribs            [MaxVertexAttribs]attrib
+	unpackAlignment    int
+	packAlignment      int
+	unpackFlipY        bool
+	unpackPremultiply  bool
+	capabilities       map[webgl.GLenum]bool
+	viewport           image.Rectangle
+	scissor            image.Rectangle
+	clearColor         [4]float32
+	clearDepth         float64
+	clearStencil       int
+	colorMask          [4]bool
+	depthMask          bool
+	depthFunc          webgl.GLenum
+	depthNear          float64
+	depthFar           float64
+	stencil            [2]webgl.StencilState // front and back faces
+	blendColor         [4]float32
+	blendEquationRGB   webgl.GLenum
+	blendEquationAlpha webgl.GLenum
+	blendSrcRGB        webgl.GLenum
+	blendDstRGB        webgl.GLenum
+	blendSrcAlpha      webgl.GLenum
+	blendDstAlpha      webgl.GLenum
+	cullFace           webgl.GLenum
+	frontFace          webgl.GLenum
+	lineWidth          float64
+	polygonOffset      [2]float64
+	generateMipmapHint webgl.GLenum
+	sampleCoverage     float64
+	sampleCoverageInv  bool
+}
+
+// New returns a Renderer whose default framebuffer is width by height
+// pixels with the buffers requested by attrs. If attrs is nil, the
+// webgl.DefaultAttributes are used. Antialias is reported as false, since
+// the Renderer does not multisample.
+func New(width, height int, attrs *webgl.ContextAttributes) *Renderer {
+	if attrs == nil {
+		attrs = webgl.DefaultAttributes()
+	}
+	r := &Renderer{
+		attrs:           *attrs,
+		vertexSources:   make(map[string]VertexShader),
+		fragmentSources: make(map[string]FragmentShader),
+		buffers:         make(map[uint32]*buffer),
+		textures:        make(map[uint32]*texture),
+		renderbuffers:   make(map[uint32]*renderbuffer),
+		framebuffers:    make(map[uint32]*framebuffer),
+		shaders:         make(map[uint32]*shader),
+		programs:        make(map[uint32]*program),
+		locations:       make(map[uint32]uniformLocation),
+	}
+	r.attrs.Antialias = false
+	r.canvas = newCanvas(width, height, &r.attrs)
+	r.resetState()
+	return r
+}
+
+// resetState sets the context state to its initial values.
+func (r *Renderer) resetState() {
+	r.err = webgl.NO_ERROR
+	r.unpackAlignment = 4
+	r.packAlignment = 4
+	r.capabilities = map[webgl.GLenum]bool{
+		webgl.BLEND:                    false,
+		webgl.CULL_FACE:                false,
+		webgl.DEPTH_TEST:               false,
+		webgl.DITHER:                   true,
+		webgl.POLYGON_OFFSET_FILL:      false,
+		webgl.SAMPLE_ALPHA_TO_COVERAGE: false,
+		webgl.SAMPLE_COVERAGE:          false,
+		webgl.SCISSOR_TEST:             false,
+		webgl.STENCIL_TEST:             false,
+	}
+	r.viewport = image.Rect(0, 0, r.canvas.width, r.canvas.height)
+	r.scissor = r.viewport
+	r.clearDepth = 1
+	r.colorMask = [4]bool{true, true, true, true}
+	r.depthMask = true
+	r.depthFunc = webgl.LESS
+	r.depthFar = 1
+	r.stencil[0] = webgl.DefaultStencilState()
+	r.stencil[1] = webgl.DefaultStencilState()
+	r.blendEquationRGB = webgl.FUNC_ADD
+	r.blendEquationAlpha = webgl.FUNC_ADD
+	r.blendSrcRGB = webgl.ONE
+	r.blendDstRGB = webgl.ZERO
+	r.blendSrcAlpha = webgl.ONE
+	r.blendDstAlpha = webgl.ZERO
+	r.cullFace = webgl.BACK
+	r.frontFace = webgl.CCW
+	r.lineWidth = 1
+	r.generateMipmapHint = webgl.DONT_CARE
+	r.sampleCoverage = 1
+	for i := range r.attribs {
+		r.attribs[i] = attrib{current: Vec4{0, 0, 0, 1}}
+	}
+}
+
+// Size returns the size of the default framebuffer.
+func (r *Renderer) Size() (width, height int) {
+	return r.canvas.width, r.canvas.height
+}
+
+func (r *Renderer) DrawingBufferWidth() int {
+	return r.canvas.width
+}
+
+func (r *Renderer) DrawingBufferHeight() int {
+	return r.canvas.height
+}
+
+// Resize reallocates the default framebuffer with the given size, clearing
+// its contents, as resizing a canvas does. The viewport is left unchanged.
+func (r *Renderer) Resize(width, height int) {
+	r.canvas = newCanvas(width, height, &r.attrs)
+}
+
+// setError records code as the current error unless an earlier error has
+// not been read with GetError yet.
+func (r *Renderer) setError(code webgl.ErrorCode) {
+	if r.err == webgl.NO_ERROR {
+		r.err = code
+	}
+}
+
+func (r *Renderer) GetError() webgl.ErrorCode {
+	err := r.err
+	r.err = webgl.NO_ERROR
+	return err
+}
+
+func (r *Renderer) GetContextAttributes() webgl.ContextAttributes {
+	return r.attrs
+}
+
+func (r *Renderer) GetSupportedExtensions() []string {
+	return []string{}
+}
+
+func (r *Renderer) IsContextLost() bool {
+	return false
+}
+
+func (r *Renderer) Finish() {}
+
+func (r *Renderer) Flush() {}
+
+func (r *Renderer) Enable(cap webgl.GLenum) {
+	r.setCapability(cap, true)
+}
+
+func (r *Renderer) Disable(cap webgl.GLenum) {
+	r.setCapability(cap, false)
+}
+
+func (r *Renderer) setCapability(cap webgl.GLenum, enabled bool) {
+	if _, ok := r.capabilities[cap]; !ok {
+		r.setError(webgl.INVALID_ENUM)
+		return
+	}
+	r.capabilities[cap] = enabled
+}
+
+func (r *Renderer) IsEnabled(capability webgl.GLenum) bool {
+	enabled, ok := r.capabilities[capability]
+	if !ok {
+		r.setError(webgl.INVALID_ENUM)
+	}
+	return enabled
+}
+
+func (r *Renderer) Viewport(x, y, width, height int) {
+	if width < 0 || height < 0 {
+		r.setError(webgl.INVALID_VALUE)
+		return
+	}
+	if width > MaxViewportDimension {
+		width = MaxViewportDimension
+	}
+	if height > MaxViewportDimension {
+		height = MaxViewportDimension
+	}
+	r.viewport = image.Rect(x, y, x+width, y+height)
+}
+
+func (r *Renderer) Scissor(x, y, width, height int) {
+	if width < 0 || height < 0 {
+		r.setError(webgl.INVALID_VALUE)
+		return
+	}
+	r.scissor = image.Rect(x, y, x+width, y+height)
+}
+
+func (r *Renderer) ClearColor(red, green, blue, alpha float32) {
+	r.clearColor = [4]float32{clamp(red), clamp(green), clamp(blue), clamp(alpha)}
+}
+
+func (r *Renderer) ClearDepth(depth float64) {
+	r.clearDepth = clamp64(depth)
+}
+
+func (r *Renderer) ClearStencil(s int) {
+	r.clearStencil = s
+}
+
+func (r *Renderer) ColorMask(red, green, blue, alpha bool) {
+	r.colorMask = [4]bool{red, green, blue, alpha}
+}
+
+func (r *Renderer) DepthFunc(fun webgl.GLenum) {
+	if !isCompareFunc(fun) {
+		r.setError(webgl.INVALID_ENUM)
+		return
+	}
+	r.depthFunc = fun
+}
+
+func (r *Renderer) DepthMask(flag bool) {
+	r.depthMask = flag
+}
+
+func (r *Renderer) DepthRange(zNear, zFar float64) {
+	if zNear > zFar {
+		r.setError(webgl.INVALID_OPERATION)
+		return
+	}
+	r.depthNear, r.depthFar = clamp64(zNear), clamp64(zFar)
+}
+
+func (r *Renderer) StencilFunc(fun webgl.GLenum, ref int, mask uint32) {
+	r.StencilFuncSeparate(webgl.FRONT_AND_BACK, fun, ref, mask)
+}
+
+func (r *Renderer) StencilFuncSeparate(face, fun webgl.GLenum, ref int, mask uint32) {
+	faces := r.stencilFaces(face)
+	if faces == nil {
+		return
+	}
+	if !isCompareFunc(fun) {
+		r.setError(webgl.INVALID_ENUM)
+		return
+	}
+	for _, s := range faces {
+		s.Func, s.Ref, s.ValueMask = fun, ref, mask
+	}
+}
+
+func (r *Renderer) StencilMask(mask uint32) {
+	r.StencilMaskSeparate(webgl.FRONT_AND_BACK, mask)
+}
+
+func (r *Renderer) StencilMaskSeparate(face webgl.GLenum, mask uint32) {
+	for _, s := range r.stencilFaces(face) {
+		s.WriteMask = mask
+	}
+}
+
+func (r *Renderer) StencilOp(fail, zfail, zpass webgl.GLenum) {
+	r.StencilOpSeparate(webgl.FRONT_AND_BACK, fail, zfail, zpass)
+}
+
+func (r *Renderer) StencilOpSeparate(face, fail, zfail, zpass webgl.GLenum) {
+	faces := r.stencilFaces(face)
+	if faces == nil {
+		return
+	}
+	if !isStencilOp(fail) || !isStencilOp(zfail) || !isStencilOp(zpass) {
+		r.setError(webgl.INVALID_ENUM)
+		return
+	}
+	for _, s := range faces {
+		s.Fail, s.ZFail, s.ZPass = fail, zfail, zpass
+	}
+}
+
+// stencilFaces returns the stencil state of the faces selected by face,
+// setting an error and returning nil if it is not a face.
+func (r *Renderer) stencilFaces(face webgl.GLenum) []*webgl.StencilState {
+	switch face {
+	case webgl.FRONT:
+		return []*webgl.StencilState{&r.stencil[0]}
+	case webgl.BACK:
+		return []*webgl.StencilState{&r.stencil[1]}
+	case webgl.FRONT_AND_BACK:
+		return []*webgl.StencilState{&r.stencil[0], &r.stencil[1]}
+	}
+	r.setError(webgl.INVALID_ENUM)
+	return nil
+}
+
+func (r *Renderer) GetStencilState(face webgl.GLenum) webgl.StencilState {
+	switch face {
+	case webgl.FRONT:
+		return r.stencil[0]
+	case webgl.BACK:
+		return r.stencil[1]
+	}
+	r.setError(webgl.INVALID_ENUM)
+	return webgl.StencilState{}
+}
+
+func (r *Renderer) GetStencilClearValue() int {
+	return r.clearStencil
+}
+
+// GetStencilBits returns 8 if the bound framebuffer has a stencil buffer,
+// and 0 otherwise.
+func (r *Renderer) GetStencilBits() int {
+	var stencil []byte
+	if r.boundFramebuffer == nil {
+		stencil = r.canvas.stencil
+	} else if p, status := r.boundFramebuffer.planes(); status == webgl.FRAMEBUFFER_COMPLETE {
+		stencil = p.stencil
+	}
+	if stencil == nil {
+		return 0
+	}
+	return 8
+}
+
+func (r *Renderer) BlendColor(red, green, blue, alpha float64) {
+	r.blendColor = [4]float32{
+		clamp(float32(red)), clamp(float32(green)),
+		clamp(float32(blue)), clamp(float32(alpha)),
+	}
+}
+
+func (r *Renderer) BlendEquation(mode webgl.GLenum) {
+	r.BlendEquationSeparate(mode, mode)
+}
+
+func (r *Renderer) BlendEquationSeparate(modeRGB, modeAlpha webgl.GLenum) {
+	if !isBlendEquation(modeRGB) || !isBlendEquation(modeAlpha) {
+		r.setError(webgl.INVALID_ENUM)
+		return
+	}
+	r.blendEquationRGB, r.blendEquationAlpha = modeRGB, modeAlpha
+}
+
+func (r *Renderer) BlendFunc(sfactor, dfactor webgl.GLenum) {
+	r.BlendFuncSeparate(sfactor, dfactor, sfactor, dfactor)
+}
+
+func (r *Renderer) BlendFuncSeparate(srcRGB, dstRGB, srcAlpha, dstAlpha webgl.GLenum) {
+	for i, f := range []webgl.GLenum{srcRGB, dstRGB, srcAlpha, dstAlpha} {
+		// SRC_ALPHA_SATURATE is only a source factor.
+		if !isBlendFactor(f) || i%2 == 1 && f == webgl.SRC_ALPHA_SATURATE {
+			r.setError(webgl.INVALID_ENUM)
+			return
+		}
+	}
+	// WebGL forbids mixing constant color and constant alpha factors.
+	if isConstantColor(srcRGB) && isConstantAlpha(dstRGB) || isConstantAlpha(srcRGB) && isConstantColor(dstRGB) {
+		r.setError(webgl.INVALID_OPERATION)
+		return
+	}
+	r.blendSrcRGB, r.blendDstRGB = srcRGB, dstRGB
+	r.blendSrcAlpha, r.blendDstAlpha = srcAlpha, dstAlpha
+}
+
+func (r *Renderer) CullFace(mode webgl.GLenum) {
+	switch mode {
+	case webgl.FRONT, webgl.BACK, webgl.FRONT_AND_BACK:
+		r.cullFace = mode
+	default:
+		r.setError(webgl.INVALID_ENUM)
+	}
+}
+
+func (r *Renderer) FrontFace(mode webgl.GLenum) {
+	switch mode {
+	case webgl.CW, webgl.CCW:
+		r.frontFace = mode
+	default:
+		r.setError(webgl.INVALID_ENUM)
+	}
+}
+
+// LineWidth sets the line width, which is only kept as state: lines are
+// always drawn one pixel wide, as in browsers whose ALIASED_LINE_WIDTH_RANGE
+// is [1, 1].
+func (r *Renderer) LineWidth(width float64) {
+	if width <= 0 {
+		r.setError(webgl.INVALID_VALUE)
+		return
+	}
+	r.lineWidth = width
+}
+
+func (r *Renderer) PolygonOffset(factor, units float64) {
+	r.polygonOffset = [2]float64{factor, units}
+}
+
+func (r *Renderer) Hint(target, mode webgl.GLenum) {
+	if target != webgl.GENERATE_MIPMAP_HINT {
+		r.setError(webgl.INVALID_ENUM)
+		return
+	}
+	switch mode {
+	case webgl.FASTEST, webgl.NICEST, webgl.DONT_CARE:
+		r.generateMipmapHint = mode
+	default:
+		r.setError(webgl.INVALID_ENUM)
+	}
+}
+
+func (r *Renderer) SampleCoverage(value float64, invert bool) {
+	r.sampleCoverage = clamp64(value)
+	r.sampleCoverageInv = invert
+}
+
+func (r *Renderer) PixelStorei(pname webgl.GLenum, param int) {
+	switch pname {
+	case webgl.PACK_ALIGNMENT, webgl.UNPACK_ALIGNMENT:
+		if param != 1 && param != 2 && param != 4 && param != 8 {
+			r.setError(webgl.INVALID_VALUE)
+			return
+		}
+		if pname == webgl.PACK_ALIGNMENT {
+			r.packAlignment = param
+		} else {
+			r.unpackAlignment = param
+		}
+	case webgl.UNPACK_FLIP_Y_WEBGL:
+		r.unpackFlipY = param != 0
+	case webgl.UNPACK_PREMULTIPLY_ALPHA_WEBGL:
+		r.unpackPremultiply = param != 0
+	case webgl.UNPACK_COLORSPACE_CONVERSION_WEBGL:
+	default:
+		r.setError(webgl.INVALID_ENUM)
+	}
+}
+
+func isCompareFunc(f webgl.GLenum) bool {
+	switch f {
+	case webgl.NEVER, webgl.LESS, webgl.EQUAL, webgl.LEQUAL,
+		webgl.GREATER, webgl.NOTEQUAL, webgl.GEQUAL, webgl.ALWAYS:
+		return true
+	}
+	return false
+}
+
+func isStencilOp(op webgl.GLenum) bool {
+	switch op {
+	case webgl.KEEP, webgl.ZERO, webgl.REPLACE, webgl.INCR, webgl.DECR,
+		webgl.INVERT, webgl.INCR_WRAP, webgl.DECR_WRAP:
+		return true
+	}
+	return false
+}
+
+func isBlendEquation(mode webgl.GLenum) bool {
+	switch mode {
+	case webgl.FUNC_ADD, webgl.FUNC_SUBTRACT, webgl.FUNC_REVERSE_SUBTRACT:
+		return true
+	}
+	return false
+}
+
+func isBlendFactor(f webgl.GLenum) bool {
+	switch f {
+	case webgl.ZERO, webgl.ONE,
+		webgl.SRC_COLOR, webgl.ONE_MINUS_SRC_COLOR,
+		webgl.DST_COLOR, webgl.ONE_MINUS_DST_COLOR,
+		webgl.SRC_ALPHA, webgl.ONE_MINUS_SRC_ALPHA,
+		webgl.DST_ALPHA, webgl.ONE_MINUS_DST_ALPHA,
+		webgl.CONSTANT_COLOR, webgl.ONE_MINUS_CONSTANT_COLOR,
+		webgl.CONSTANT_ALPHA, webgl.ONE_MINUS_CONSTANT_ALPHA,
+		webgl.SRC_ALPHA_SATURATE:
+		return true
+	}
+	return false
+}
+
+func isConstantColor(f webgl.GLenum) bool {
+	return f == webgl.CONSTANT_COLOR || f == webgl.ONE_MINUS_CONSTANT_COLOR
+}
+
+func isConstantAlpha(f webgl.GLenum) bool {
+	return f == webgl.CONSTANT_ALPHA || f == webgl.ONE_MINUS_CONSTANT_ALPHA
+}
+
+func clamp(v float32) float32 {
+	if v < 0 {
+		return 0
+	}
+	if v > 1 {
+		return 1
+	}
+	return v
+}
+
+func clamp64(v float64) float64 {
+	if v < 0 {
+		return 0
+	}
+	if v > 1 {
+		return 1
+	}
+	return v
+}
+
+var _ webgl.Backend = (*Renderer)(nil)
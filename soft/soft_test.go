@@ -0,0 +1,189 @@
+// Copyright 2014 Joseph Hager. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package soft
+
+import (
+	"flag"
+	"path/filepath"
+	"testing"
+
+	"github.com/gopherjs/webgl"
+	"github.com/gopherjs/webgl/webgltest"
+)
+
+var update = flag.Bool("update", false, "update the golden files in testdata")
+
+const (
+	vertexSource = `attribute vec3 a_position;
+void main() { gl_Position = vec4(a_position, 1.0); }`
+	fragmentSource = `precision mediump float;
+uniform vec4 u_color;
+void main() { gl_FragColor = u_color; }`
+)
+
+// solid is a Renderer set up to draw triangles of a uniform color.
+type solid struct {
+	*Renderer
+	color webgl.UniformLocation
+}
+
+func newSolid(t *testing.T, attrs *webgl.ContextAttributes) *solid {
+	r := New(16, 16, attrs)
+	r.DefineVertexShader(vertexSource, func(attribs []Vec4, u *Uniforms) (Vec4, []float32) {
+		a := attribs[0]
+		return Vec4{a[0], a[1], a[2], 1}, nil
+	})
+	r.DefineFragmentShader(fragmentSource, func(in *Fragment, u *Uniforms) (Vec4, bool) {
+		return u.Vec("u_color"), true
+	})
+	p := r.CreateProgram()
+	for _, sh := range []struct {
+		typ    webgl.GLenum
+		source string
+	}{{webgl.VERTEX_SHADER, vertexSource}, {webgl.FRAGMENT_SHADER, fragmentSource}} {
+		s := r.CreateShader(sh.typ)
+		r.ShaderSource(s, sh.source)
+		r.CompileShader(s)
+		r.AttachShader(p, s)
+	}
+	r.LinkProgram(p)
+	if !r.GetProgramParameterb(p, webgl.LINK_STATUS) {
+		t.Fatalf("LinkProgram: %s", r.GetProgramInfoLog(p))
+	}
+	r.UseProgram(p)
+	r.BindBuffer(webgl.ARRAY_BUFFER, r.CreateBuffer())
+	r.EnableVertexAttribArray(0)
+	r.ClearColor(0, 0, 0, 1)
+	r.Clear(webgl.COLOR_BUFFER_BIT | webgl.DEPTH_BUFFER_BIT | webgl.STENCIL_BUFFER_BIT)
+	return &solid{r, r.GetUniformLocation(p, "u_color")}
+}
+
+// fill draws the triangles with the given x, y, z vertex positions.
+func (s *solid) fill(color [4]float32, positions ...float32) {
+	s.Uniform4f(s.color, color[0], color[1], color[2], color[3])
+	s.BufferDataFloat32(webgl.ARRAY_BUFFER, positions, webgl.STREAM_DRAW)
+	s.VertexAttribPointer(0, 3, webgl.FLOAT, false, 0, 0)
+	s.DrawArrays(webgl.TRIANGLES, 0, len(positions)/3)
+}
+
+// rect draws the rectangle from (x0, y0) to (x1, y1) at depth z.
+func (s *solid) rect(color [4]float32, x0, y0, x1, y1, z float32) {
+	s.fill(color,
+		x0, y0, z, x1, y0, z, x1, y1, z,
+		x0, y0, z, x1, y1, z, x0, y1, z)
+}
+
+var (
+	red   = [4]float32{1, 0, 0, 1}
+	green = [4]float32{0, 1, 0, 1}
+	blue  = [4]float32{0, 0, 1, 1}
+)
+
+func TestRender(t *testing.T) {
+	stencil := webgl.DefaultAttributes()
+	stencil.Stencil = true
+	tests := []struct {
+		name  string
+		attrs *webgl.ContextAttributes
+		draw  func(s *solid)
+	}{
+		{
+			name: "triangle",
+			draw: func(s *solid) {
+				s.fill(red, -0.75, -0.75, 0, 0.75, -0.75, 0, 0, 0.75, 0)
+			},
+		},
+		{
+			name: "cull",
+			draw: func(s *solid) {
+				s.Enable(webgl.CULL_FACE)
+				// Clockwise, so culled.
+				s.fill(red, -1, -1, 0, 0, 1, 0, 1, -1, 0)
+				s.fill(green, -0.5, -0.5, 0, 0.5, -0.5, 0, 0, 0.5, 0)
+			},
+		},
+		{
+			name: "blend",
+			draw: func(s *solid) {
+				s.rect(red, -0.75, -0.75, 0.25, 0.25, 0)
+				s.Enable(webgl.BLEND)
+				s.BlendFunc(webgl.SRC_ALPHA, webgl.ONE_MINUS_SRC_ALPHA)
+				s.rect([4]float32{0, 0, 1, 0.5}, -0.25, -0.25, 0.75, 0.75, 0)
+			},
+		},
+		{
+			name: "blend_saturate",
+			draw: func(s *solid) {
+				s.ClearColor(0, 0, 0, 0.25)
+				s.Clear(webgl.COLOR_BUFFER_BIT)
+				s.Enable(webgl.BLEND)
+				s.BlendFunc(webgl.SRC_ALPHA_SATURATE, webgl.ONE)
+				s.rect([4]float32{1, 1, 1, 0.5}, -0.5, -0.5, 0.5, 0.5, 0)
+			},
+		},
+		{
+			name: "depth",
+			draw: func(s *solid) {
+				s.Enable(webgl.DEPTH_TEST)
+				s.rect(green, -0.75, -0.75, 0.25, 0.25, -0.5)
+				s.rect(red, -0.25, -0.25, 0.75, 0.75, 0.5)
+				s.DepthFunc(webgl.GREATER)
+				s.rect(blue, -0.5, 0.5, 0.5, 1, 0.75)
+			},
+		},
+		{
+			name:  "stencil",
+			attrs: stencil,
+			draw: func(s *solid) {
+				s.Enable(webgl.STENCIL_TEST)
+				s.StencilFunc(webgl.ALWAYS, 1, 0xff)
+				s.StencilOp(webgl.KEEP, webgl.KEEP, webgl.REPLACE)
+				s.ColorMask(false, false, false, false)
+				s.fill(red, -0.75, -0.75, 0, 0.75, -0.75, 0, 0, 0.75, 0)
+				s.ColorMask(true, true, true, true)
+				s.StencilFunc(webgl.EQUAL, 1, 0xff)
+				s.StencilOp(webgl.KEEP, webgl.KEEP, webgl.KEEP)
+				s.rect(green, -1, -1, 1, 0, 0)
+				s.StencilFunc(webgl.NOTEQUAL, 1, 0xff)
+				s.rect(blue, -1, 0, 1, 1, 0)
+			},
+		},
+	}
+	for _, test := range tests {
+		s := newSolid(t, test.attrs)
+		test.draw(s)
+		if err := s.GetError(); err != webgl.NO_ERROR {
+			t.Errorf("%s: GetError = %v", test.name, err)
+		}
+		golden := filepath.Join("testdata", test.name+".png")
+		if err := webgltest.CompareGoldenPNG(golden, s.Image(), *update); err != nil {
+			t.Errorf("%s: %v", test.name, err)
+		}
+	}
+}
+
+func TestBlendFuncErrors(t *testing.T) {
+	tests := []struct {
+		src, dst webgl.GLenum
+		err      webgl.ErrorCode
+	}{
+		{webgl.SRC_ALPHA, webgl.ONE_MINUS_SRC_ALPHA, webgl.NO_ERROR},
+		{webgl.SRC_ALPHA_SATURATE, webgl.ONE, webgl.NO_ERROR},
+		{webgl.ONE, webgl.SRC_ALPHA_SATURATE, webgl.INVALID_ENUM},
+		{webgl.ONE, webgl.FUNC_ADD, webgl.INVALID_ENUM},
+		{webgl.CONSTANT_COLOR, webgl.CONSTANT_ALPHA, webgl.INVALID_OPERATION},
+	}
+	for _, test := range tests {
+		r := New(1, 1, nil)
+		r.BlendFunc(test.src, test.dst)
+		if err := r.GetError(); err != test.err {
+			t.Errorf("BlendFunc(%v, %v): GetError = %v, want %v", test.src, test.dst, err, test.err)
+		}
+		r.BlendFuncSeparate(test.src, test.dst, webgl.ONE, webgl.ZERO)
+		if err := r.GetError(); err != test.err {
+			t.Errorf("BlendFuncSeparate(%v, %v, ONE, ZERO): GetError = %v, want %v", test.src, test.dst, err, test.err)
+		}
+	}
+}
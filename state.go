@@ -0,0 +1,315 @@
+// Copyright 2014 Joseph Hager. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package webgl
+
+// SavedState is a snapshot of the state of a context, taken by SaveState
+// and put back by RestoreState. It holds the bindings and settings of the
+// context, not the contents of the objects bound, except for the
+// parameters of the bound textures.
+type SavedState struct {
+	RenderState
+
+	Program            Program
+	ArrayBuffer        Buffer
+	ElementArrayBuffer Buffer
+	Framebuffer        Framebuffer
+	Renderbuffer       Renderbuffer
+
+	// ActiveTexture is the active texture unit, and TextureUnits the
+	// textures bound to each unit, from TEXTURE0 on.
+	ActiveTexture GLenum
+	TextureUnits  []TextureUnitState
+
+	// VertexAttribs is the state of each generic vertex attribute.
+	VertexAttribs []VertexAttribState
+
+	// VertexArray is the vertex array bound through VertexArrayObject.
+	// VertexAttribs and ElementArrayBuffer are those of the zero
+	// VertexArray; the state held by the others is not saved.
+	VertexArray VertexArray
+
+	PackAlignment              int
+	UnpackAlignment            int
+	UnpackFlipY                bool
+	UnpackPremultiplyAlpha     bool
+	UnpackColorspaceConversion GLenum
+
+	Viewport              [4]int
+	ScissorTest           bool
+	ScissorBox            [4]int
+	Dither                bool
+	SampleAlphaToCoverage bool
+	SampleCoverage        bool
+	SampleCoverageValue   float64
+	SampleCoverageInvert  bool
+	DepthRange            [2]float64
+	LineWidth             float64
+	GenerateMipmapHint    GLenum
+
+	ClearColor   [4]float32
+	ClearDepth   float64
+	ClearStencil int
+}
+
+// TextureUnitState is the textures bound to a texture unit and their
+// parameters. The parameters of a zero Texture are not saved.
+type TextureUnitState struct {
+	Texture2D                Texture
+	Texture2DParameters      TextureParameters
+	TextureCubeMap           Texture
+	TextureCubeMapParameters TextureParameters
+}
+
+// TextureParameters are the parameters of a texture set by TexParameteri.
+type TextureParameters struct {
+	MinFilter, MagFilter GLenum
+	WrapS, WrapT         GLenum
+}
+
+// VertexAttribState is the state of a generic vertex attribute: its array,
+// as set by VertexAttribPointer, whether the array is enabled, and the
+// value used when it is not. Divisor is only saved and restored once
+// InstancedArrays has enabled ANGLE_instanced_arrays.
+type VertexAttribState struct {
+	Enabled    bool
+	Buffer     Buffer
+	Size       int
+	Type       GLenum
+	Normalized bool
+	Stride     int
+	Offset     int
+	Current    [4]float32
+	Divisor    int
+}
+
+// Returns a snapshot of the state of the context, to be put back with
+// RestoreState after the context was used by other code.
+func (c *Context) SaveState() *SavedState {
+	// The parameter names below are all in their type tables with the type
+	// of the getter, so the getters cannot fail.
+	enabled := func(pname GLenum) bool {
+		b, _ := c.GetParameterBool(pname)
+		return b
+	}
+	enum := func(pname GLenum) GLenum {
+		e, _ := c.GetParameterEnum(pname)
+		return e
+	}
+	num := func(pname GLenum) int {
+		n, _ := c.GetParameterInt(pname)
+		return n
+	}
+	float := func(pname GLenum) float64 {
+		f, _ := c.GetParameterFloat(pname)
+		return float64(f)
+	}
+
+	s := &SavedState{
+		RenderState:                c.GetRenderState(),
+		ActiveTexture:              enum(ACTIVE_TEXTURE),
+		PackAlignment:              num(PACK_ALIGNMENT),
+		UnpackAlignment:            num(UNPACK_ALIGNMENT),
+		UnpackFlipY:                enabled(UNPACK_FLIP_Y_WEBGL),
+		UnpackPremultiplyAlpha:     enabled(UNPACK_PREMULTIPLY_ALPHA_WEBGL),
+		UnpackColorspaceConversion: enum(UNPACK_COLORSPACE_CONVERSION_WEBGL),
+		ScissorTest:                enabled(SCISSOR_TEST),
+		Dither:                     enabled(DITHER),
+		SampleAlphaToCoverage:      enabled(SAMPLE_ALPHA_TO_COVERAGE),
+		SampleCoverage:             enabled(SAMPLE_COVERAGE),
+		SampleCoverageValue:        float(SAMPLE_COVERAGE_VALUE),
+		SampleCoverageInvert:       enabled(SAMPLE_COVERAGE_INVERT),
+		LineWidth:                  float(LINE_WIDTH),
+		GenerateMipmapHint:         enum(GENERATE_MIPMAP_HINT),
+		ClearDepth:                 float(DEPTH_CLEAR_VALUE),
+		ClearStencil:               num(STENCIL_CLEAR_VALUE),
+	}
+	s.Program, _ = c.GetParameterProgram(CURRENT_PROGRAM)
+	s.ArrayBuffer, _ = c.GetParameterBuffer(ARRAY_BUFFER_BINDING)
+	s.Framebuffer, _ = c.GetParameterFramebuffer(FRAMEBUFFER_BINDING)
+	s.Renderbuffer, _ = c.GetParameterRenderbuffer(RENDERBUFFER_BINDING)
+	viewport, _ := c.GetParameterInts(VIEWPORT)
+	copy(s.Viewport[:], viewport)
+	scissor, _ := c.GetParameterInts(SCISSOR_BOX)
+	copy(s.ScissorBox[:], scissor)
+	depthRange, _ := c.GetParameterFloats(DEPTH_RANGE)
+	for i := range s.DepthRange {
+		if i < len(depthRange) {
+			s.DepthRange[i] = float64(depthRange[i])
+		}
+	}
+	clearColor, _ := c.GetParameterFloats(COLOR_CLEAR_VALUE)
+	copy(s.ClearColor[:], clearColor)
+
+	s.TextureUnits = make([]TextureUnitState, num(MAX_COMBINED_TEXTURE_IMAGE_UNITS))
+	for i := range s.TextureUnits {
+		u := &s.TextureUnits[i]
+		c.ActiveTexture(TEXTURE0 + GLenum(i))
+		u.Texture2D, _ = c.GetParameterTexture(TEXTURE_BINDING_2D)
+		if u.Texture2D.object != nil {
+			u.Texture2DParameters = c.textureParameters(TEXTURE_2D)
+		}
+		u.TextureCubeMap, _ = c.GetParameterTexture(TEXTURE_BINDING_CUBE_MAP)
+		if u.TextureCubeMap.object != nil {
+			u.TextureCubeMapParameters = c.textureParameters(TEXTURE_CUBE_MAP)
+		}
+	}
+	c.ActiveTexture(s.ActiveTexture)
+
+	s.VertexArray = c.boundVertexArray()
+	if s.VertexArray.object != nil {
+		c.bindVertexArray(VertexArray{})
+	}
+	s.ElementArrayBuffer, _ = c.GetParameterBuffer(ELEMENT_ARRAY_BUFFER_BINDING)
+	s.VertexAttribs = make([]VertexAttribState, num(MAX_VERTEX_ATTRIBS))
+	for i := range s.VertexAttribs {
+		s.VertexAttribs[i] = c.vertexAttribState(i)
+	}
+	if s.VertexArray.object != nil {
+		c.bindVertexArray(s.VertexArray)
+	}
+	return s
+}
+
+// vertexAttribState returns the state of the generic vertex attribute at
+// index.
+func (c *Context) vertexAttribState(index int) VertexAttribState {
+	var a VertexAttribState
+	a.Enabled, _ = c.GetVertexAttribBool(index, VERTEX_ATTRIB_ARRAY_ENABLED)
+	a.Buffer, _ = c.GetVertexAttribBuffer(index, VERTEX_ATTRIB_ARRAY_BUFFER_BINDING)
+	a.Size, _ = c.GetVertexAttribInt(index, VERTEX_ATTRIB_ARRAY_SIZE)
+	a.Type, _ = c.GetVertexAttribEnum(index, VERTEX_ATTRIB_ARRAY_TYPE)
+	a.Normalized, _ = c.GetVertexAttribBool(index, VERTEX_ATTRIB_ARRAY_NORMALIZED)
+	a.Stride, _ = c.GetVertexAttribInt(index, VERTEX_ATTRIB_ARRAY_STRIDE)
+	a.Offset = c.GetVertexAttribOffset(index, VERTEX_ATTRIB_ARRAY_POINTER)
+	current, _ := c.GetVertexAttribFloats(index, CURRENT_VERTEX_ATTRIB)
+	copy(a.Current[:], current)
+	if c.instancedArrays != nil {
+		a.Divisor, _ = c.GetVertexAttribInt(index, VERTEX_ATTRIB_ARRAY_DIVISOR_ANGLE)
+	}
+	return a
+}
+
+// textureParameters returns the parameters of the texture bound to target
+// on the active unit.
+func (c *Context) textureParameters(target GLenum) TextureParameters {
+	param := func(pname GLenum) GLenum {
+		p, _ := c.GetTexParameterEnum(target, pname)
+		return p
+	}
+	return TextureParameters{
+		MinFilter: param(TEXTURE_MIN_FILTER),
+		MagFilter: param(TEXTURE_MAG_FILTER),
+		WrapS:     param(TEXTURE_WRAP_S),
+		WrapT:     param(TEXTURE_WRAP_T),
+	}
+}
+
+// Puts back the state saved by SaveState. Objects bound when the state was
+// saved must not have been deleted since.
+func (c *Context) RestoreState(s *SavedState) {
+	c.InvalidateRenderState()
+	c.SetRenderState(s.RenderState)
+	var d AttribDivisor
+	if c.instancedArrays != nil {
+		d = c.instancedArrays
+	}
+	s.restore(c, c.bindVertexArray, d)
+}
+
+// restore makes the calls on b that put back the state in s other than
+// the render state. bindVertexArray binds a vertex array as
+// VertexArrayObject does, and d, if not nil, sets the divisors of the
+// attributes.
+func (s *SavedState) restore(b Backend, bindVertexArray func(VertexArray), d AttribDivisor) {
+	for i, u := range s.TextureUnits {
+		b.ActiveTexture(TEXTURE0 + GLenum(i))
+		b.BindTexture(TEXTURE_2D, u.Texture2D)
+		if u.Texture2D.object != nil {
+			setTextureParameters(b, TEXTURE_2D, u.Texture2DParameters)
+		}
+		b.BindTexture(TEXTURE_CUBE_MAP, u.TextureCubeMap)
+		if u.TextureCubeMap.object != nil {
+			setTextureParameters(b, TEXTURE_CUBE_MAP, u.TextureCubeMapParameters)
+		}
+	}
+	b.ActiveTexture(s.ActiveTexture)
+
+	// The attributes and ELEMENT_ARRAY_BUFFER are those of the zero
+	// VertexArray, which must be bound to set them. VertexAttribPointer
+	// takes the buffer bound to ARRAY_BUFFER; without one, only an offset
+	// of 0 is allowed.
+	bindVertexArray(VertexArray{})
+	for i, a := range s.VertexAttribs {
+		if a.Buffer.object != nil || a.Offset == 0 {
+			b.BindBuffer(ARRAY_BUFFER, a.Buffer)
+			b.VertexAttribPointer(i, a.Size, a.Type, a.Normalized, a.Stride, a.Offset)
+		}
+		if a.Enabled {
+			b.EnableVertexAttribArray(i)
+		} else {
+			b.DisableVertexAttribArray(i)
+		}
+		b.VertexAttrib4fv(i, a.Current[:])
+		if d != nil {
+			d.VertexAttribDivisor(i, a.Divisor)
+		}
+	}
+	b.BindBuffer(ARRAY_BUFFER, s.ArrayBuffer)
+	b.BindBuffer(ELEMENT_ARRAY_BUFFER, s.ElementArrayBuffer)
+	if s.VertexArray.object != nil {
+		bindVertexArray(s.VertexArray)
+	}
+	b.BindFramebuffer(FRAMEBUFFER, s.Framebuffer)
+	b.BindRenderbuffer(RENDERBUFFER, s.Renderbuffer)
+	b.UseProgram(s.Program)
+
+	b.PixelStorei(PACK_ALIGNMENT, s.PackAlignment)
+	b.PixelStorei(UNPACK_ALIGNMENT, s.UnpackAlignment)
+	b.PixelStorei(UNPACK_FLIP_Y_WEBGL, boolInt(s.UnpackFlipY))
+	b.PixelStorei(UNPACK_PREMULTIPLY_ALPHA_WEBGL, boolInt(s.UnpackPremultiplyAlpha))
+	b.PixelStorei(UNPACK_COLORSPACE_CONVERSION_WEBGL, int(s.UnpackColorspaceConversion))
+
+	b.Viewport(s.Viewport[0], s.Viewport[1], s.Viewport[2], s.Viewport[3])
+	b.Scissor(s.ScissorBox[0], s.ScissorBox[1], s.ScissorBox[2], s.ScissorBox[3])
+	for _, capability := range []struct {
+		name    GLenum
+		enabled bool
+	}{
+		{SCISSOR_TEST, s.ScissorTest},
+		{DITHER, s.Dither},
+		{SAMPLE_ALPHA_TO_COVERAGE, s.SampleAlphaToCoverage},
+		{SAMPLE_COVERAGE, s.SampleCoverage},
+	} {
+		if capability.enabled {
+			b.Enable(capability.name)
+		} else {
+			b.Disable(capability.name)
+		}
+	}
+	b.SampleCoverage(s.SampleCoverageValue, s.SampleCoverageInvert)
+	b.DepthRange(s.DepthRange[0], s.DepthRange[1])
+	b.LineWidth(s.LineWidth)
+	b.Hint(GENERATE_MIPMAP_HINT, s.GenerateMipmapHint)
+
+	b.ClearColor(s.ClearColor[0], s.ClearColor[1], s.ClearColor[2], s.ClearColor[3])
+	b.ClearDepth(s.ClearDepth)
+	b.ClearStencil(s.ClearStencil)
+}
+
+// setTextureParameters sets the parameters of the texture bound to target
+// on the active unit of b.
+func setTextureParameters(b Backend, target GLenum, p TextureParameters) {
+	b.TexParameteri(target, TEXTURE_MIN_FILTER, int(p.MinFilter))
+	b.TexParameteri(target, TEXTURE_MAG_FILTER, int(p.MagFilter))
+	b.TexParameteri(target, TEXTURE_WRAP_S, int(p.WrapS))
+	b.TexParameteri(target, TEXTURE_WRAP_T, int(p.WrapT))
+}
+
+func boolInt(b bool) int {
+	if b {
+		return 1
+	}
+	return 0
+}
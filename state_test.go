@@ -0,0 +1,111 @@
+// Copyright 2014 Joseph Hager. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package webgl
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestSavedStateRestore(t *testing.T) {
+	r := NewRecorder(nil)
+	tex, cube := r.CreateTexture(), r.CreateTexture()
+	vertices, indices := r.CreateBuffer(), r.CreateBuffer()
+	s := &SavedState{
+		RenderState:        DefaultRenderState(),
+		Program:            r.CreateProgram(),
+		ArrayBuffer:        indices,
+		ElementArrayBuffer: indices,
+		ActiveTexture:      TEXTURE1,
+		TextureUnits: []TextureUnitState{
+			{
+				Texture2D:           tex,
+				Texture2DParameters: TextureParameters{LINEAR, NEAREST, REPEAT, CLAMP_TO_EDGE},
+			},
+			{
+				TextureCubeMap:           cube,
+				TextureCubeMapParameters: TextureParameters{NEAREST, LINEAR, MIRRORED_REPEAT, REPEAT},
+			},
+		},
+		VertexAttribs: []VertexAttribState{
+			{Enabled: true, Buffer: vertices, Size: 3, Type: FLOAT, Stride: 12, Offset: 4, Divisor: 1},
+			// Without a buffer, a pointer with an offset cannot be set.
+			{Size: 4, Type: FLOAT, Offset: 8, Current: [4]float32{0, 0, 0, 1}},
+		},
+		VertexArray:                VertexArray{&object{id: 100}},
+		PackAlignment:              4,
+		UnpackAlignment:            1,
+		UnpackFlipY:                true,
+		UnpackColorspaceConversion: BROWSER_DEFAULT_WEBGL,
+		Viewport:                   [4]int{0, 0, 640, 480},
+		ScissorBox:                 [4]int{0, 0, 640, 480},
+		Dither:                     true,
+		SampleCoverageValue:        1,
+		DepthRange:                 [2]float64{0, 1},
+		LineWidth:                  1,
+		GenerateMipmapHint:         DONT_CARE,
+		ClearColor:                 [4]float32{0, 0, 0, 1},
+		ClearDepth:                 1,
+	}
+	r.Reset()
+
+	bindVertexArray := func(va VertexArray) {
+		r.record("BindVertexArray", r.ref("vertexArray", va.object))
+	}
+	s.restore(r, bindVertexArray, recordedDivisor{r})
+	want := []string{
+		"ActiveTexture(TEXTURE0)",
+		"BindTexture(TEXTURE_2D, texture1)",
+		"TexParameteri(TEXTURE_2D, TEXTURE_MIN_FILTER, LINEAR)",
+		"TexParameteri(TEXTURE_2D, TEXTURE_MAG_FILTER, NEAREST)",
+		"TexParameteri(TEXTURE_2D, TEXTURE_WRAP_S, REPEAT)",
+		"TexParameteri(TEXTURE_2D, TEXTURE_WRAP_T, CLAMP_TO_EDGE)",
+		"BindTexture(TEXTURE_CUBE_MAP, null)",
+		"ActiveTexture(TEXTURE1)",
+		"BindTexture(TEXTURE_2D, null)",
+		"BindTexture(TEXTURE_CUBE_MAP, texture2)",
+		"TexParameteri(TEXTURE_CUBE_MAP, TEXTURE_MIN_FILTER, NEAREST)",
+		"TexParameteri(TEXTURE_CUBE_MAP, TEXTURE_MAG_FILTER, LINEAR)",
+		"TexParameteri(TEXTURE_CUBE_MAP, TEXTURE_WRAP_S, MIRRORED_REPEAT)",
+		"TexParameteri(TEXTURE_CUBE_MAP, TEXTURE_WRAP_T, REPEAT)",
+		"ActiveTexture(TEXTURE1)",
+		"BindVertexArray(null)",
+		"BindBuffer(ARRAY_BUFFER, buffer1)",
+		"VertexAttribPointer(0, 3, FLOAT, false, 12, 4)",
+		"EnableVertexAttribArray(0)",
+		"VertexAttrib4fv(0, [0 0 0 0])",
+		"VertexAttribDivisor(0, 1)",
+		"DisableVertexAttribArray(1)",
+		"VertexAttrib4fv(1, [0 0 0 1])",
+		"VertexAttribDivisor(1, 0)",
+		"BindBuffer(ARRAY_BUFFER, buffer2)",
+		"BindBuffer(ELEMENT_ARRAY_BUFFER, buffer2)",
+		"BindVertexArray(vertexArray1)",
+		"BindFramebuffer(FRAMEBUFFER, null)",
+		"BindRenderbuffer(RENDERBUFFER, null)",
+		"UseProgram(program1)",
+		"PixelStorei(PACK_ALIGNMENT, 4)",
+		"PixelStorei(UNPACK_ALIGNMENT, 1)",
+		"PixelStorei(UNPACK_FLIP_Y_WEBGL, 1)",
+		"PixelStorei(UNPACK_PREMULTIPLY_ALPHA_WEBGL, 0)",
+		"PixelStorei(UNPACK_COLORSPACE_CONVERSION_WEBGL, 37444)",
+		"Viewport(0, 0, 640, 480)",
+		"Scissor(0, 0, 640, 480)",
+		"Disable(SCISSOR_TEST)",
+		"Enable(DITHER)",
+		"Disable(SAMPLE_ALPHA_TO_COVERAGE)",
+		"Disable(SAMPLE_COVERAGE)",
+		"SampleCoverage(1, false)",
+		"DepthRange(0, 1)",
+		"LineWidth(1)",
+		"Hint(GENERATE_MIPMAP_HINT, DONT_CARE)",
+		"ClearColor(0, 0, 0, 1)",
+		"ClearDepth(1)",
+		"ClearStencil(0)",
+	}
+	if got, want := r.Trace(), strings.Join(want, "\n")+"\n"; got != want {
+		t.Errorf("trace =\n%swant\n%s", got, want)
+	}
+}
@@ -0,0 +1,42 @@
+// Copyright 2014 Joseph Hager. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package webgl
+
+// StencilState is the stencil test state of one face, as set by
+// StencilFuncSeparate, StencilMaskSeparate and StencilOpSeparate.
+type StencilState struct {
+	Func      GLenum // comparison function, ALWAYS by default
+	Ref       int    // reference value
+	ValueMask uint32 // bits compared by the test
+	WriteMask uint32 // bits written to the stencil buffer
+	Fail      GLenum // action when the stencil test fails
+	ZFail     GLenum // action when the stencil test passes but the depth test fails
+	ZPass     GLenum // action when both tests pass
+}
+
+// DefaultStencilState returns the initial stencil state of each face.
+func DefaultStencilState() StencilState {
+	return StencilState{
+		Func:      ALWAYS,
+		ValueMask: 0xffffffff,
+		WriteMask: 0xffffffff,
+		Fail:      KEEP,
+		ZFail:     KEEP,
+		ZPass:     KEEP,
+	}
+}
+
+// The GetParameter names of the StencilState fields of each face, in field
+// order.
+var (
+	frontStencilParameters = [...]GLenum{
+		STENCIL_FUNC, STENCIL_REF, STENCIL_VALUE_MASK, STENCIL_WRITEMASK,
+		STENCIL_FAIL, STENCIL_PASS_DEPTH_FAIL, STENCIL_PASS_DEPTH_PASS,
+	}
+	backStencilParameters = [...]GLenum{
+		STENCIL_BACK_FUNC, STENCIL_BACK_REF, STENCIL_BACK_VALUE_MASK, STENCIL_BACK_WRITEMASK,
+		STENCIL_BACK_FAIL, STENCIL_BACK_PASS_DEPTH_FAIL, STENCIL_BACK_PASS_DEPTH_PASS,
+	}
+)
@@ -0,0 +1,73 @@
+// Copyright 2014 Joseph Hager. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// +build !wasm
+
+package webgl
+
+import "github.com/gopherjs/gopherjs/js"
+
+// views caches, for each element type, the typed array last used to pass
+// a Go slice to WebGL. GopherJS stores numeric slices in typed arrays, so
+// a slice spanning its whole backing array is passed as is; other slices
+// need a subarray, which is reused while the same slice is uploaded every
+// frame instead of being created per call.
+type views struct {
+	bytes, uint16s, float32s, int32s view
+}
+
+// view is a cached subarray of n elements at offset in array.
+type view struct {
+	array  *js.Object
+	offset int
+	n      int
+	sub    *js.Object
+}
+
+// of returns a typed array holding the elements of slice.
+func (v *view) of(slice interface{}) *js.Object {
+	s := js.InternalObject(slice)
+	array := s.Get("$array")
+	offset, n := s.Get("$offset").Int(), s.Get("$length").Int()
+	if offset == 0 && n == array.Length() {
+		return array
+	}
+	if v.sub == nil || v.array != array || v.offset != offset || v.n != n {
+		v.array, v.offset, v.n = array, offset, n
+		v.sub = array.Call("subarray", offset, offset+n)
+	}
+	return v.sub
+}
+
+// bytesView returns a Uint8Array holding data.
+func (vs *views) bytesView(data []byte) *js.Object {
+	if len(data) == 0 {
+		return js.Global.Get("Uint8Array").New(0)
+	}
+	return vs.bytes.of(data)
+}
+
+// uint16sView returns a Uint16Array holding data.
+func (vs *views) uint16sView(data []uint16) *js.Object {
+	if len(data) == 0 {
+		return js.Global.Get("Uint16Array").New(0)
+	}
+	return vs.uint16s.of(data)
+}
+
+// float32sView returns a Float32Array holding data.
+func (vs *views) float32sView(data []float32) *js.Object {
+	if len(data) == 0 {
+		return js.Global.Get("Float32Array").New(0)
+	}
+	return vs.float32s.of(data)
+}
+
+// int32sView returns an Int32Array holding data.
+func (vs *views) int32sView(data []int32) *js.Object {
+	if len(data) == 0 {
+		return js.Global.Get("Int32Array").New(0)
+	}
+	return vs.int32s.of(data)
+}
@@ -0,0 +1,87 @@
+// Copyright 2014 Joseph Hager. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// +build wasm
+
+package webgl
+
+import (
+	"unsafe"
+
+	"github.com/gopherjs/gopherwasm/js"
+)
+
+// views caches, for each element type, the typed array last used to pass
+// a Go slice to WebGL. The typed arrays are views of the WebAssembly
+// memory, so no data is copied on the Go side, and uploading the same
+// slice every frame reuses one JavaScript object instead of creating and
+// releasing a new one per call.
+type views struct {
+	bytes, uint16s, float32s, int32s, uint32s view
+}
+
+// view is a cached typed array viewing n elements at address ptr.
+type view struct {
+	ptr uintptr
+	n   int
+	a   js.TypedArray
+	ok  bool
+}
+
+// of returns a typed array viewing slice, whose first element is at ptr
+// and which has n elements. The typed array is only valid until the next
+// call, so it must be passed to WebGL right away.
+func (v *view) of(slice interface{}, ptr uintptr, n int) js.Value {
+	// A view is detached, and reports a byteLength of 0, once the memory
+	// grows.
+	if v.ok && v.ptr == ptr && v.n == n && v.a.Get("byteLength").Int() != 0 {
+		return v.a.Value
+	}
+	if v.ok {
+		v.a.Release()
+	}
+	v.a = js.TypedArrayOf(slice)
+	v.ptr, v.n, v.ok = ptr, n, true
+	return v.a.Value
+}
+
+// bytesView returns a Uint8Array viewing data.
+func (vs *views) bytesView(data []byte) js.Value {
+	if len(data) == 0 {
+		return js.Global().Get("Uint8Array").New(0)
+	}
+	return vs.bytes.of(data, uintptr(unsafe.Pointer(&data[0])), len(data))
+}
+
+// uint16sView returns a Uint16Array viewing data.
+func (vs *views) uint16sView(data []uint16) js.Value {
+	if len(data) == 0 {
+		return js.Global().Get("Uint16Array").New(0)
+	}
+	return vs.uint16s.of(data, uintptr(unsafe.Pointer(&data[0])), len(data))
+}
+
+// float32sView returns a Float32Array viewing data.
+func (vs *views) float32sView(data []float32) js.Value {
+	if len(data) == 0 {
+		return js.Global().Get("Float32Array").New(0)
+	}
+	return vs.float32s.of(data, uintptr(unsafe.Pointer(&data[0])), len(data))
+}
+
+// int32sView returns an Int32Array viewing data.
+func (vs *views) int32sView(data []int32) js.Value {
+	if len(data) == 0 {
+		return js.Global().Get("Int32Array").New(0)
+	}
+	return vs.int32s.of(data, uintptr(unsafe.Pointer(&data[0])), len(data))
+}
+
+// uint32sView returns a Uint32Array viewing data.
+func (vs *views) uint32sView(data []uint32) js.Value {
+	if len(data) == 0 {
+		return js.Global().Get("Uint32Array").New(0)
+	}
+	return vs.uint32s.of(data, uintptr(unsafe.Pointer(&data[0])), len(data))
+}
@@ -0,0 +1,298 @@
+// Copyright 2014 Joseph Hager. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package webgl
+
+import "sort"
+
+// VertexArrayObject is the OES_vertex_array_object extension, which records
+// the vertex attribute arrays and the ELEMENT_ARRAY_BUFFER binding in
+// vertex array objects, so that binding one sets them all at once.
+//
+// If the context does not support the extension, it is emulated: while an
+// emulated vertex array is bound, the Context methods that change the
+// state it holds record the changes in it, and binding it makes the calls
+// that put the state back. Only the changes made through the Context are
+// seen, and divisors set through InstancedArrays are not part of the
+// emulated state.
+//
+// Vertex arrays do not survive a lost context; create them again once it
+// is restored.
+type VertexArrayObject struct {
+	c   *Context
+	ext *vertexArrayExt
+	emu *vertexArrayEmulation
+}
+
+// Returns the OES_vertex_array_object extension, enabling it, or an
+// emulation of it if the context does not support it.
+func (c *Context) VertexArrayObject() *VertexArrayObject {
+	if ext := c.vertexArrayExt(); ext != nil {
+		c.vertexArrayOES = ext
+		return &VertexArrayObject{c: c, ext: ext}
+	}
+	if c.vertexArrays == nil {
+		c.vertexArrays = newVertexArrayEmulation()
+		c.vertexArrays.loadDefault(c)
+	}
+	return &VertexArrayObject{c: c, emu: c.vertexArrays}
+}
+
+// Emulated reports whether the extension is emulated.
+func (v *VertexArrayObject) Emulated() bool {
+	return v.emu != nil
+}
+
+// CreateVertexArray creates a vertex array, with all attribute arrays
+// disabled and no ELEMENT_ARRAY_BUFFER bound.
+func (v *VertexArrayObject) CreateVertexArray() VertexArray {
+	if v.emu != nil {
+		return v.emu.create()
+	}
+	return v.ext.create()
+}
+
+// BindVertexArray binds vertexArray, setting the state it holds. Binding
+// the zero VertexArray goes back to the state of the context without one.
+//
+// Binding changes the ELEMENT_ARRAY_BUFFER binding without a BindBuffer
+// call on the Context, and so does the emulation, which makes its calls
+// on the Context directly. A StateCache wrapping the Context keeps the
+// binding it last saw; call its Invalidate after binding a vertex array.
+func (v *VertexArrayObject) BindVertexArray(vertexArray VertexArray) {
+	if v.emu != nil {
+		v.emu.bind(v.c, vertexArray)
+		return
+	}
+	v.ext.bind(vertexArray)
+}
+
+// DeleteVertexArray deletes vertexArray. If it is bound, the zero
+// VertexArray is bound instead.
+func (v *VertexArrayObject) DeleteVertexArray(vertexArray VertexArray) {
+	if v.emu != nil {
+		v.emu.delete(v.c, vertexArray)
+		return
+	}
+	v.ext.delete(vertexArray)
+}
+
+// IsVertexArray reports whether vertexArray was created and not deleted.
+func (v *VertexArrayObject) IsVertexArray(vertexArray VertexArray) bool {
+	if v.emu != nil {
+		return v.emu.is(vertexArray)
+	}
+	return v.ext.is(vertexArray)
+}
+
+// boundVertexArray returns the vertex array bound through
+// VertexArrayObject, or the zero VertexArray if it was never called.
+func (c *Context) boundVertexArray() VertexArray {
+	if c.vertexArrays == nil && c.vertexArrayOES == nil {
+		return VertexArray{}
+	}
+	va, _ := c.GetParameterVertexArray(VERTEX_ARRAY_BINDING_OES)
+	return va
+}
+
+// bindVertexArray binds va with the extension or its emulation, whichever
+// VertexArrayObject set up. It does nothing if VertexArrayObject was never
+// called.
+func (c *Context) bindVertexArray(va VertexArray) {
+	switch {
+	case c.vertexArrays != nil:
+		c.vertexArrays.bind(c, va)
+	case c.vertexArrayOES != nil:
+		c.vertexArrayOES.bind(va)
+	}
+}
+
+// vertexArrayEmulation emulates OES_vertex_array_object for a Context. The
+// Context calls its hooks when the state of the bound vertex array changes,
+// and bind makes its calls on any Backend.
+type vertexArrayEmulation struct {
+	arrays       map[*object]*vertexArrayState
+	defaultArray vertexArrayState
+	current      *vertexArrayState
+	bound        VertexArray
+	nextID       uint32
+
+	// binding is set while bind makes its calls, which the hooks ignore.
+	binding bool
+
+	// arrayBuffer is bound to ARRAY_BUFFER. It is not part of a vertex
+	// array, but VertexAttribPointer records it in the attribute.
+	arrayBuffer Buffer
+}
+
+// vertexArrayState is the state held by a vertex array. Attributes whose
+// state was never changed are not in attribs; their Size is 0 until their
+// pointer is set. Current is not used, as it is not vertex array state,
+// and neither is Divisor, as the emulation does not see it change.
+type vertexArrayState struct {
+	attribs      map[int]VertexAttribState
+	elementArray Buffer
+}
+
+// newVertexArrayEmulation returns an emulation without vertex arrays, whose
+// zero VertexArray holds the state of a new context.
+func newVertexArrayEmulation() *vertexArrayEmulation {
+	e := &vertexArrayEmulation{arrays: make(map[*object]*vertexArrayState)}
+	e.reset()
+	return e
+}
+
+// loadDefault sets the state held by the zero VertexArray to the current
+// state of c.
+func (e *vertexArrayEmulation) loadDefault(c *Context) {
+	e.arrayBuffer, _ = c.GetParameterBuffer(ARRAY_BUFFER_BINDING)
+	e.defaultArray.elementArray, _ = c.GetParameterBuffer(ELEMENT_ARRAY_BUFFER_BINDING)
+	n, _ := c.GetParameterInt(MAX_VERTEX_ATTRIBS)
+	for i := 0; i < n; i++ {
+		a := c.vertexAttribState(i)
+		a.Current, a.Divisor = [4]float32{}, 0
+		e.defaultArray.attribs[i] = a
+	}
+}
+
+// reset forgets all vertex arrays, as happens when the context is lost.
+func (e *vertexArrayEmulation) reset() {
+	for o := range e.arrays {
+		delete(e.arrays, o)
+	}
+	e.defaultArray = vertexArrayState{attribs: make(map[int]VertexAttribState)}
+	e.current = &e.defaultArray
+	e.bound = VertexArray{}
+	e.arrayBuffer = Buffer{}
+}
+
+func (e *vertexArrayEmulation) create() VertexArray {
+	e.nextID++
+	va := VertexArray{&object{id: e.nextID}}
+	e.arrays[va.object] = &vertexArrayState{attribs: make(map[int]VertexAttribState)}
+	return va
+}
+
+func (e *vertexArrayEmulation) is(va VertexArray) bool {
+	_, ok := e.arrays[va.object]
+	return ok
+}
+
+func (e *vertexArrayEmulation) delete(b Backend, va VertexArray) {
+	if va.object == nil || !e.is(va) {
+		return
+	}
+	if va.object == e.bound.object {
+		e.bind(b, VertexArray{})
+	}
+	delete(e.arrays, va.object)
+}
+
+// bind makes the calls on b that change the state of the context from
+// that of the bound vertex array to that of va. The calls are not
+// recorded in the vertex arrays.
+func (e *vertexArrayEmulation) bind(b Backend, va VertexArray) {
+	next := &e.defaultArray
+	if va.object != nil {
+		if next = e.arrays[va.object]; next == nil {
+			return
+		}
+	}
+	prev := e.current
+	e.binding = true
+	defer func() { e.binding = false }()
+
+	rebound := false
+	for _, i := range sortedAttribs(next.attribs) {
+		a := next.attribs[i]
+		if old, ok := prev.attribs[i]; ok && old == a {
+			continue
+		}
+		if a.Size > 0 {
+			b.BindBuffer(ARRAY_BUFFER, a.Buffer)
+			b.VertexAttribPointer(i, a.Size, a.Type, a.Normalized, a.Stride, a.Offset)
+			rebound = true
+		}
+		if a.Enabled {
+			b.EnableVertexAttribArray(i)
+		} else {
+			b.DisableVertexAttribArray(i)
+		}
+	}
+	for _, i := range sortedAttribs(prev.attribs) {
+		if _, ok := next.attribs[i]; !ok && prev.attribs[i].Enabled {
+			b.DisableVertexAttribArray(i)
+		}
+	}
+	if rebound {
+		b.BindBuffer(ARRAY_BUFFER, e.arrayBuffer)
+	}
+	if key(next.elementArray.object) != key(prev.elementArray.object) {
+		b.BindBuffer(ELEMENT_ARRAY_BUFFER, next.elementArray)
+	}
+	e.current, e.bound = next, va
+}
+
+// sortedAttribs returns the indices of attribs in increasing order, so
+// that bind makes its calls in the same order every time.
+func sortedAttribs(attribs map[int]VertexAttribState) []int {
+	indices := make([]int, 0, len(attribs))
+	for i := range attribs {
+		indices = append(indices, i)
+	}
+	sort.Ints(indices)
+	return indices
+}
+
+// The hooks below record the changes made through the Context.
+
+func (e *vertexArrayEmulation) bindBuffer(target GLenum, buffer Buffer) {
+	if e.binding {
+		return
+	}
+	switch target {
+	case ARRAY_BUFFER:
+		e.arrayBuffer = buffer
+	case ELEMENT_ARRAY_BUFFER:
+		e.current.elementArray = buffer
+	}
+}
+
+// deleteBuffer unbinds buffer from ARRAY_BUFFER and from the bound vertex
+// array, as deleting it does. Other vertex arrays keep referring to it.
+func (e *vertexArrayEmulation) deleteBuffer(buffer Buffer) {
+	k := key(buffer.object)
+	if key(e.arrayBuffer.object) == k {
+		e.arrayBuffer = Buffer{}
+	}
+	if key(e.current.elementArray.object) == k {
+		e.current.elementArray = Buffer{}
+	}
+	for i, a := range e.current.attribs {
+		if key(a.Buffer.object) == k {
+			a.Buffer = Buffer{}
+			e.current.attribs[i] = a
+		}
+	}
+}
+
+func (e *vertexArrayEmulation) enableAttrib(index int, enabled bool) {
+	if e.binding {
+		return
+	}
+	a := e.current.attribs[index]
+	a.Enabled = enabled
+	e.current.attribs[index] = a
+}
+
+func (e *vertexArrayEmulation) attribPointer(index, size int, typ GLenum, normalized bool, stride, offset int) {
+	if e.binding {
+		return
+	}
+	a := e.current.attribs[index]
+	a.Buffer = e.arrayBuffer
+	a.Size, a.Type, a.Normalized = size, typ, normalized
+	a.Stride, a.Offset = stride, offset
+	e.current.attribs[index] = a
+}
@@ -0,0 +1,189 @@
+// Copyright 2014 Joseph Hager. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package webgl
+
+import (
+	"strings"
+	"testing"
+)
+
+// emulatedContext is a standalone Recorder that calls the hooks of a
+// vertex array emulation as a Context does.
+type emulatedContext struct {
+	*Recorder
+	e *vertexArrayEmulation
+}
+
+func (c emulatedContext) BindBuffer(target GLenum, buffer Buffer) {
+	c.e.bindBuffer(target, buffer)
+	c.Recorder.BindBuffer(target, buffer)
+}
+
+func (c emulatedContext) DeleteBuffer(buffer Buffer) {
+	c.e.deleteBuffer(buffer)
+	c.Recorder.DeleteBuffer(buffer)
+}
+
+func (c emulatedContext) DisableVertexAttribArray(index int) {
+	c.e.enableAttrib(index, false)
+	c.Recorder.DisableVertexAttribArray(index)
+}
+
+func (c emulatedContext) EnableVertexAttribArray(index int) {
+	c.e.enableAttrib(index, true)
+	c.Recorder.EnableVertexAttribArray(index)
+}
+
+func (c emulatedContext) VertexAttribPointer(index, size int, typ GLenum, normal bool, stride int, offset int) {
+	c.e.attribPointer(index, size, typ, normal, stride, offset)
+	c.Recorder.VertexAttribPointer(index, size, typ, normal, stride, offset)
+}
+
+func TestVertexArrayEmulation(t *testing.T) {
+	e := newVertexArrayEmulation()
+	c := emulatedContext{NewRecorder(nil), e}
+	vertices, indices, instances := c.CreateBuffer(), c.CreateBuffer(), c.CreateBuffer()
+	va, va2 := e.create(), e.create()
+
+	tests := []struct {
+		name  string
+		calls func()
+		trace []string
+	}{
+		{
+			name:  "bind empty",
+			calls: func() { e.bind(c, va) },
+		},
+		{
+			name: "set up",
+			calls: func() {
+				c.BindBuffer(ARRAY_BUFFER, vertices)
+				c.VertexAttribPointer(0, 3, FLOAT, false, 12, 0)
+				c.EnableVertexAttribArray(0)
+				c.BindBuffer(ELEMENT_ARRAY_BUFFER, indices)
+			},
+			trace: []string{
+				"BindBuffer(ARRAY_BUFFER, buffer1)",
+				"VertexAttribPointer(0, 3, FLOAT, false, 12, 0)",
+				"EnableVertexAttribArray(0)",
+				"BindBuffer(ELEMENT_ARRAY_BUFFER, buffer2)",
+			},
+		},
+		{
+			name:  "unbind",
+			calls: func() { e.bind(c, VertexArray{}) },
+			trace: []string{
+				"DisableVertexAttribArray(0)",
+				"BindBuffer(ELEMENT_ARRAY_BUFFER, null)",
+			},
+		},
+		{
+			name:  "bind",
+			calls: func() { e.bind(c, va) },
+			trace: []string{
+				"BindBuffer(ARRAY_BUFFER, buffer1)",
+				"VertexAttribPointer(0, 3, FLOAT, false, 12, 0)",
+				"EnableVertexAttribArray(0)",
+				"BindBuffer(ARRAY_BUFFER, buffer1)",
+				"BindBuffer(ELEMENT_ARRAY_BUFFER, buffer2)",
+			},
+		},
+		{
+			name:  "rebind",
+			calls: func() { e.bind(c, va) },
+		},
+		{
+			name: "bind another",
+			calls: func() {
+				e.bind(c, va2)
+				c.BindBuffer(ARRAY_BUFFER, instances)
+				c.VertexAttribPointer(1, 2, FLOAT, false, 0, 0)
+				c.EnableVertexAttribArray(1)
+				c.BindBuffer(ARRAY_BUFFER, vertices)
+				e.bind(c, va)
+			},
+			trace: []string{
+				"DisableVertexAttribArray(0)",
+				"BindBuffer(ELEMENT_ARRAY_BUFFER, null)",
+				"BindBuffer(ARRAY_BUFFER, buffer3)",
+				"VertexAttribPointer(1, 2, FLOAT, false, 0, 0)",
+				"EnableVertexAttribArray(1)",
+				"BindBuffer(ARRAY_BUFFER, buffer1)",
+				"BindBuffer(ARRAY_BUFFER, buffer1)",
+				"VertexAttribPointer(0, 3, FLOAT, false, 12, 0)",
+				"EnableVertexAttribArray(0)",
+				"DisableVertexAttribArray(1)",
+				"BindBuffer(ARRAY_BUFFER, buffer1)",
+				"BindBuffer(ELEMENT_ARRAY_BUFFER, buffer2)",
+			},
+		},
+		{
+			name:  "delete while bound",
+			calls: func() { e.delete(c, va) },
+			trace: []string{
+				"DisableVertexAttribArray(0)",
+				"BindBuffer(ELEMENT_ARRAY_BUFFER, null)",
+			},
+		},
+		{
+			name:  "bind deleted",
+			calls: func() { e.bind(c, va) },
+		},
+	}
+	for _, test := range tests {
+		n := len(c.Calls())
+		test.calls()
+		var trace []string
+		for _, call := range c.Calls()[n:] {
+			trace = append(trace, call.String())
+		}
+		if got, want := strings.Join(trace, "\n"), strings.Join(test.trace, "\n"); got != want {
+			t.Errorf("%s: trace =\n%s\nwant\n%s", test.name, got, want)
+		}
+	}
+
+	// The calls made by bind are not recorded in the vertex arrays.
+	if n := len(e.defaultArray.attribs); n != 0 {
+		t.Errorf("zero VertexArray has %d attributes, want 0", n)
+	}
+	if e.is(va) || !e.is(va2) {
+		t.Errorf("is(va), is(va2) = %v, %v, want false, true", e.is(va), e.is(va2))
+	}
+	if e.bound.object != nil {
+		t.Errorf("bound %v after deleting the bound vertex array", e.bound)
+	}
+}
+
+func TestVertexArrayEmulationDeleteBuffer(t *testing.T) {
+	e := newVertexArrayEmulation()
+	c := emulatedContext{NewRecorder(nil), e}
+	vertices, indices := c.CreateBuffer(), c.CreateBuffer()
+	va, va2 := e.create(), e.create()
+	for _, v := range []VertexArray{va2, va} {
+		e.bind(c, v)
+		c.BindBuffer(ARRAY_BUFFER, vertices)
+		c.VertexAttribPointer(0, 3, FLOAT, false, 12, 0)
+		c.BindBuffer(ELEMENT_ARRAY_BUFFER, indices)
+	}
+
+	c.DeleteBuffer(vertices)
+	if e.arrayBuffer.object != nil {
+		t.Errorf("ARRAY_BUFFER is %v after deleting it", e.arrayBuffer)
+	}
+	if b := e.arrays[va.object].attribs[0].Buffer; b.object != nil {
+		t.Errorf("bound vertex array attribute buffer is %v after deleting it", b)
+	}
+	if b := e.arrays[va2.object].attribs[0].Buffer; b != vertices {
+		t.Errorf("other vertex array attribute buffer is %v, want %v", b, vertices)
+	}
+
+	c.DeleteBuffer(indices)
+	if b := e.arrays[va.object].elementArray; b.object != nil {
+		t.Errorf("bound vertex array ELEMENT_ARRAY_BUFFER is %v after deleting it", b)
+	}
+	if b := e.arrays[va2.object].elementArray; b != indices {
+		t.Errorf("other vertex array ELEMENT_ARRAY_BUFFER is %v, want %v", b, indices)
+	}
+}
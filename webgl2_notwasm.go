@@ -0,0 +1,565 @@
+// Copyright 2014 Joseph Hager. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// +build !wasm
+
+package webgl
+
+import (
+	"errors"
+
+	"github.com/gopherjs/gopherjs/js"
+)
+
+// NewContext2 takes an HTML5 canvas object and optional context attributes
+// and creates a WebGL 2.0 context. If an error is returned it means you
+// won't have access to WebGL 2.0 functionality, though NewContext may
+// still succeed.
+func NewContext2(canvas *js.Object, ca *ContextAttributes) (*Context2, error) {
+	if js.Global.Get("WebGL2RenderingContext") == js.Undefined {
+		return nil, errors.New("Your browser doesn't appear to support webgl2.")
+	}
+
+	if ca == nil {
+		ca = DefaultAttributes()
+	}
+
+	gl := canvas.Call("getContext", "webgl2", ca.attributes())
+	if gl == nil {
+		return nil, errors.New("Creating a webgl2 context has failed.")
+	}
+	ctx := &Context2{Context: &Context{enums: webgl1Enums, params: parameterTypes2}, enums2: webgl2Enums}
+	ctx.Object = gl
+	return ctx, nil
+}
+
+// Starts an asynchronous query.
+func (c *Context2) BeginQuery(target GLenum, query Query) {
+	c.Call("beginQuery", target, query.jsValue())
+}
+
+// Starts a transform feedback operation.
+func (c *Context2) BeginTransformFeedback(primitiveMode GLenum) {
+	c.Call("beginTransformFeedback", primitiveMode)
+}
+
+// Binds a buffer to the indexed binding point of target.
+func (c *Context2) BindBufferBase(target GLenum, index int, buffer Buffer) {
+	c.Call("bindBufferBase", target, index, buffer.jsValue())
+}
+
+// Binds a range of a buffer to the indexed binding point of target.
+func (c *Context2) BindBufferRange(target GLenum, index int, buffer Buffer, offset, size int) {
+	c.Call("bindBufferRange", target, index, buffer.jsValue(), offset, size)
+}
+
+// Binds a WebGLSampler object to a texture unit.
+func (c *Context2) BindSampler(unit int, sampler Sampler) {
+	c.Call("bindSampler", unit, sampler.jsValue())
+}
+
+// Binds a WebGLTransformFeedback object to the TRANSFORM_FEEDBACK target.
+func (c *Context2) BindTransformFeedback(target GLenum, transformFeedback TransformFeedback) {
+	c.Call("bindTransformFeedback", target, transformFeedback.jsValue())
+}
+
+// Binds a WebGLVertexArrayObject, restoring the vertex attribute state it records.
+func (c *Context2) BindVertexArray(vertexArray VertexArray) {
+	c.Call("bindVertexArray", vertexArray.jsValue())
+}
+
+// Copies a block of pixels from the read framebuffer to the draw framebuffer.
+func (c *Context2) BlitFramebuffer(srcX0, srcY0, srcX1, srcY1, dstX0, dstY0, dstX1, dstY1 int, mask, filter GLenum) {
+	c.Call("blitFramebuffer", srcX0, srcY0, srcX1, srcY1, dstX0, dstY0, dstX1, dstY1, mask, filter)
+}
+
+// Clears a floating point buffer of the current draw framebuffer.
+func (c *Context2) ClearBufferfv(buffer GLenum, drawBuffer int, values []float32) {
+	c.Call("clearBufferfv", buffer, drawBuffer, values)
+}
+
+// Clears a signed integer buffer of the current draw framebuffer.
+func (c *Context2) ClearBufferiv(buffer GLenum, drawBuffer int, values []int32) {
+	c.Call("clearBufferiv", buffer, drawBuffer, values)
+}
+
+// Clears an unsigned integer buffer of the current draw framebuffer.
+func (c *Context2) ClearBufferuiv(buffer GLenum, drawBuffer int, values []uint32) {
+	c.Call("clearBufferuiv", buffer, drawBuffer, values)
+}
+
+// Clears the depth and stencil buffers of the current draw framebuffer.
+func (c *Context2) ClearBufferfi(buffer GLenum, drawBuffer int, depth float32, stencil int) {
+	c.Call("clearBufferfi", buffer, drawBuffer, depth, stencil)
+}
+
+// Blocks until the sync object is signaled or the timeout in nanoseconds expires.
+func (c *Context2) ClientWaitSync(sync Sync, flags GLenum, timeout int) GLenum {
+	return GLenum(c.Call("clientWaitSync", sync.jsValue(), flags, timeout).Int())
+}
+
+// Copies part of the data store of one buffer to another buffer.
+func (c *Context2) CopyBufferSubData(readTarget, writeTarget GLenum, readOffset, writeOffset, size int) {
+	c.Call("copyBufferSubData", readTarget, writeTarget, readOffset, writeOffset, size)
+}
+
+// Replaces a portion of a 3D texture image with data from the current framebuffer.
+func (c *Context2) CopyTexSubImage3D(target GLenum, level, xoffset, yoffset, zoffset, x, y, width, height int) {
+	c.Call("copyTexSubImage3D", target, level, xoffset, yoffset, zoffset, x, y, width, height)
+}
+
+// Creates a WebGLQuery object.
+func (c *Context2) CreateQuery() Query {
+	return Query{wrap(c.Call("createQuery"))}
+}
+
+// Creates a WebGLSampler object.
+func (c *Context2) CreateSampler() Sampler {
+	return Sampler{wrap(c.Call("createSampler"))}
+}
+
+// Creates a WebGLTransformFeedback object.
+func (c *Context2) CreateTransformFeedback() TransformFeedback {
+	return TransformFeedback{wrap(c.Call("createTransformFeedback"))}
+}
+
+// Creates a WebGLVertexArrayObject.
+func (c *Context2) CreateVertexArray() VertexArray {
+	return VertexArray{wrap(c.Call("createVertexArray"))}
+}
+
+// Deletes a specific query object.
+func (c *Context2) DeleteQuery(query Query) {
+	c.Call("deleteQuery", query.jsValue())
+}
+
+// Deletes a specific sampler object.
+func (c *Context2) DeleteSampler(sampler Sampler) {
+	c.Call("deleteSampler", sampler.jsValue())
+}
+
+// Deletes a specific sync object.
+func (c *Context2) DeleteSync(sync Sync) {
+	c.Call("deleteSync", sync.jsValue())
+}
+
+// Deletes a specific transform feedback object.
+func (c *Context2) DeleteTransformFeedback(transformFeedback TransformFeedback) {
+	c.Call("deleteTransformFeedback", transformFeedback.jsValue())
+}
+
+// Deletes a specific vertex array object.
+func (c *Context2) DeleteVertexArray(vertexArray VertexArray) {
+	c.Call("deleteVertexArray", vertexArray.jsValue())
+}
+
+// Renders instanceCount instances of the primitives from bound and enabled vertex data.
+func (c *Context2) DrawArraysInstanced(mode GLenum, first, count, instanceCount int) {
+	c.Call("drawArraysInstanced", mode, first, count, instanceCount)
+}
+
+// Specifies the color buffers that fragment shader outputs are written into.
+func (c *Context2) DrawBuffers(buffers []GLenum) {
+	c.Call("drawBuffers", buffers)
+}
+
+// Renders instanceCount instances of the primitives indexed by element array data.
+func (c *Context2) DrawElementsInstanced(mode GLenum, count int, typ GLenum, offset, instanceCount int) {
+	c.Call("drawElementsInstanced", mode, count, typ, offset, instanceCount)
+}
+
+// Renders primitives indexed by element array data whose indices lie in [start, end].
+func (c *Context2) DrawRangeElements(mode GLenum, start, end, count int, typ GLenum, offset int) {
+	c.Call("drawRangeElements", mode, start, end, count, typ, offset)
+}
+
+// Ends an asynchronous query.
+func (c *Context2) EndQuery(target GLenum) {
+	c.Call("endQuery", target)
+}
+
+// Ends the current transform feedback operation.
+func (c *Context2) EndTransformFeedback() {
+	c.Call("endTransformFeedback")
+}
+
+// Creates a sync object and inserts it into the command stream.
+func (c *Context2) FenceSync(condition, flags GLenum) Sync {
+	return Sync{wrap(c.Call("fenceSync", condition, flags))}
+}
+
+// Attaches a single layer of a 3D or array texture to a WebGLFramebuffer object.
+func (c *Context2) FramebufferTextureLayer(target, attachment GLenum, texture Texture, level, layer int) {
+	c.Call("framebufferTextureLayer", target, attachment, texture.jsValue(), level, layer)
+}
+
+// Returns the name of the uniform block at index in a program object.
+func (c *Context2) GetActiveUniformBlockName(program Program, uniformBlockIndex int) string {
+	return c.Call("getActiveUniformBlockName", program.jsValue(), uniformBlockIndex).String()
+}
+
+// Returns information about the uniform block at index in a program object.
+func (c *Context2) GetActiveUniformBlockParameter(program Program, uniformBlockIndex int, pname GLenum) *js.Object {
+	return c.Call("getActiveUniformBlockParameter", program.jsValue(), uniformBlockIndex, pname)
+}
+
+// Returns information about the uniforms at the given indices in a program object.
+func (c *Context2) GetActiveUniforms(program Program, uniformIndices []int, pname GLenum) *js.Object {
+	return c.Call("getActiveUniforms", program.jsValue(), uniformIndices, pname)
+}
+
+// Reads data from the buffer bound to target into dstData.
+func (c *Context2) GetBufferSubData(target GLenum, srcByteOffset int, dstData *js.Object) {
+	c.Call("getBufferSubData", target, srcByteOffset, dstData)
+}
+
+// Returns the color number a fragment shader output variable is bound to.
+func (c *Context2) GetFragDataLocation(program Program, name string) int {
+	return c.Call("getFragDataLocation", program.jsValue(), name).Int()
+}
+
+// Returns the value of an indexed parameter such as UNIFORM_BUFFER_BINDING.
+func (c *Context2) GetIndexedParameter(target GLenum, index int) *js.Object {
+	return c.Call("getIndexedParameter", target, index)
+}
+
+// Returns information about implementation-dependent support for an internal format.
+func (c *Context2) GetInternalformatParameter(target, internalFormat, pname GLenum) *js.Object {
+	return c.Call("getInternalformatParameter", target, internalFormat, pname)
+}
+
+// Returns an int64 parameter, such as MAX_ELEMENT_INDEX, whose value can
+// be past the range of an int. It returns a *ParamError if pname is not
+// such a parameter.
+func (c *Context2) GetParameterInt64(pname GLenum) (int64, error) {
+	if err := checkParam("GetParameterInt64", c.params, pname, ParamInt64); err != nil {
+		return 0, err
+	}
+	return int64(c.Call("getParameter", pname).Float()), nil
+}
+
+// Returns the SAMPLER_BINDING parameter, the sampler bound to the active
+// texture unit. It returns a *ParamError if pname is not such a parameter.
+func (c *Context2) GetParameterSampler(pname GLenum) (Sampler, error) {
+	if err := checkParam("GetParameterSampler", c.params, pname, ParamSampler); err != nil {
+		return Sampler{}, err
+	}
+	return Sampler{wrap(c.Call("getParameter", pname))}, nil
+}
+
+// Returns the TRANSFORM_FEEDBACK_BINDING parameter. It returns a
+// *ParamError if pname is not such a parameter.
+func (c *Context2) GetParameterTransformFeedback(pname GLenum) (TransformFeedback, error) {
+	if err := checkParam("GetParameterTransformFeedback", c.params, pname, ParamTransformFeedback); err != nil {
+		return TransformFeedback{}, err
+	}
+	return TransformFeedback{wrap(c.Call("getParameter", pname))}, nil
+}
+
+// Returns the currently active query for target, or null.
+func (c *Context2) GetQuery(target, pname GLenum) Query {
+	return Query{wrap(c.Call("getQuery", target, pname))}
+}
+
+// Returns information about a query object.
+func (c *Context2) GetQueryParameter(query Query, pname GLenum) *js.Object {
+	return c.Call("getQueryParameter", query.jsValue(), pname)
+}
+
+// Returns a parameter of a sampler object.
+func (c *Context2) GetSamplerParameter(sampler Sampler, pname GLenum) *js.Object {
+	return c.Call("getSamplerParameter", sampler.jsValue(), pname)
+}
+
+// Returns a parameter of a sync object.
+func (c *Context2) GetSyncParameter(sync Sync, pname GLenum) *js.Object {
+	return c.Call("getSyncParameter", sync.jsValue(), pname)
+}
+
+// Returns a WebGLActiveInfo object describing the transform feedback
+// varying at index in a program object.
+func (c *Context2) GetTransformFeedbackVarying(program Program, index int) *js.Object {
+	return c.Call("getTransformFeedbackVarying", program.jsValue(), index)
+}
+
+// Returns the index of a named uniform block in a program object.
+func (c *Context2) GetUniformBlockIndex(program Program, uniformBlockName string) int {
+	return c.Call("getUniformBlockIndex", program.jsValue(), uniformBlockName).Int()
+}
+
+// Returns the indices of the named uniforms in a program object.
+func (c *Context2) GetUniformIndices(program Program, uniformNames []string) []int {
+	objs := c.Call("getUniformIndices", program.jsValue(), uniformNames)
+	indices := make([]int, objs.Length())
+	for i := 0; i < objs.Length(); i++ {
+		indices[i] = objs.Index(i).Int()
+	}
+	return indices
+}
+
+// Invalidates the contents of attachments of the framebuffer bound to target.
+func (c *Context2) InvalidateFramebuffer(target GLenum, attachments []GLenum) {
+	c.Call("invalidateFramebuffer", target, attachments)
+}
+
+// Invalidates a region of attachments of the framebuffer bound to target.
+func (c *Context2) InvalidateSubFramebuffer(target GLenum, attachments []GLenum, x, y, width, height int) {
+	c.Call("invalidateSubFramebuffer", target, attachments, x, y, width, height)
+}
+
+// Returns true if query is a valid WebGLQuery, false otherwise.
+func (c *Context2) IsQuery(query Query) bool {
+	return c.Call("isQuery", query.jsValue()).Bool()
+}
+
+// Returns true if sampler is a valid WebGLSampler, false otherwise.
+func (c *Context2) IsSampler(sampler Sampler) bool {
+	return c.Call("isSampler", sampler.jsValue()).Bool()
+}
+
+// Returns true if sync is a valid WebGLSync, false otherwise.
+func (c *Context2) IsSync(sync Sync) bool {
+	return c.Call("isSync", sync.jsValue()).Bool()
+}
+
+// Returns true if transformFeedback is a valid WebGLTransformFeedback, false otherwise.
+func (c *Context2) IsTransformFeedback(transformFeedback TransformFeedback) bool {
+	return c.Call("isTransformFeedback", transformFeedback.jsValue()).Bool()
+}
+
+// Returns true if vertexArray is a valid WebGLVertexArrayObject, false otherwise.
+func (c *Context2) IsVertexArray(vertexArray VertexArray) bool {
+	return c.Call("isVertexArray", vertexArray.jsValue()).Bool()
+}
+
+// Pauses the current transform feedback operation.
+func (c *Context2) PauseTransformFeedback() {
+	c.Call("pauseTransformFeedback")
+}
+
+// Selects the color buffer used as the source for readPixels and copyTex*.
+func (c *Context2) ReadBuffer(src GLenum) {
+	c.Call("readBuffer", src)
+}
+
+// Creates or replaces the multisampled data store for the currently
+// bound WebGLRenderbuffer object.
+func (c *Context2) RenderbufferStorageMultisample(target GLenum, samples int, internalFormat GLenum, width, height int) {
+	c.Call("renderbufferStorageMultisample", target, samples, internalFormat, width, height)
+}
+
+// Resumes a paused transform feedback operation.
+func (c *Context2) ResumeTransformFeedback() {
+	c.Call("resumeTransformFeedback")
+}
+
+// Sets a floating point parameter of a sampler object.
+func (c *Context2) SamplerParameterf(sampler Sampler, pname GLenum, param float32) {
+	c.Call("samplerParameterf", sampler.jsValue(), pname, param)
+}
+
+// Sets an integer parameter of a sampler object.
+func (c *Context2) SamplerParameteri(sampler Sampler, pname GLenum, param int) {
+	c.Call("samplerParameteri", sampler.jsValue(), pname, param)
+}
+
+// Loads data, an image, canvas, video, ImageData or ImageBitmap, or a
+// typed array, into a 3D or array texture.
+func (c *Context2) TexImage3D(target GLenum, level int, internalFormat GLenum, width, height, depth, border int, format, typ GLenum, data *js.Object) {
+	c.Call("texImage3D", target, level, internalFormat, width, height, depth, border, format, typ, data)
+}
+
+// Loads width by height by depth pixels, bottom row of the first image
+// first, into a 3D or array texture. typ is usually UNSIGNED_BYTE. If
+// pixels is empty, the image is allocated and cleared to zero.
+func (c *Context2) TexImage3DBytes(target GLenum, level int, internalFormat GLenum, width, height, depth, border int, format, typ GLenum, pixels []byte) {
+	var pix *js.Object
+	if len(pixels) > 0 {
+		pix = c.views.bytesView(pixels)
+	}
+	c.Call("texImage3D", target, level, internalFormat, width, height, depth, border, format, typ, pix)
+}
+
+// Loads width by height by depth pixels, bottom row of the first image
+// first, into a 3D or array texture. typ is UNSIGNED_SHORT, HALF_FLOAT
+// or one of the packed UNSIGNED_SHORT_* types. If pixels is empty, the
+// image is allocated and cleared to zero.
+func (c *Context2) TexImage3DUint16(target GLenum, level int, internalFormat GLenum, width, height, depth, border int, format, typ GLenum, pixels []uint16) {
+	var pix *js.Object
+	if len(pixels) > 0 {
+		pix = c.views.uint16sView(pixels)
+	}
+	c.Call("texImage3D", target, level, internalFormat, width, height, depth, border, format, typ, pix)
+}
+
+// Loads width by height by depth pixels, bottom row of the first image
+// first, into a 3D or array texture. typ is FLOAT. If pixels is empty,
+// the image is allocated and cleared to zero.
+func (c *Context2) TexImage3DFloat32(target GLenum, level int, internalFormat GLenum, width, height, depth, border int, format, typ GLenum, pixels []float32) {
+	var pix *js.Object
+	if len(pixels) > 0 {
+		pix = c.views.float32sView(pixels)
+	}
+	c.Call("texImage3D", target, level, internalFormat, width, height, depth, border, format, typ, pix)
+}
+
+// Allocates immutable storage for all levels of a 2D texture.
+func (c *Context2) TexStorage2D(target GLenum, levels int, internalFormat GLenum, width, height int) {
+	c.Call("texStorage2D", target, levels, internalFormat, width, height)
+}
+
+// Allocates immutable storage for all levels of a 3D or array texture.
+func (c *Context2) TexStorage3D(target GLenum, levels int, internalFormat GLenum, width, height, depth int) {
+	c.Call("texStorage3D", target, levels, internalFormat, width, height, depth)
+}
+
+// Replaces a portion of an existing 3D or array texture image with data,
+// an image, canvas, video, ImageData or ImageBitmap, or a typed array.
+func (c *Context2) TexSubImage3D(target GLenum, level, xoffset, yoffset, zoffset, width, height, depth int, format, typ GLenum, data *js.Object) {
+	c.Call("texSubImage3D", target, level, xoffset, yoffset, zoffset, width, height, depth, format, typ, data)
+}
+
+// Replaces a width by height by depth portion of an existing 3D or
+// array texture image with pixels, bottom row of the first image first.
+// typ is usually UNSIGNED_BYTE.
+func (c *Context2) TexSubImage3DBytes(target GLenum, level, xoffset, yoffset, zoffset, width, height, depth int, format, typ GLenum, pixels []byte) {
+	if len(pixels) == 0 {
+		return
+	}
+	c.Call("texSubImage3D", target, level, xoffset, yoffset, zoffset, width, height, depth, format, typ, c.views.bytesView(pixels))
+}
+
+// Replaces a width by height by depth portion of an existing 3D or
+// array texture image with pixels, bottom row of the first image first.
+// typ is UNSIGNED_SHORT, HALF_FLOAT or one of the packed
+// UNSIGNED_SHORT_* types.
+func (c *Context2) TexSubImage3DUint16(target GLenum, level, xoffset, yoffset, zoffset, width, height, depth int, format, typ GLenum, pixels []uint16) {
+	if len(pixels) == 0 {
+		return
+	}
+	c.Call("texSubImage3D", target, level, xoffset, yoffset, zoffset, width, height, depth, format, typ, c.views.uint16sView(pixels))
+}
+
+// Replaces a width by height by depth portion of an existing 3D or
+// array texture image with pixels, bottom row of the first image first.
+// typ is FLOAT.
+func (c *Context2) TexSubImage3DFloat32(target GLenum, level, xoffset, yoffset, zoffset, width, height, depth int, format, typ GLenum, pixels []float32) {
+	if len(pixels) == 0 {
+		return
+	}
+	c.Call("texSubImage3D", target, level, xoffset, yoffset, zoffset, width, height, depth, format, typ, c.views.float32sView(pixels))
+}
+
+// Specifies the varyings captured by transform feedback.
+func (c *Context2) TransformFeedbackVaryings(program Program, varyings []string, bufferMode GLenum) {
+	c.Call("transformFeedbackVaryings", program.jsValue(), varyings, bufferMode)
+}
+
+// Assigns an unsigned integer value to a uniform variable for the current program object.
+func (c *Context2) Uniform1ui(location UniformLocation, x uint32) {
+	c.Call("uniform1ui", location.jsValue(), x)
+}
+
+// Assigns 2 unsigned integer values to a uniform variable for the current program object.
+func (c *Context2) Uniform2ui(location UniformLocation, x, y uint32) {
+	c.Call("uniform2ui", location.jsValue(), x, y)
+}
+
+// Assigns 3 unsigned integer values to a uniform variable for the current program object.
+func (c *Context2) Uniform3ui(location UniformLocation, x, y, z uint32) {
+	c.Call("uniform3ui", location.jsValue(), x, y, z)
+}
+
+// Assigns 4 unsigned integer values to a uniform variable for the current program object.
+func (c *Context2) Uniform4ui(location UniformLocation, x, y, z, w uint32) {
+	c.Call("uniform4ui", location.jsValue(), x, y, z, w)
+}
+
+// Assigns unsigned integer values to a uint uniform or uniform array.
+func (c *Context2) Uniform1uiv(location UniformLocation, value []uint32) {
+	c.Call("uniform1uiv", location.jsValue(), value)
+}
+
+// Assigns unsigned integer values to a uvec2 uniform or uniform array.
+func (c *Context2) Uniform2uiv(location UniformLocation, value []uint32) {
+	c.Call("uniform2uiv", location.jsValue(), value)
+}
+
+// Assigns unsigned integer values to a uvec3 uniform or uniform array.
+func (c *Context2) Uniform3uiv(location UniformLocation, value []uint32) {
+	c.Call("uniform3uiv", location.jsValue(), value)
+}
+
+// Assigns unsigned integer values to a uvec4 uniform or uniform array.
+func (c *Context2) Uniform4uiv(location UniformLocation, value []uint32) {
+	c.Call("uniform4uiv", location.jsValue(), value)
+}
+
+// Assigns a uniform block to a uniform buffer binding point.
+func (c *Context2) UniformBlockBinding(program Program, uniformBlockIndex, uniformBlockBinding int) {
+	c.Call("uniformBlockBinding", program.jsValue(), uniformBlockIndex, uniformBlockBinding)
+}
+
+// Sets values for a 2x3 floating point matrix into a
+// uniform location as a matrix or a matrix array.
+func (c *Context2) UniformMatrix2x3fv(location UniformLocation, transpose bool, value []float32) {
+	c.Call("uniformMatrix2x3fv", location.jsValue(), transpose, value)
+}
+
+// Sets values for a 2x4 floating point matrix into a
+// uniform location as a matrix or a matrix array.
+func (c *Context2) UniformMatrix2x4fv(location UniformLocation, transpose bool, value []float32) {
+	c.Call("uniformMatrix2x4fv", location.jsValue(), transpose, value)
+}
+
+// Sets values for a 3x2 floating point matrix into a
+// uniform location as a matrix or a matrix array.
+func (c *Context2) UniformMatrix3x2fv(location UniformLocation, transpose bool, value []float32) {
+	c.Call("uniformMatrix3x2fv", location.jsValue(), transpose, value)
+}
+
+// Sets values for a 3x4 floating point matrix into a
+// uniform location as a matrix or a matrix array.
+func (c *Context2) UniformMatrix3x4fv(location UniformLocation, transpose bool, value []float32) {
+	c.Call("uniformMatrix3x4fv", location.jsValue(), transpose, value)
+}
+
+// Sets values for a 4x2 floating point matrix into a
+// uniform location as a matrix or a matrix array.
+func (c *Context2) UniformMatrix4x2fv(location UniformLocation, transpose bool, value []float32) {
+	c.Call("uniformMatrix4x2fv", location.jsValue(), transpose, value)
+}
+
+// Sets values for a 4x3 floating point matrix into a
+// uniform location as a matrix or a matrix array.
+func (c *Context2) UniformMatrix4x3fv(location UniformLocation, transpose bool, value []float32) {
+	c.Call("uniformMatrix4x3fv", location.jsValue(), transpose, value)
+}
+
+// Sets the rate at which a generic vertex attribute advances during
+// instanced rendering. A divisor of 0 makes it advance per vertex.
+func (c *Context2) VertexAttribDivisor(index, divisor int) {
+	c.Call("vertexAttribDivisor", index, divisor)
+}
+
+// Specifies a constant signed integer value for a generic vertex attribute.
+func (c *Context2) VertexAttribI4i(index int, x, y, z, w int32) {
+	c.Call("vertexAttribI4i", index, x, y, z, w)
+}
+
+// Specifies a constant unsigned integer value for a generic vertex attribute.
+func (c *Context2) VertexAttribI4ui(index int, x, y, z, w uint32) {
+	c.Call("vertexAttribI4ui", index, x, y, z, w)
+}
+
+// Specifies the layout of an integer vertex attribute. Unlike
+// VertexAttribPointer, the values are never converted to floating point.
+func (c *Context2) VertexAttribIPointer(index, size int, typ GLenum, stride, offset int) {
+	c.Call("vertexAttribIPointer", index, size, typ, stride, offset)
+}
+
+// Waits on the GPU until the sync object is signaled.
+func (c *Context2) WaitSync(sync Sync, flags GLenum, timeout int) {
+	c.Call("waitSync", sync.jsValue(), flags, timeout)
+}
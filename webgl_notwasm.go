@@ -12,6 +12,56 @@ import (
 	"github.com/gopherjs/gopherjs/js"
 )
 
+// object is the value behind a resource handle. Handles created by Context
+// refer to a JavaScript object; handles created by other Backend
+// implementations are identified by id alone.
+type object struct {
+	v  *js.Object
+	id uint32
+}
+
+// wrap returns the handle object for v, or nil if v is null.
+func wrap(v *js.Object) *object {
+	if v == nil {
+		return nil
+	}
+	return &object{v: v}
+}
+
+// jsValue returns the JavaScript object o refers to, or null for
+// the zero handle.
+func (o *object) jsValue() *js.Object {
+	if o == nil {
+		return nil
+	}
+	return o.v
+}
+
+// Context is a WebGL 1.0 rendering context. Its enum fields hold the same
+// values as the package-level GLenum constants.
+type Context struct {
+	*js.Object
+	enums
+	views views
+
+	// params are the types of the GetParameter values, parameterTypes2
+	// for a WebGL 2.0 context.
+	params map[GLenum]ParamType
+
+	handlers    *contextHandlers
+	resources   *resources
+	renderState *RenderState
+
+	// vertexArrays emulates OES_vertex_array_object, if needed, and
+	// vertexArrayOES is the extension once VertexArrayObject enabled it.
+	vertexArrays   *vertexArrayEmulation
+	vertexArrayOES *vertexArrayExt
+
+	// instancedArrays is ANGLE_instanced_arrays once InstancedArrays
+	// enabled it.
+	instancedArrays *InstancedArrays
+}
+
 // NewContext takes an HTML5 canvas object and optional context attributes.
 // If an error is returned it means you won't have access to WebGL
 // functionality.
@@ -24,14 +74,7 @@ func NewContext(canvas *js.Object, ca *ContextAttributes) (*Context, error) {
 		ca = DefaultAttributes()
 	}
 
-	attrs := map[string]bool{
-		"alpha":                 ca.Alpha,
-		"depth":                 ca.Depth,
-		"stencil":               ca.Stencil,
-		"antialias":             ca.Antialias,
-		"premultipliedAlpha":    ca.PremultipliedAlpha,
-		"preserveDrawingBuffer": ca.PreserveDrawingBuffer,
-	}
+	attrs := ca.attributes()
 	gl := canvas.Call("getContext", "webgl", attrs)
 	if gl == nil {
 		gl = canvas.Call("getContext", "experimental-webgl", attrs)
@@ -39,7 +82,7 @@ func NewContext(canvas *js.Object, ca *ContextAttributes) (*Context, error) {
 			return nil, errors.New("Creating a webgl context has failed.")
 		}
 	}
-	ctx := new(Context)
+	ctx := &Context{enums: webgl1Enums, params: parameterTypes}
 	ctx.Object = gl
 	return ctx, nil
 }
@@ -55,43 +98,73 @@ func (c *Context) GetContextAttributes() ContextAttributes {
 		ca.Get("stencil").Bool(),
 		ca.Get("antialias").Bool(),
 		ca.Get("premultipliedAlpha").Bool(),
-		ca.Get("preservedDrawingBuffer").Bool(),
+		ca.Get("preserveDrawingBuffer").Bool(),
 	}
 }
 
+// Returns the width of the drawing buffer, which is the size of the
+// default framebuffer.
+func (c *Context) DrawingBufferWidth() int {
+	return c.Get("drawingBufferWidth").Int()
+}
+
+// Returns the height of the drawing buffer, which is the size of the
+// default framebuffer.
+func (c *Context) DrawingBufferHeight() int {
+	return c.Get("drawingBufferHeight").Int()
+}
+
 // Specifies the active texture unit.
-func (c *Context) ActiveTexture(texture int) {
+func (c *Context) ActiveTexture(texture GLenum) {
+	if c.resources != nil {
+		c.resources.activeTexture(texture)
+	}
 	c.Call("activeTexture", texture)
 }
 
 // Attaches a WebGLShader object to a WebGLProgram object.
-func (c *Context) AttachShader(program *js.Object, shader *js.Object) {
-	c.Call("attachShader", program, shader)
+func (c *Context) AttachShader(program Program, shader Shader) {
+	if c.resources != nil {
+		c.resources.attachShader(program.object, shader.object)
+	}
+	c.Call("attachShader", program.jsValue(), shader.jsValue())
 }
 
 // Binds a generic vertex index to a user-defined attribute variable.
-func (c *Context) BindAttribLocation(program *js.Object, index int, name string) {
-	c.Call("bindAttribLocation", program, index, name)
+func (c *Context) BindAttribLocation(program Program, index int, name string) {
+	if c.resources != nil {
+		c.resources.bindAttribLocation(program.object, index, name)
+	}
+	c.Call("bindAttribLocation", program.jsValue(), index, name)
 }
 
 // Associates a buffer with a buffer target.
-func (c *Context) BindBuffer(target int, buffer *js.Object) {
-	c.Call("bindBuffer", target, buffer)
+func (c *Context) BindBuffer(target GLenum, buffer Buffer) {
+	if c.resources != nil {
+		c.resources.bindBuffer(target, buffer.object)
+	}
+	if c.vertexArrays != nil {
+		c.vertexArrays.bindBuffer(target, buffer)
+	}
+	c.Call("bindBuffer", target, buffer.jsValue())
 }
 
 // Associates a WebGLFramebuffer object with the FRAMEBUFFER bind target.
-func (c *Context) BindFramebuffer(target int, framebuffer *js.Object) {
-	c.Call("bindFramebuffer", target, framebuffer)
+func (c *Context) BindFramebuffer(target GLenum, framebuffer Framebuffer) {
+	c.Call("bindFramebuffer", target, framebuffer.jsValue())
 }
 
 // Binds a WebGLRenderbuffer object to be used for rendering.
-func (c *Context) BindRenderbuffer(target int, renderbuffer *js.Object) {
-	c.Call("bindRenderbuffer", target, renderbuffer)
+func (c *Context) BindRenderbuffer(target GLenum, renderbuffer Renderbuffer) {
+	c.Call("bindRenderbuffer", target, renderbuffer.jsValue())
 }
 
 // Binds a named texture object to a target.
-func (c *Context) BindTexture(target int, texture *js.Object) {
-	c.Call("bindTexture", target, texture)
+func (c *Context) BindTexture(target GLenum, texture Texture) {
+	if c.resources != nil {
+		c.resources.bindTexture(target, texture.object)
+	}
+	c.Call("bindTexture", target, texture.jsValue())
 }
 
 // The GL_BLEND_COLOR may be used to calculate the source and destination blending factors.
@@ -101,45 +174,156 @@ func (c *Context) BlendColor(r, g, b, a float64) {
 
 // Sets the equation used to blend RGB and Alpha values of an incoming source
 // fragment with a destination values as stored in the fragment's frame buffer.
-func (c *Context) BlendEquation(mode int) {
+func (c *Context) BlendEquation(mode GLenum) {
 	c.Call("blendEquation", mode)
 }
 
 // Controls the blending of an incoming source fragment's R, G, B, and A values
 // with a destination R, G, B, and A values as stored in the fragment's WebGLFramebuffer.
-func (c *Context) BlendEquationSeparate(modeRGB, modeAlpha int) {
+func (c *Context) BlendEquationSeparate(modeRGB, modeAlpha GLenum) {
 	c.Call("blendEquationSeparate", modeRGB, modeAlpha)
 }
 
 // Sets the blending factors used to combine source and destination pixels.
-func (c *Context) BlendFunc(sfactor, dfactor int) {
+func (c *Context) BlendFunc(sfactor, dfactor GLenum) {
 	c.Call("blendFunc", sfactor, dfactor)
 }
 
 // Sets the weighting factors that are used by blendEquationSeparate.
-func (c *Context) BlendFuncSeparate(srcRGB, dstRGB, srcAlpha, dstAlpha int) {
+func (c *Context) BlendFuncSeparate(srcRGB, dstRGB, srcAlpha, dstAlpha GLenum) {
 	c.Call("blendFuncSeparate", srcRGB, dstRGB, srcAlpha, dstAlpha)
 }
 
 // Creates a buffer in memory and initializes it with array data.
 // If no array is provided, the contents of the buffer is initialized to 0.
-func (c *Context) BufferData(target int, data interface{}, usage int) {
-	c.Call("bufferData", target, data, usage)
+// A size in bytes, or a []byte, []uint16 or []float32 is passed on as
+// BufferDataSize or the typed variants do.
+func (c *Context) BufferData(target GLenum, data interface{}, usage GLenum) {
+	switch data := data.(type) {
+	case int:
+		c.BufferDataSize(target, data, usage)
+	case []byte:
+		c.BufferDataBytes(target, data, usage)
+	case []uint16:
+		c.BufferDataUint16(target, data, usage)
+	case []float32:
+		c.BufferDataFloat32(target, data, usage)
+	default:
+		if c.resources != nil {
+			c.resources.bufferData(target, jsBytes(data), usage)
+		}
+		c.Call("bufferData", target, data, usage)
+	}
+}
+
+// Creates a buffer in memory of size bytes, initialized to 0.
+func (c *Context) BufferDataSize(target GLenum, size int, usage GLenum) {
+	if c.resources != nil && size >= 0 {
+		c.resources.bufferData(target, make([]byte, size), usage)
+	}
+	c.Call("bufferData", target, size, usage)
+}
+
+// Creates a buffer in memory and initializes it with a copy of data.
+func (c *Context) BufferDataBytes(target GLenum, data []byte, usage GLenum) {
+	if len(data) == 0 {
+		c.BufferDataSize(target, 0, usage)
+		return
+	}
+	if c.resources != nil {
+		c.resources.bufferData(target, append([]byte(nil), data...), usage)
+	}
+	c.Call("bufferData", target, c.views.bytesView(data), usage)
+}
+
+// Creates a buffer in memory and initializes it with a copy of data.
+func (c *Context) BufferDataUint16(target GLenum, data []uint16, usage GLenum) {
+	if len(data) == 0 {
+		c.BufferDataSize(target, 0, usage)
+		return
+	}
+	if c.resources != nil {
+		c.resources.bufferData(target, uint16Bytes(data), usage)
+	}
+	c.Call("bufferData", target, c.views.uint16sView(data), usage)
+}
+
+// Creates a buffer in memory and initializes it with a copy of data.
+func (c *Context) BufferDataFloat32(target GLenum, data []float32, usage GLenum) {
+	if len(data) == 0 {
+		c.BufferDataSize(target, 0, usage)
+		return
+	}
+	if c.resources != nil {
+		c.resources.bufferData(target, float32Bytes(data), usage)
+	}
+	c.Call("bufferData", target, c.views.float32sView(data), usage)
 }
 
 // Used to modify or update some or all of a data store for a bound buffer object.
-func (c *Context) BufferSubData(target int, offset int, data interface{}) {
-	c.Call("bufferSubData", target, offset, data)
+// A []byte, []uint16 or []float32 is passed on as the typed variants do.
+func (c *Context) BufferSubData(target GLenum, offset int, data interface{}) {
+	switch data := data.(type) {
+	case []byte:
+		c.BufferSubDataBytes(target, offset, data)
+	case []uint16:
+		c.BufferSubDataUint16(target, offset, data)
+	case []float32:
+		c.BufferSubDataFloat32(target, offset, data)
+	default:
+		if c.resources != nil {
+			data := jsBytes(data)
+			copy(c.resources.bufferRange(target, offset, len(data)), data)
+		}
+		c.Call("bufferSubData", target, offset, data)
+	}
+}
+
+// Copies data into the data store of the bound buffer, starting at byte offset.
+func (c *Context) BufferSubDataBytes(target GLenum, offset int, data []byte) {
+	if len(data) == 0 {
+		return
+	}
+	if c.resources != nil {
+		copy(c.resources.bufferRange(target, offset, len(data)), data)
+	}
+	c.Call("bufferSubData", target, offset, c.views.bytesView(data))
+}
+
+// Copies data into the data store of the bound buffer, starting at byte offset.
+func (c *Context) BufferSubDataUint16(target GLenum, offset int, data []uint16) {
+	if len(data) == 0 {
+		return
+	}
+	if c.resources != nil {
+		if b := c.resources.bufferRange(target, offset, 2*len(data)); b != nil {
+			putUint16s(b, data)
+		}
+	}
+	c.Call("bufferSubData", target, offset, c.views.uint16sView(data))
+}
+
+// Copies data into the data store of the bound buffer, starting at byte offset.
+func (c *Context) BufferSubDataFloat32(target GLenum, offset int, data []float32) {
+	if len(data) == 0 {
+		return
+	}
+	if c.resources != nil {
+		if b := c.resources.bufferRange(target, offset, 4*len(data)); b != nil {
+			putFloat32s(b, data)
+		}
+	}
+	c.Call("bufferSubData", target, offset, c.views.float32sView(data))
 }
 
 // Returns whether the currently bound WebGLFramebuffer is complete.
 // If not complete, returns the reason why.
-func (c *Context) CheckFramebufferStatus(target int) int {
-	return c.Call("checkFramebufferStatus", target).Int()
+func (c *Context) CheckFramebufferStatus(target GLenum) GLenum {
+	return GLenum(c.Call("checkFramebufferStatus", target).Int())
 }
 
 // Sets all pixels in a specific buffer to the same value.
-func (c *Context) Clear(flags int) {
+func (c *Context) Clear(flags GLenum) {
 	c.Call("clear", flags)
 }
 
@@ -164,96 +348,135 @@ func (c *Context) ColorMask(r, g, b, a bool) {
 }
 
 // Compiles the GLSL shader source into binary data used by the WebGLProgram object.
-func (c *Context) CompileShader(shader *js.Object) {
-	c.Call("compileShader", shader)
+func (c *Context) CompileShader(shader Shader) {
+	if c.resources != nil {
+		c.resources.compileShader(shader.object)
+	}
+	c.Call("compileShader", shader.jsValue())
 }
 
 // Copies a rectangle of pixels from the current WebGLFramebuffer into a texture image.
-func (c *Context) CopyTexImage2D(target, level, internal, x, y, w, h, border int) {
+func (c *Context) CopyTexImage2D(target GLenum, level int, internal GLenum, x, y, w, h, border int) {
+	if c.resources != nil {
+		c.resources.texImage(target, level, func() {
+			c.TexImage2DBytes(target, level, internal, w, h, border, internal, UNSIGNED_BYTE, nil)
+		})
+	}
 	c.Call("copyTexImage2D", target, level, internal, x, y, w, h, border)
 }
 
 // Replaces a portion of an existing 2D texture image with data from the current framebuffer.
-func (c *Context) CopyTexSubImage2D(target, level, xoffset, yoffset, x, y, w, h int) {
+func (c *Context) CopyTexSubImage2D(target GLenum, level, xoffset, yoffset, x, y, w, h int) {
 	c.Call("copyTexSubImage2D", target, level, xoffset, yoffset, x, y, w, h)
 }
 
 // Creates and initializes a WebGLBuffer.
-func (c *Context) CreateBuffer() *js.Object {
-	return c.Call("createBuffer")
+func (c *Context) CreateBuffer() Buffer {
+	b := Buffer{wrap(c.Call("createBuffer"))}
+	if c.resources != nil {
+		c.resources.createBuffer(b.object)
+	}
+	return b
 }
 
 // Returns a WebGLFramebuffer object.
-func (c *Context) CreateFramebuffer() *js.Object {
-	return c.Call("createFramebuffer")
+func (c *Context) CreateFramebuffer() Framebuffer {
+	return Framebuffer{wrap(c.Call("createFramebuffer"))}
 }
 
 // Creates an empty WebGLProgram object to which vector and fragment
 // WebGLShader objects can be bound.
-func (c *Context) CreateProgram() *js.Object {
-	return c.Call("createProgram")
+func (c *Context) CreateProgram() Program {
+	p := Program{wrap(c.Call("createProgram"))}
+	if c.resources != nil {
+		c.resources.createProgram(p.object)
+	}
+	return p
 }
 
 // Creates and returns a WebGLRenderbuffer object.
-func (c *Context) CreateRenderbuffer() *js.Object {
-	return c.Call("createRenderbuffer")
+func (c *Context) CreateRenderbuffer() Renderbuffer {
+	return Renderbuffer{wrap(c.Call("createRenderbuffer"))}
 }
 
 // Returns an empty vertex or fragment shader object based on the type specified.
-func (c *Context) CreateShader(typ int) *js.Object {
-	return c.Call("createShader", typ)
+func (c *Context) CreateShader(typ GLenum) Shader {
+	s := Shader{wrap(c.Call("createShader", typ))}
+	if c.resources != nil {
+		c.resources.createShader(s.object, typ)
+	}
+	return s
 }
 
 // Used to generate a WebGLTexture object to which images can be bound.
-func (c *Context) CreateTexture() *js.Object {
-	return c.Call("createTexture")
+func (c *Context) CreateTexture() Texture {
+	t := Texture{wrap(c.Call("createTexture"))}
+	if c.resources != nil {
+		c.resources.createTexture(t.object)
+	}
+	return t
 }
 
 // Sets whether or not front, back, or both facing facets are able to be culled.
-func (c *Context) CullFace(mode int) {
+func (c *Context) CullFace(mode GLenum) {
 	c.Call("cullFace", mode)
 }
 
 // Delete a specific buffer.
-func (c *Context) DeleteBuffer(buffer *js.Object) {
-	c.Call("deleteBuffer", buffer)
+func (c *Context) DeleteBuffer(buffer Buffer) {
+	if c.resources != nil {
+		c.resources.deleteBuffer(buffer.object)
+	}
+	if c.vertexArrays != nil {
+		c.vertexArrays.deleteBuffer(buffer)
+	}
+	c.Call("deleteBuffer", buffer.jsValue())
 }
 
 // Deletes a specific WebGLFramebuffer object. If you delete the
 // currently bound framebuffer, the default framebuffer will be bound.
 // Deleting a framebuffer detaches all of its attachments.
-func (c *Context) DeleteFramebuffer(framebuffer *js.Object) {
-	c.Call("deleteFramebuffer", framebuffer)
+func (c *Context) DeleteFramebuffer(framebuffer Framebuffer) {
+	c.Call("deleteFramebuffer", framebuffer.jsValue())
 }
 
 // Flags a specific WebGLProgram object for deletion if currently active.
 // It will be deleted when it is no longer being used.
 // Any shader objects associated with the program will be detached.
 // They will be deleted if they were already flagged for deletion.
-func (c *Context) DeleteProgram(program *js.Object) {
-	c.Call("deleteProgram", program)
+func (c *Context) DeleteProgram(program Program) {
+	if c.resources != nil {
+		c.resources.deleteProgram(program.object)
+	}
+	c.Call("deleteProgram", program.jsValue())
 }
 
 // Deletes the specified renderbuffer object. If the renderbuffer is
 // currently bound, it will become unbound. If the renderbuffer is
 // attached to the currently bound framebuffer, it is detached.
-func (c *Context) DeleteRenderbuffer(renderbuffer *js.Object) {
-	c.Call("deleteRenderbuffer", renderbuffer)
+func (c *Context) DeleteRenderbuffer(renderbuffer Renderbuffer) {
+	c.Call("deleteRenderbuffer", renderbuffer.jsValue())
 }
 
 // Deletes a specific shader object.
-func (c *Context) DeleteShader(shader *js.Object) {
-	c.Call("deleteShader", shader)
+func (c *Context) DeleteShader(shader Shader) {
+	if c.resources != nil {
+		c.resources.deleteShader(shader.object)
+	}
+	c.Call("deleteShader", shader.jsValue())
 }
 
 // Deletes a specific texture object.
-func (c *Context) DeleteTexture(texture *js.Object) {
-	c.Call("deleteTexture", texture)
+func (c *Context) DeleteTexture(texture Texture) {
+	if c.resources != nil {
+		c.resources.deleteTexture(texture.object)
+	}
+	c.Call("deleteTexture", texture.jsValue())
 }
 
 // Sets a function to use to compare incoming pixel depth to the
 // current depth buffer value.
-func (c *Context) DepthFunc(fun int) {
+func (c *Context) DepthFunc(fun GLenum) {
 	c.Call("depthFunc", fun)
 }
 
@@ -268,38 +491,47 @@ func (c *Context) DepthRange(zNear, zFar float64) {
 }
 
 // Detach a shader object from a program object.
-func (c *Context) DetachShader(program, shader *js.Object) {
-	c.Call("detachShader", program, shader)
+func (c *Context) DetachShader(program Program, shader Shader) {
+	if c.resources != nil {
+		c.resources.detachShader(program.object, shader.object)
+	}
+	c.Call("detachShader", program.jsValue(), shader.jsValue())
 }
 
 // Turns off specific WebGL capabilities for this context.
-func (c *Context) Disable(cap int) {
+func (c *Context) Disable(cap GLenum) {
 	c.Call("disable", cap)
 }
 
 // Turns off a vertex attribute array at a specific index position.
 func (c *Context) DisableVertexAttribArray(index int) {
+	if c.vertexArrays != nil {
+		c.vertexArrays.enableAttrib(index, false)
+	}
 	c.Call("disableVertexAttribArray", index)
 }
 
 // Render geometric primitives from bound and enabled vertex data.
-func (c *Context) DrawArrays(mode, first, count int) {
+func (c *Context) DrawArrays(mode GLenum, first, count int) {
 	c.Call("drawArrays", mode, first, count)
 }
 
 // Renders geometric primitives indexed by element array data.
-func (c *Context) DrawElements(mode, count, typ, offset int) {
+func (c *Context) DrawElements(mode GLenum, count int, typ GLenum, offset int) {
 	c.Call("drawElements", mode, count, typ, offset)
 }
 
 // Turns on specific WebGL capabilities for this context.
-func (c *Context) Enable(cap int) {
+func (c *Context) Enable(cap GLenum) {
 	c.Call("enable", cap)
 }
 
 // Turns on a vertex attribute at a specific index position in
 // a vertex attribute array.
 func (c *Context) EnableVertexAttribArray(index int) {
+	if c.vertexArrays != nil {
+		c.vertexArrays.enableAttrib(index, true)
+	}
 	c.Call("enableVertexAttribArray", index)
 }
 
@@ -313,126 +545,398 @@ func (c *Context) Flush() {
 
 // Attaches a WebGLRenderbuffer object as a logical buffer to the
 // currently bound WebGLFramebuffer object.
-func (c *Context) FrameBufferRenderBuffer(target, attachment, renderbufferTarget int, renderbuffer *js.Object) {
-	c.Call("framebufferRenderBuffer", target, attachment, renderbufferTarget, renderbuffer)
+func (c *Context) FramebufferRenderbuffer(target, attachment, renderbufferTarget GLenum, renderbuffer Renderbuffer) {
+	c.Call("framebufferRenderbuffer", target, attachment, renderbufferTarget, renderbuffer.jsValue())
+}
+
+// FrameBufferRenderBuffer is the former name of FramebufferRenderbuffer.
+//
+// Deprecated: Use FramebufferRenderbuffer.
+func (c *Context) FrameBufferRenderBuffer(target, attachment, renderbufferTarget GLenum, renderbuffer Renderbuffer) {
+	c.FramebufferRenderbuffer(target, attachment, renderbufferTarget, renderbuffer)
 }
 
 // Attaches a texture to a WebGLFramebuffer object.
-func (c *Context) FramebufferTexture2D(target, attachment, textarget int, texture *js.Object, level int) {
-	c.Call("framebufferTexture2D", target, attachment, textarget, texture, level)
+func (c *Context) FramebufferTexture2D(target, attachment, textarget GLenum, texture Texture, level int) {
+	c.Call("framebufferTexture2D", target, attachment, textarget, texture.jsValue(), level)
 }
 
 // Sets whether or not polygons are considered front-facing based
 // on their winding direction.
-func (c *Context) FrontFace(mode int) {
+func (c *Context) FrontFace(mode GLenum) {
 	c.Call("frontFace", mode)
 }
 
 // Creates a set of textures for a WebGLTexture object with image
 // dimensions from the original size of the image down to a 1x1 image.
-func (c *Context) GenerateMipmap(target int) {
+func (c *Context) GenerateMipmap(target GLenum) {
+	if c.resources != nil {
+		c.resources.generateMipmap(target, func() { c.GenerateMipmap(target) })
+	}
 	c.Call("generateMipmap", target)
 }
 
 // Returns an WebGLActiveInfo object containing the size, type, and name
 // of a vertex attribute at a specific index position in a program object.
-func (c *Context) GetActiveAttrib(program *js.Object, index int) *js.Object {
-	return c.Call("getActiveAttrib", program, index)
+// GetActiveAttribInfo returns it as an ActiveInfo.
+func (c *Context) GetActiveAttrib(program Program, index int) *js.Object {
+	return c.Call("getActiveAttrib", program.jsValue(), index)
+}
+
+// Returns the name, size and type of the active attribute at index in
+// program, or a zero ActiveInfo if index is out of range.
+func (c *Context) GetActiveAttribInfo(program Program, index int) ActiveInfo {
+	return activeInfo(c.Call("getActiveAttrib", program.jsValue(), index))
 }
 
 // Returns an WebGLActiveInfo object containing the size, type, and name
 // of a uniform attribute at a specific index position in a program object.
-func (c *Context) GetActiveUniform(program *js.Object, index int) *js.Object {
-	return c.Call("getActiveUniform", program, index)
+// GetActiveUniformInfo returns it as an ActiveInfo.
+func (c *Context) GetActiveUniform(program Program, index int) *js.Object {
+	return c.Call("getActiveUniform", program.jsValue(), index)
+}
+
+// Returns the name, size and type of the active uniform at index in
+// program, or a zero ActiveInfo if index is out of range.
+func (c *Context) GetActiveUniformInfo(program Program, index int) ActiveInfo {
+	return activeInfo(c.Call("getActiveUniform", program.jsValue(), index))
 }
 
 // Returns a slice of WebGLShaders bound to a WebGLProgram.
-func (c *Context) GetAttachedShaders(program *js.Object) []*js.Object {
-	objs := c.Call("getAttachedShaders", program)
-	shaders := make([]*js.Object, objs.Length())
+func (c *Context) GetAttachedShaders(program Program) []Shader {
+	objs := c.Call("getAttachedShaders", program.jsValue())
+	shaders := make([]Shader, objs.Length())
 	for i := 0; i < objs.Length(); i++ {
-		shaders[i] = objs.Index(i)
+		shaders[i] = Shader{wrap(objs.Index(i))}
 	}
 	return shaders
 }
 
 // Returns an index to the location in a program of a named attribute variable.
-func (c *Context) GetAttribLocation(program *js.Object, name string) int {
-	return c.Call("getAttribLocation", program, name).Int()
+func (c *Context) GetAttribLocation(program Program, name string) int {
+	return c.Call("getAttribLocation", program.jsValue(), name).Int()
 }
 
-// TODO: Create type specific variations.
 // Returns the type of a parameter for a given buffer.
-func (c *Context) GetBufferParameter(target, pname int) *js.Object {
+// The typed GetBufferParameter* methods check the type of pname.
+func (c *Context) GetBufferParameter(target, pname GLenum) *js.Object {
 	return c.Call("getBufferParameter", target, pname)
 }
 
-// TODO: Create type specific variations.
+// Returns the BUFFER_SIZE of the buffer bound to target. It returns a
+// *ParamError if pname is not such a parameter.
+func (c *Context) GetBufferParameterInt(target, pname GLenum) (int, error) {
+	if err := checkParam("GetBufferParameterInt", bufferParameterTypes, pname, ParamInt); err != nil {
+		return 0, err
+	}
+	return c.Call("getBufferParameter", target, pname).Int(), nil
+}
+
+// Returns the BUFFER_USAGE of the buffer bound to target. It returns a
+// *ParamError if pname is not such a parameter.
+func (c *Context) GetBufferParameterEnum(target, pname GLenum) (GLenum, error) {
+	if err := checkParam("GetBufferParameterEnum", bufferParameterTypes, pname, ParamEnum); err != nil {
+		return 0, err
+	}
+	return GLenum(c.Call("getBufferParameter", target, pname).Int()), nil
+}
+
 // Returns the natural type value for a constant parameter.
-func (c *Context) GetParameter(pname int) *js.Object {
+// The typed GetParameter* methods check the type of pname.
+func (c *Context) GetParameter(pname GLenum) *js.Object {
 	return c.Call("getParameter", pname)
 }
 
+// Returns a bool parameter, such as DEPTH_TEST. It returns a *ParamError
+// if pname is not such a parameter.
+func (c *Context) GetParameterBool(pname GLenum) (bool, error) {
+	if err := checkParam("GetParameterBool", c.params, pname, ParamBool); err != nil {
+		return false, err
+	}
+	return c.Call("getParameter", pname).Bool(), nil
+}
+
+// Returns an int parameter, such as MAX_TEXTURE_SIZE. It returns a
+// *ParamError if pname is not such a parameter.
+func (c *Context) GetParameterInt(pname GLenum) (int, error) {
+	if err := checkParam("GetParameterInt", c.params, pname, ParamInt); err != nil {
+		return 0, err
+	}
+	return c.Call("getParameter", pname).Int(), nil
+}
+
+// Returns a mask parameter, such as STENCIL_WRITEMASK. It returns a
+// *ParamError if pname is not such a parameter.
+func (c *Context) GetParameterMask(pname GLenum) (uint32, error) {
+	if err := checkParam("GetParameterMask", c.params, pname, ParamMask); err != nil {
+		return 0, err
+	}
+	// The mask is a number up to 2^32-1, which Int would not return as
+	// the same bit pattern on every target.
+	return uint32(c.Call("getParameter", pname).Float()), nil
+}
+
+// Returns an enum parameter, such as BLEND_SRC_RGB. It returns a
+// *ParamError if pname is not such a parameter.
+func (c *Context) GetParameterEnum(pname GLenum) (GLenum, error) {
+	if err := checkParam("GetParameterEnum", c.params, pname, ParamEnum); err != nil {
+		return 0, err
+	}
+	return GLenum(c.Call("getParameter", pname).Int()), nil
+}
+
+// Returns a float parameter, such as LINE_WIDTH. It returns a *ParamError
+// if pname is not such a parameter.
+func (c *Context) GetParameterFloat(pname GLenum) (float32, error) {
+	if err := checkParam("GetParameterFloat", c.params, pname, ParamFloat); err != nil {
+		return 0, err
+	}
+	return float32(c.Call("getParameter", pname).Float()), nil
+}
+
+// Returns a string parameter, such as VERSION. It returns a *ParamError if
+// pname is not such a parameter.
+func (c *Context) GetParameterString(pname GLenum) (string, error) {
+	if err := checkParam("GetParameterString", c.params, pname, ParamString); err != nil {
+		return "", err
+	}
+	return c.Call("getParameter", pname).String(), nil
+}
+
+// Returns a bool array parameter, such as COLOR_WRITEMASK. It returns a
+// *ParamError if pname is not such a parameter.
+func (c *Context) GetParameterBools(pname GLenum) ([]bool, error) {
+	if err := checkParam("GetParameterBools", c.params, pname, ParamBools); err != nil {
+		return nil, err
+	}
+	return toBools(c.Call("getParameter", pname)), nil
+}
+
+// Returns an int array parameter, such as VIEWPORT. It returns a
+// *ParamError if pname is not such a parameter.
+func (c *Context) GetParameterInts(pname GLenum) ([]int, error) {
+	if err := checkParam("GetParameterInts", c.params, pname, ParamInts); err != nil {
+		return nil, err
+	}
+	return toInts(c.Call("getParameter", pname)), nil
+}
+
+// Returns a float array parameter, such as COLOR_CLEAR_VALUE. It returns a
+// *ParamError if pname is not such a parameter.
+func (c *Context) GetParameterFloats(pname GLenum) ([]float32, error) {
+	if err := checkParam("GetParameterFloats", c.params, pname, ParamFloats); err != nil {
+		return nil, err
+	}
+	return toFloats(c.Call("getParameter", pname)), nil
+}
+
+// Returns a buffer binding parameter, such as ARRAY_BUFFER_BINDING. It
+// returns a *ParamError if pname is not such a parameter.
+func (c *Context) GetParameterBuffer(pname GLenum) (Buffer, error) {
+	if err := checkParam("GetParameterBuffer", c.params, pname, ParamBuffer); err != nil {
+		return Buffer{}, err
+	}
+	return Buffer{wrap(c.Call("getParameter", pname))}, nil
+}
+
+// Returns the FRAMEBUFFER_BINDING parameter. It returns a *ParamError if
+// pname is not such a parameter.
+func (c *Context) GetParameterFramebuffer(pname GLenum) (Framebuffer, error) {
+	if err := checkParam("GetParameterFramebuffer", c.params, pname, ParamFramebuffer); err != nil {
+		return Framebuffer{}, err
+	}
+	return Framebuffer{wrap(c.Call("getParameter", pname))}, nil
+}
+
+// Returns the CURRENT_PROGRAM parameter. It returns a *ParamError if pname
+// is not such a parameter.
+func (c *Context) GetParameterProgram(pname GLenum) (Program, error) {
+	if err := checkParam("GetParameterProgram", c.params, pname, ParamProgram); err != nil {
+		return Program{}, err
+	}
+	return Program{wrap(c.Call("getParameter", pname))}, nil
+}
+
+// Returns the RENDERBUFFER_BINDING parameter. It returns a *ParamError if
+// pname is not such a parameter.
+func (c *Context) GetParameterRenderbuffer(pname GLenum) (Renderbuffer, error) {
+	if err := checkParam("GetParameterRenderbuffer", c.params, pname, ParamRenderbuffer); err != nil {
+		return Renderbuffer{}, err
+	}
+	return Renderbuffer{wrap(c.Call("getParameter", pname))}, nil
+}
+
+// Returns a texture binding parameter, such as TEXTURE_BINDING_2D. It
+// returns a *ParamError if pname is not such a parameter.
+func (c *Context) GetParameterTexture(pname GLenum) (Texture, error) {
+	if err := checkParam("GetParameterTexture", c.params, pname, ParamTexture); err != nil {
+		return Texture{}, err
+	}
+	return Texture{wrap(c.Call("getParameter", pname))}, nil
+}
+
+// Returns the VERTEX_ARRAY_BINDING_OES parameter, the vertex array bound
+// through VertexArrayObject, whether or not it is emulated. On a Context2
+// it returns VERTEX_ARRAY_BINDING, which has the same value. It returns a
+// *ParamError if pname is not such a parameter.
+func (c *Context) GetParameterVertexArray(pname GLenum) (VertexArray, error) {
+	if err := checkParam("GetParameterVertexArray", c.params, pname, ParamVertexArray); err != nil {
+		return VertexArray{}, err
+	}
+	if c.vertexArrays != nil {
+		return c.vertexArrays.bound, nil
+	}
+	return VertexArray{wrap(c.Call("getParameter", pname))}, nil
+}
+
 // Returns a value for the WebGL error flag and clears the flag.
-func (c *Context) GetError() int {
-	return c.Call("getError").Int()
+func (c *Context) GetError() ErrorCode {
+	return ErrorCode(c.Call("getError").Int())
 }
 
-// TODO: Create type specific variations.
-// Enables a passed extension, otherwise returns null.
+// Enables a passed extension, otherwise returns null. InstancedArrays,
+// VertexArrayObject and DrawBuffers return typed versions of the
+// extensions they are named after.
 func (c *Context) GetExtension(name string) *js.Object {
 	return c.Call("getExtension", name)
 }
 
-// TODO: Create type specific variations.
 // Gets a parameter value for a given target and attachment.
-func (c *Context) GetFramebufferAttachmentParameter(target, attachment, pname int) *js.Object {
+// The typed GetFramebufferAttachmentParameter* methods check the type of pname.
+func (c *Context) GetFramebufferAttachmentParameter(target, attachment, pname GLenum) *js.Object {
 	return c.Call("getFramebufferAttachmentParameter", target, attachment, pname)
 }
 
+// Returns an int parameter of a framebuffer attachment, such as
+// FRAMEBUFFER_ATTACHMENT_TEXTURE_LEVEL. It returns a *ParamError if pname
+// is not such a parameter.
+func (c *Context) GetFramebufferAttachmentParameterInt(target, attachment, pname GLenum) (int, error) {
+	if err := checkParam("GetFramebufferAttachmentParameterInt", framebufferAttachmentParameterTypes, pname, ParamInt); err != nil {
+		return 0, err
+	}
+	return c.Call("getFramebufferAttachmentParameter", target, attachment, pname).Int(), nil
+}
+
+// Returns an enum parameter of a framebuffer attachment, such as
+// FRAMEBUFFER_ATTACHMENT_OBJECT_TYPE. It returns a *ParamError if pname is
+// not such a parameter.
+func (c *Context) GetFramebufferAttachmentParameterEnum(target, attachment, pname GLenum) (GLenum, error) {
+	if err := checkParam("GetFramebufferAttachmentParameterEnum", framebufferAttachmentParameterTypes, pname, ParamEnum); err != nil {
+		return 0, err
+	}
+	return GLenum(c.Call("getFramebufferAttachmentParameter", target, attachment, pname).Int()), nil
+}
+
+// Returns the FRAMEBUFFER_ATTACHMENT_OBJECT_NAME parameter of a framebuffer
+// attachment: the texture or the renderbuffer attached, as told by
+// FRAMEBUFFER_ATTACHMENT_OBJECT_TYPE, and the zero value of the other. It
+// returns a *ParamError if pname is not such a parameter.
+func (c *Context) GetFramebufferAttachmentParameterObject(target, attachment, pname GLenum) (Texture, Renderbuffer, error) {
+	if err := checkParam("GetFramebufferAttachmentParameterObject", framebufferAttachmentParameterTypes, pname, ParamObject); err != nil {
+		return Texture{}, Renderbuffer{}, err
+	}
+	// Querying the name of an attachment point with nothing attached is
+	// an INVALID_ENUM error, so the type is checked first.
+	switch GLenum(c.Call("getFramebufferAttachmentParameter", target, attachment, FRAMEBUFFER_ATTACHMENT_OBJECT_TYPE).Int()) {
+	case TEXTURE:
+		return Texture{wrap(c.Call("getFramebufferAttachmentParameter", target, attachment, pname))}, Renderbuffer{}, nil
+	case RENDERBUFFER:
+		return Texture{}, Renderbuffer{wrap(c.Call("getFramebufferAttachmentParameter", target, attachment, pname))}, nil
+	}
+	return Texture{}, Renderbuffer{}, nil
+}
+
 // Returns the value of the program parameter that corresponds to a supplied pname
 // which is interpreted as an int.
-func (c *Context) GetProgramParameteri(program *js.Object, pname int) int {
-	return c.Call("getProgramParameter", program, pname).Int()
+func (c *Context) GetProgramParameteri(program Program, pname GLenum) int {
+	return c.Call("getProgramParameter", program.jsValue(), pname).Int()
 }
 
 // Returns the value of the program parameter that corresponds to a supplied pname
 // which is interpreted as a bool.
-func (c *Context) GetProgramParameterb(program *js.Object, pname int) bool {
-	return c.Call("getProgramParameter", program, pname).Bool()
+func (c *Context) GetProgramParameterb(program Program, pname GLenum) bool {
+	return c.Call("getProgramParameter", program.jsValue(), pname).Bool()
 }
 
 // Returns information about the last error that occurred during
 // the failed linking or validation of a WebGL program object.
-func (c *Context) GetProgramInfoLog(program *js.Object) string {
-	return c.Call("getProgramInfoLog", program).String()
+func (c *Context) GetProgramInfoLog(program Program) string {
+	return c.Call("getProgramInfoLog", program.jsValue()).String()
 }
 
-// TODO: Create type specific variations.
 // Returns a renderbuffer parameter from the currently bound WebGLRenderbuffer object.
-func (c *Context) GetRenderbufferParameter(target, pname int) *js.Object {
+// The typed GetRenderbufferParameter* methods check the type of pname.
+func (c *Context) GetRenderbufferParameter(target, pname GLenum) *js.Object {
 	return c.Call("getRenderbufferParameter", target, pname)
 }
 
-// TODO: Create type specific variations.
+// Returns an int parameter of the bound renderbuffer, such as
+// RENDERBUFFER_WIDTH. It returns a *ParamError if pname is not such a
+// parameter.
+func (c *Context) GetRenderbufferParameterInt(target, pname GLenum) (int, error) {
+	if err := checkParam("GetRenderbufferParameterInt", renderbufferParameterTypes, pname, ParamInt); err != nil {
+		return 0, err
+	}
+	return c.Call("getRenderbufferParameter", target, pname).Int(), nil
+}
+
+// Returns the RENDERBUFFER_INTERNAL_FORMAT of the bound renderbuffer. It
+// returns a *ParamError if pname is not such a parameter.
+func (c *Context) GetRenderbufferParameterEnum(target, pname GLenum) (GLenum, error) {
+	if err := checkParam("GetRenderbufferParameterEnum", renderbufferParameterTypes, pname, ParamEnum); err != nil {
+		return 0, err
+	}
+	return GLenum(c.Call("getRenderbufferParameter", target, pname).Int()), nil
+}
+
 // Returns the value of the parameter associated with pname for a shader object.
-func (c *Context) GetShaderParameter(shader *js.Object, pname int) *js.Object {
-	return c.Call("getShaderParameter", shader, pname)
+// The typed GetShaderParameter* methods check the type of pname.
+func (c *Context) GetShaderParameter(shader Shader, pname GLenum) *js.Object {
+	return c.Call("getShaderParameter", shader.jsValue(), pname)
+}
+
+// Returns a bool parameter of a shader, such as COMPILE_STATUS. It returns
+// a *ParamError if pname is not such a parameter.
+func (c *Context) GetShaderParameterBool(shader Shader, pname GLenum) (bool, error) {
+	if err := checkParam("GetShaderParameterBool", shaderParameterTypes, pname, ParamBool); err != nil {
+		return false, err
+	}
+	return c.Call("getShaderParameter", shader.jsValue(), pname).Bool(), nil
+}
+
+// Returns the SHADER_TYPE of a shader. It returns a *ParamError if pname
+// is not such a parameter.
+func (c *Context) GetShaderParameterEnum(shader Shader, pname GLenum) (GLenum, error) {
+	if err := checkParam("GetShaderParameterEnum", shaderParameterTypes, pname, ParamEnum); err != nil {
+		return 0, err
+	}
+	return GLenum(c.Call("getShaderParameter", shader.jsValue(), pname).Int()), nil
 }
 
 // Returns the value of the parameter associated with pname for a shader object.
-func (c *Context) GetShaderParameterb(shader *js.Object, pname int) bool {
-	return c.Call("getShaderParameter", shader, pname).Bool()
+func (c *Context) GetShaderParameterb(shader Shader, pname GLenum) bool {
+	return c.Call("getShaderParameter", shader.jsValue(), pname).Bool()
 }
 
 // Returns errors which occur when compiling a shader.
-func (c *Context) GetShaderInfoLog(shader *js.Object) string {
-	return c.Call("getShaderInfoLog", shader).String()
+func (c *Context) GetShaderInfoLog(shader Shader) string {
+	return c.Call("getShaderInfoLog", shader.jsValue()).String()
+}
+
+// Returns the range and precision of a precision type, such as
+// MEDIUM_FLOAT, in shaders of shaderType.
+func (c *Context) GetShaderPrecisionFormat(shaderType, precisionType GLenum) PrecisionFormat {
+	f := c.Call("getShaderPrecisionFormat", shaderType, precisionType)
+	return PrecisionFormat{
+		RangeMin:  f.Get("rangeMin").Int(),
+		RangeMax:  f.Get("rangeMax").Int(),
+		Precision: f.Get("precision").Int(),
+	}
 }
 
 // Returns source code string associated with a shader object.
-func (c *Context) GetShaderSource(shader *js.Object) string {
-	return c.Call("getShaderSource", shader).String()
+func (c *Context) GetShaderSource(shader Shader) string {
+	return c.Call("getShaderSource", shader.jsValue()).String()
 }
 
 // Returns a slice of supported extension strings.
@@ -445,41 +949,150 @@ func (c *Context) GetSupportedExtensions() []string {
 	return extensions
 }
 
-// TODO: Create type specific variations.
 // Returns the value for a parameter on an active texture unit.
-func (c *Context) GetTexParameter(target, pname int) *js.Object {
+// The typed GetTexParameter* methods check the type of pname.
+func (c *Context) GetTexParameter(target, pname GLenum) *js.Object {
 	return c.Call("getTexParameter", target, pname)
 }
 
-// TODO: Create type specific variations.
+// Returns a parameter of the texture bound to target, such as
+// TEXTURE_MIN_FILTER. It returns a *ParamError if pname is not such a
+// parameter.
+func (c *Context) GetTexParameterEnum(target, pname GLenum) (GLenum, error) {
+	if err := checkParam("GetTexParameterEnum", texParameterTypes, pname, ParamEnum); err != nil {
+		return 0, err
+	}
+	return GLenum(c.Call("getTexParameter", target, pname).Int()), nil
+}
+
 // Gets the uniform value for a specific location in a program.
-func (c *Context) GetUniform(program, location *js.Object) *js.Object {
-	return c.Call("getUniform", program, location)
+// GetUniformFloats, GetUniformInts and GetUniformBools return it typed.
+func (c *Context) GetUniform(program Program, location UniformLocation) *js.Object {
+	return c.Call("getUniform", program.jsValue(), location.jsValue())
+}
+
+// Returns the value of a float, vec or mat uniform, or of one element of
+// an array of them, in column-major order for matrices. It returns an
+// error if the uniform holds ints or bools.
+func (c *Context) GetUniformFloats(program Program, location UniformLocation) ([]float32, error) {
+	v := c.Call("getUniform", program.jsValue(), location.jsValue())
+	switch name := constructorName(v); name {
+	case "Number":
+		return []float32{float32(v.Float())}, nil
+	case "Float32Array":
+		return toFloats(v), nil
+	default:
+		return nil, uniformTypeError("GetUniformFloats", name)
+	}
+}
+
+// Returns the value of an int, ivec or sampler uniform, or of one element
+// of an array of them. It returns an error if the uniform holds floats or
+// bools.
+func (c *Context) GetUniformInts(program Program, location UniformLocation) ([]int, error) {
+	v := c.Call("getUniform", program.jsValue(), location.jsValue())
+	switch name := constructorName(v); name {
+	case "Number":
+		return []int{v.Int()}, nil
+	case "Int32Array":
+		return toInts(v), nil
+	default:
+		return nil, uniformTypeError("GetUniformInts", name)
+	}
+}
+
+// Returns the value of a bool or bvec uniform, or of one element of an
+// array of them. It returns an error if the uniform holds floats or ints.
+func (c *Context) GetUniformBools(program Program, location UniformLocation) ([]bool, error) {
+	v := c.Call("getUniform", program.jsValue(), location.jsValue())
+	switch name := constructorName(v); name {
+	case "Boolean":
+		return []bool{v.Bool()}, nil
+	case "Array":
+		return toBools(v), nil
+	default:
+		return nil, uniformTypeError("GetUniformBools", name)
+	}
 }
 
 // Returns a WebGLUniformLocation object for the location
 // of a uniform variable within a WebGLProgram object.
-func (c *Context) GetUniformLocation(program *js.Object, name string) *js.Object {
-	return c.Call("getUniformLocation", program, name)
+func (c *Context) GetUniformLocation(program Program, name string) UniformLocation {
+	l := UniformLocation{wrap(c.Call("getUniformLocation", program.jsValue(), name))}
+	if c.resources != nil {
+		c.resources.uniformLocation(program.object, name, l.object)
+	}
+	return l
 }
 
-// TODO: Create type specific variations.
 // Returns data for a particular characteristic of a vertex
 // attribute at an index in a vertex attribute array.
-func (c *Context) GetVertexAttrib(index, pname int) *js.Object {
+// The typed GetVertexAttrib* methods check the type of pname.
+func (c *Context) GetVertexAttrib(index int, pname GLenum) *js.Object {
 	return c.Call("getVertexAttrib", index, pname)
 }
 
+// Returns a bool parameter of a vertex attribute, such as
+// VERTEX_ATTRIB_ARRAY_ENABLED. It returns a *ParamError if pname is not
+// such a parameter.
+func (c *Context) GetVertexAttribBool(index int, pname GLenum) (bool, error) {
+	if err := checkParam("GetVertexAttribBool", vertexAttribTypes, pname, ParamBool); err != nil {
+		return false, err
+	}
+	return c.Call("getVertexAttrib", index, pname).Bool(), nil
+}
+
+// Returns an int parameter of a vertex attribute, such as
+// VERTEX_ATTRIB_ARRAY_SIZE. It returns a *ParamError if pname is not such
+// a parameter.
+func (c *Context) GetVertexAttribInt(index int, pname GLenum) (int, error) {
+	if err := checkParam("GetVertexAttribInt", vertexAttribTypes, pname, ParamInt); err != nil {
+		return 0, err
+	}
+	return c.Call("getVertexAttrib", index, pname).Int(), nil
+}
+
+// Returns the VERTEX_ATTRIB_ARRAY_TYPE of a vertex attribute. It returns a
+// *ParamError if pname is not such a parameter.
+func (c *Context) GetVertexAttribEnum(index int, pname GLenum) (GLenum, error) {
+	if err := checkParam("GetVertexAttribEnum", vertexAttribTypes, pname, ParamEnum); err != nil {
+		return 0, err
+	}
+	return GLenum(c.Call("getVertexAttrib", index, pname).Int()), nil
+}
+
+// Returns the CURRENT_VERTEX_ATTRIB value of a vertex attribute. It
+// returns a *ParamError if pname is not such a parameter.
+func (c *Context) GetVertexAttribFloats(index int, pname GLenum) ([]float32, error) {
+	if err := checkParam("GetVertexAttribFloats", vertexAttribTypes, pname, ParamFloats); err != nil {
+		return nil, err
+	}
+	return toFloats(c.Call("getVertexAttrib", index, pname)), nil
+}
+
+// Returns the VERTEX_ATTRIB_ARRAY_BUFFER_BINDING of a vertex attribute. It
+// returns a *ParamError if pname is not such a parameter.
+func (c *Context) GetVertexAttribBuffer(index int, pname GLenum) (Buffer, error) {
+	if err := checkParam("GetVertexAttribBuffer", vertexAttribTypes, pname, ParamBuffer); err != nil {
+		return Buffer{}, err
+	}
+	return Buffer{wrap(c.Call("getVertexAttrib", index, pname))}, nil
+}
+
 // Returns the address of a specified vertex attribute.
-func (c *Context) GetVertexAttribOffset(index, pname int) int {
+func (c *Context) GetVertexAttribOffset(index int, pname GLenum) int {
 	return c.Call("getVertexAttribOffset", index, pname).Int()
 }
 
-// public function hint(target:GLenum, mode:GLenum) : Void;
+// Sets the implementation's behavior for target, which must be
+// GENERATE_MIPMAP_HINT, to FASTEST, NICEST or DONT_CARE.
+func (c *Context) Hint(target, mode GLenum) {
+	c.Call("hint", target, mode)
+}
 
 // Returns true if buffer is valid, false otherwise.
-func (c *Context) IsBuffer(buffer *js.Object) bool {
-	return c.Call("isBuffer", buffer).Bool()
+func (c *Context) IsBuffer(buffer Buffer) bool {
+	return c.Call("isBuffer", buffer.jsValue()).Bool()
 }
 
 // Returns whether the WebGL context has been lost.
@@ -487,33 +1100,50 @@ func (c *Context) IsContextLost() bool {
 	return c.Call("isContextLost").Bool()
 }
 
+// listen adds the webglcontextlost and webglcontextrestored listeners to
+// the canvas of c, the first time it is called.
+func (c *Context) listen() {
+	if c.handlers != nil {
+		return
+	}
+	c.handlers = &contextHandlers{}
+	canvas := c.Get("canvas")
+	canvas.Call("addEventListener", "webglcontextlost", func(event *js.Object) {
+		event.Call("preventDefault")
+		c.contextLost()
+	}, false)
+	canvas.Call("addEventListener", "webglcontextrestored", func(event *js.Object) {
+		c.contextRestored()
+	}, false)
+}
+
 // Returns true if buffer is valid, false otherwise.
-func (c *Context) IsFramebuffer(framebuffer *js.Object) bool {
-	return c.Call("isFramebuffer", framebuffer).Bool()
+func (c *Context) IsFramebuffer(framebuffer Framebuffer) bool {
+	return c.Call("isFramebuffer", framebuffer.jsValue()).Bool()
 }
 
 // Returns true if program object is valid, false otherwise.
-func (c *Context) IsProgram(program *js.Object) bool {
-	return c.Call("isProgram", program).Bool()
+func (c *Context) IsProgram(program Program) bool {
+	return c.Call("isProgram", program.jsValue()).Bool()
 }
 
 // Returns true if buffer is valid, false otherwise.
-func (c *Context) IsRenderbuffer(renderbuffer *js.Object) bool {
-	return c.Call("isRenderbuffer", renderbuffer).Bool()
+func (c *Context) IsRenderbuffer(renderbuffer Renderbuffer) bool {
+	return c.Call("isRenderbuffer", renderbuffer.jsValue()).Bool()
 }
 
 // Returns true if shader is valid, false otherwise.
-func (c *Context) IsShader(shader *js.Object) bool {
-	return c.Call("isShader", shader).Bool()
+func (c *Context) IsShader(shader Shader) bool {
+	return c.Call("isShader", shader.jsValue()).Bool()
 }
 
 // Returns true if texture is valid, false otherwise.
-func (c *Context) IsTexture(texture *js.Object) bool {
-	return c.Call("isTexture", texture).Bool()
+func (c *Context) IsTexture(texture Texture) bool {
+	return c.Call("isTexture", texture.jsValue()).Bool()
 }
 
 // Returns whether or not a WebGL capability is enabled for this context.
-func (c *Context) IsEnabled(capability int) bool {
+func (c *Context) IsEnabled(capability GLenum) bool {
 	return c.Call("isEnabled", capability).Bool()
 }
 
@@ -524,13 +1154,19 @@ func (c *Context) LineWidth(width float64) {
 
 // Links an attached vertex shader and an attached fragment shader
 // to a program so it can be used by the graphics processing unit (GPU).
-func (c *Context) LinkProgram(program *js.Object) {
-	c.Call("linkProgram", program)
+func (c *Context) LinkProgram(program Program) {
+	if c.resources != nil {
+		c.resources.linkProgram(program.object)
+	}
+	c.Call("linkProgram", program.jsValue())
 }
 
 // Sets pixel storage modes for readPixels and unpacking of textures
 // with texImage2D and texSubImage2D.
-func (c *Context) PixelStorei(pname, param int) {
+func (c *Context) PixelStorei(pname GLenum, param int) {
+	if c.resources != nil {
+		c.resources.pixelStore(pname, param)
+	}
 	c.Call("pixelStorei", pname, param)
 }
 
@@ -540,21 +1176,43 @@ func (c *Context) PolygonOffset(factor, units float64) {
 	c.Call("polygonOffset", factor, units)
 }
 
-// TODO: Figure out if pixels should be a slice.
 // Reads pixel data into an ArrayBufferView object from a
 // rectangular area in the color buffer of the active frame buffer.
-func (c *Context) ReadPixels(x, y, width, height, format, typ int, pixels *js.Object) {
+// ReadPixelsBytes and ReadPixelsFloat32 read into Go slices instead.
+func (c *Context) ReadPixels(x, y, width, height int, format, typ GLenum, pixels *js.Object) {
 	c.Call("readPixels", x, y, width, height, format, typ, pixels)
 }
 
+// Reads pixel data from a rectangular area in the color buffer of the
+// active frame buffer into pixels, bottom row first. typ is usually
+// UNSIGNED_BYTE.
+func (c *Context) ReadPixelsBytes(x, y, width, height int, format, typ GLenum, pixels []byte) {
+	if len(pixels) == 0 {
+		return
+	}
+	c.Call("readPixels", x, y, width, height, format, typ, c.views.bytesView(pixels))
+}
+
+// Reads pixel data from a rectangular area in the color buffer of the
+// active frame buffer into pixels, bottom row first. typ must be FLOAT,
+// which needs a floating-point color buffer.
+func (c *Context) ReadPixelsFloat32(x, y, width, height int, format, typ GLenum, pixels []float32) {
+	if len(pixels) == 0 {
+		return
+	}
+	c.Call("readPixels", x, y, width, height, format, typ, c.views.float32sView(pixels))
+}
+
 // Creates or replaces the data store for the currently bound WebGLRenderbuffer object.
-func (c *Context) RenderbufferStorage(target, internalFormat, width, height int) {
+func (c *Context) RenderbufferStorage(target, internalFormat GLenum, width, height int) {
 	c.Call("renderbufferStorage", target, internalFormat, width, height)
 }
 
-//func (c *Context) SampleCoverage(value float64, invert bool) {
-//	c.Call("sampleCoverage", value, invert)
-//}
+// Sets the coverage used for multisampling when SAMPLE_COVERAGE is
+// enabled: value in [0, 1], and whether the coverage mask is inverted.
+func (c *Context) SampleCoverage(value float64, invert bool) {
+	c.Call("sampleCoverage", value, invert)
+}
 
 // Sets the dimensions of the scissor box.
 func (c *Context) Scissor(x, y, width, height int) {
@@ -562,124 +1220,447 @@ func (c *Context) Scissor(x, y, width, height int) {
 }
 
 // Sets and replaces shader source code in a shader object.
-func (c *Context) ShaderSource(shader *js.Object, source string) {
-	c.Call("shaderSource", shader, source)
+func (c *Context) ShaderSource(shader Shader, source string) {
+	if c.resources != nil {
+		c.resources.shaderSource(shader.object, source)
+	}
+	c.Call("shaderSource", shader.jsValue(), source)
 }
 
-// public function stencilFunc(func:GLenum, ref:GLint, mask:GLuint) : Void;
-// public function stencilFuncSeparate(face:GLenum, func:GLenum, ref:GLint, mask:GLuint) : Void;
-// public function stencilMask(mask:GLuint) : Void;
-// public function stencilMaskSeparate(face:GLenum, mask:GLuint) : Void;
-// public function stencilOp(fail:GLenum, zfail:GLenum, zpass:GLenum) : Void;
-// public function stencilOpSeparate(face:GLenum, fail:GLenum, zfail:GLenum, zpass:GLenum) : Void;
+// Sets the function and reference value for the stencil test of both
+// front and back faces. Only the bits of ref and of the stencil buffer set
+// in mask are compared.
+func (c *Context) StencilFunc(fun GLenum, ref int, mask uint32) {
+	c.Call("stencilFunc", fun, ref, mask)
+}
+
+// Sets the function and reference value for the stencil test of the
+// FRONT, BACK or FRONT_AND_BACK faces.
+func (c *Context) StencilFuncSeparate(face, fun GLenum, ref int, mask uint32) {
+	c.Call("stencilFuncSeparate", face, fun, ref, mask)
+}
+
+// Sets the bits of the stencil buffer that can be written, for both front
+// and back faces.
+func (c *Context) StencilMask(mask uint32) {
+	c.Call("stencilMask", mask)
+}
+
+// Sets the bits of the stencil buffer that can be written by the FRONT,
+// BACK or FRONT_AND_BACK faces.
+func (c *Context) StencilMaskSeparate(face GLenum, mask uint32) {
+	c.Call("stencilMaskSeparate", face, mask)
+}
+
+// Sets the actions taken on the stencil buffer when the stencil test
+// fails, when it passes but the depth test fails, and when both pass, for
+// both front and back faces.
+func (c *Context) StencilOp(fail, zfail, zpass GLenum) {
+	c.Call("stencilOp", fail, zfail, zpass)
+}
+
+// Sets the stencil buffer actions of the FRONT, BACK or FRONT_AND_BACK
+// faces.
+func (c *Context) StencilOpSeparate(face, fail, zfail, zpass GLenum) {
+	c.Call("stencilOpSeparate", face, fail, zfail, zpass)
+}
+
+// Returns the stencil test state of the FRONT or BACK faces.
+func (c *Context) GetStencilState(face GLenum) StencilState {
+	pnames := frontStencilParameters
+	if face == BACK {
+		pnames = backStencilParameters
+	}
+	return StencilState{
+		Func:      GLenum(c.Call("getParameter", pnames[0]).Int()),
+		Ref:       c.Call("getParameter", pnames[1]).Int(),
+		ValueMask: uint32(c.Call("getParameter", pnames[2]).Float()),
+		WriteMask: uint32(c.Call("getParameter", pnames[3]).Float()),
+		Fail:      GLenum(c.Call("getParameter", pnames[4]).Int()),
+		ZFail:     GLenum(c.Call("getParameter", pnames[5]).Int()),
+		ZPass:     GLenum(c.Call("getParameter", pnames[6]).Int()),
+	}
+}
+
+// Returns the value the stencil buffer is cleared to.
+func (c *Context) GetStencilClearValue() int {
+	return c.Call("getParameter", STENCIL_CLEAR_VALUE).Int()
+}
+
+// Returns the number of bits in the stencil buffer of the bound
+// framebuffer.
+func (c *Context) GetStencilBits() int {
+	return c.Call("getParameter", STENCIL_BITS).Int()
+}
 
 // Loads the supplied pixel data into a texture.
-func (c *Context) TexImage2D(target, level, internalFormat, format, kind int, image *js.Object) {
+func (c *Context) TexImage2D(target GLenum, level int, internalFormat, format, kind GLenum, image *js.Object) {
+	if c.resources != nil {
+		c.resources.texImage(target, level, func() { c.TexImage2D(target, level, internalFormat, format, kind, image) })
+	}
 	c.Call("texImage2D", target, level, internalFormat, format, kind, image)
 }
 
+// Loads width by height pixels, bottom row first, into a texture image.
+// typ is usually UNSIGNED_BYTE. If pixels is empty, the image is
+// allocated and cleared to zero.
+func (c *Context) TexImage2DBytes(target GLenum, level int, internalFormat GLenum, width, height, border int, format, typ GLenum, pixels []byte) {
+	if c.resources != nil {
+		c.resources.texImagePixels(target, level, internalFormat, width, height, format, typ, pixels)
+	}
+	var pix *js.Object
+	if len(pixels) > 0 {
+		pix = c.views.bytesView(pixels)
+	}
+	c.Call("texImage2D", target, level, internalFormat, width, height, border, format, typ, pix)
+}
+
+// Loads width by height pixels, bottom row first, into a texture image.
+// typ is one of the packed UNSIGNED_SHORT_* types. If pixels is empty,
+// the image is allocated and cleared to zero.
+func (c *Context) TexImage2DUint16(target GLenum, level int, internalFormat GLenum, width, height, border int, format, typ GLenum, pixels []uint16) {
+	if c.resources != nil {
+		c.resources.texImagePixels(target, level, internalFormat, width, height, format, typ, pixels)
+	}
+	var pix *js.Object
+	if len(pixels) > 0 {
+		pix = c.views.uint16sView(pixels)
+	}
+	c.Call("texImage2D", target, level, internalFormat, width, height, border, format, typ, pix)
+}
+
+// Loads width by height pixels, bottom row first, into a texture image.
+// typ must be FLOAT, which needs the OES_texture_float extension. If
+// pixels is empty, the image is allocated and cleared to zero.
+func (c *Context) TexImage2DFloat32(target GLenum, level int, internalFormat GLenum, width, height, border int, format, typ GLenum, pixels []float32) {
+	if c.resources != nil {
+		c.resources.texImagePixels(target, level, internalFormat, width, height, format, typ, pixels)
+	}
+	var pix *js.Object
+	if len(pixels) > 0 {
+		pix = c.views.float32sView(pixels)
+	}
+	c.Call("texImage2D", target, level, internalFormat, width, height, border, format, typ, pix)
+}
+
 // Sets texture parameters for the current texture unit.
-func (c *Context) TexParameteri(target int, pname int, param int) {
+func (c *Context) TexParameteri(target, pname GLenum, param int) {
+	if c.resources != nil {
+		c.resources.texParameter(target, pname, func() { c.TexParameteri(target, pname, param) })
+	}
 	c.Call("texParameteri", target, pname, param)
 }
 
 // Replaces a portion of an existing 2D texture image with all of another image.
-func (c *Context) TexSubImage2D(target, level, xoffset, yoffset, format, typ int, image *js.Object) {
+func (c *Context) TexSubImage2D(target GLenum, level, xoffset, yoffset int, format, typ GLenum, image *js.Object) {
+	if c.resources != nil {
+		width, height := imageSize(image)
+		c.resources.texSubImage(target, level, xoffset, yoffset, width, height, func() {
+			c.TexSubImage2D(target, level, xoffset, yoffset, format, typ, image)
+		})
+	}
 	c.Call("texSubImage2D", target, level, xoffset, yoffset, format, typ, image)
 }
 
+// Replaces a width by height portion of an existing texture image with
+// pixels, bottom row first. typ is usually UNSIGNED_BYTE.
+func (c *Context) TexSubImage2DBytes(target GLenum, level, xoffset, yoffset, width, height int, format, typ GLenum, pixels []byte) {
+	if len(pixels) == 0 {
+		return
+	}
+	if c.resources != nil {
+		c.resources.texSubImagePixels(target, level, xoffset, yoffset, width, height, format, typ, pixels)
+	}
+	c.Call("texSubImage2D", target, level, xoffset, yoffset, width, height, format, typ, c.views.bytesView(pixels))
+}
+
+// Replaces a width by height portion of an existing texture image with
+// pixels, bottom row first. typ is one of the packed UNSIGNED_SHORT_*
+// types.
+func (c *Context) TexSubImage2DUint16(target GLenum, level, xoffset, yoffset, width, height int, format, typ GLenum, pixels []uint16) {
+	if len(pixels) == 0 {
+		return
+	}
+	if c.resources != nil {
+		c.resources.texSubImagePixels(target, level, xoffset, yoffset, width, height, format, typ, pixels)
+	}
+	c.Call("texSubImage2D", target, level, xoffset, yoffset, width, height, format, typ, c.views.uint16sView(pixels))
+}
+
+// Replaces a width by height portion of an existing texture image with
+// pixels, bottom row first. typ must be FLOAT, which needs the
+// OES_texture_float extension.
+func (c *Context) TexSubImage2DFloat32(target GLenum, level, xoffset, yoffset, width, height int, format, typ GLenum, pixels []float32) {
+	if len(pixels) == 0 {
+		return
+	}
+	if c.resources != nil {
+		c.resources.texSubImagePixels(target, level, xoffset, yoffset, width, height, format, typ, pixels)
+	}
+	c.Call("texSubImage2D", target, level, xoffset, yoffset, width, height, format, typ, c.views.float32sView(pixels))
+}
+
 // Assigns a floating point value to a uniform variable for the current program object.
-func (c *Context) Uniform1f(location *js.Object, x float32) {
-	c.Call("uniform1f", location, x)
+func (c *Context) Uniform1f(location UniformLocation, x float32) {
+	c.Call("uniform1f", location.jsValue(), x)
 }
 
 // Assigns a integer value to a uniform variable for the current program object.
-func (c *Context) Uniform1i(location *js.Object, x int) {
-	c.Call("uniform1i", location, x)
+func (c *Context) Uniform1i(location UniformLocation, x int) {
+	c.Call("uniform1i", location.jsValue(), x)
 }
 
 // Assigns 2 floating point values to a uniform variable for the current program object.
-func (c *Context) Uniform2f(location *js.Object, x, y float32) {
-	c.Call("uniform2f", location, x, y)
+func (c *Context) Uniform2f(location UniformLocation, x, y float32) {
+	c.Call("uniform2f", location.jsValue(), x, y)
 }
 
 // Assigns 2 integer values to a uniform variable for the current program object.
-func (c *Context) Uniform2i(location *js.Object, x, y int) {
-	c.Call("uniform2i", location, x, y)
+func (c *Context) Uniform2i(location UniformLocation, x, y int) {
+	c.Call("uniform2i", location.jsValue(), x, y)
 }
 
 // Assigns 3 floating point values to a uniform variable for the current program object.
-func (c *Context) Uniform3f(location *js.Object, x, y, z float32) {
-	c.Call("uniform3f", location, x, y, z)
+func (c *Context) Uniform3f(location UniformLocation, x, y, z float32) {
+	c.Call("uniform3f", location.jsValue(), x, y, z)
 }
 
 // Assigns 3 integer values to a uniform variable for the current program object.
-func (c *Context) Uniform3i(location *js.Object, x, y, z int) {
-	c.Call("uniform3i", location, x, y, z)
+func (c *Context) Uniform3i(location UniformLocation, x, y, z int) {
+	c.Call("uniform3i", location.jsValue(), x, y, z)
 }
 
 // Assigns 4 floating point values to a uniform variable for the current program object.
-func (c *Context) Uniform4f(location *js.Object, x, y, z, w float32) {
-	c.Call("uniform4f", location, x, y, z, w)
+func (c *Context) Uniform4f(location UniformLocation, x, y, z, w float32) {
+	c.Call("uniform4f", location.jsValue(), x, y, z, w)
 }
 
 // Assigns 4 integer values to a uniform variable for the current program object.
-func (c *Context) Uniform4i(location *js.Object, x, y, z, w int) {
-	c.Call("uniform4i", location, x, y, z, w)
+func (c *Context) Uniform4i(location UniformLocation, x, y, z, w int) {
+	c.Call("uniform4i", location.jsValue(), x, y, z, w)
+}
+
+// Assigns float values to a float uniform, or to consecutive elements of a
+// float array uniform, for the current program object.
+func (c *Context) Uniform1fv(location UniformLocation, v []float32) {
+	c.Call("uniform1fv", location.jsValue(), c.views.float32sView(v))
 }
 
-// public function uniform1fv(location:WebGLUniformLocation, v:ArrayAccess<Float>) : Void;
-// public function uniform1iv(location:WebGLUniformLocation, v:ArrayAccess<Long>) : Void;
-// public function uniform2fv(location:WebGLUniformLocation, v:ArrayAccess<Float>) : Void;
-// public function uniform2iv(location:WebGLUniformLocation, v:ArrayAccess<Long>) : Void;
-// public function uniform3fv(location:WebGLUniformLocation, v:ArrayAccess<Float>) : Void;
-// public function uniform3iv(location:WebGLUniformLocation, v:ArrayAccess<Long>) : Void;
-// public function uniform4fv(location:WebGLUniformLocation, v:ArrayAccess<Float>) : Void;
-// public function uniform4iv(location:WebGLUniformLocation, v:ArrayAccess<Long>) : Void;
+// Assigns int values to an int, bool or sampler uniform, or to consecutive
+// elements of an array of them, for the current program object.
+func (c *Context) Uniform1iv(location UniformLocation, v []int32) {
+	c.Call("uniform1iv", location.jsValue(), c.views.int32sView(v))
+}
+
+// Assigns vec2 values to a vec2 uniform, or to consecutive elements of a
+// vec2 array uniform, for the current program object.
+func (c *Context) Uniform2fv(location UniformLocation, v []float32) {
+	c.Call("uniform2fv", location.jsValue(), c.views.float32sView(v))
+}
+
+// Assigns ivec2 values to an ivec2 or bvec2 uniform, or to consecutive
+// elements of an array of them, for the current program object.
+func (c *Context) Uniform2iv(location UniformLocation, v []int32) {
+	c.Call("uniform2iv", location.jsValue(), c.views.int32sView(v))
+}
+
+// Assigns vec3 values to a vec3 uniform, or to consecutive elements of a
+// vec3 array uniform, for the current program object.
+func (c *Context) Uniform3fv(location UniformLocation, v []float32) {
+	c.Call("uniform3fv", location.jsValue(), c.views.float32sView(v))
+}
+
+// Assigns ivec3 values to an ivec3 or bvec3 uniform, or to consecutive
+// elements of an array of them, for the current program object.
+func (c *Context) Uniform3iv(location UniformLocation, v []int32) {
+	c.Call("uniform3iv", location.jsValue(), c.views.int32sView(v))
+}
+
+// Assigns vec4 values to a vec4 uniform, or to consecutive elements of a
+// vec4 array uniform, for the current program object.
+func (c *Context) Uniform4fv(location UniformLocation, v []float32) {
+	c.Call("uniform4fv", location.jsValue(), c.views.float32sView(v))
+}
+
+// Assigns ivec4 values to an ivec4 or bvec4 uniform, or to consecutive
+// elements of an array of them, for the current program object.
+func (c *Context) Uniform4iv(location UniformLocation, v []int32) {
+	c.Call("uniform4iv", location.jsValue(), c.views.int32sView(v))
+}
 
 // Sets values for a 2x2 floating point vector matrix into a
 // uniform location as a matrix or a matrix array.
-func (c *Context) UniformMatrix2fv(location *js.Object, transpose bool, value []float32) {
-	c.Call("uniformMatrix2fv", location, transpose, value)
+func (c *Context) UniformMatrix2fv(location UniformLocation, transpose bool, value []float32) {
+	c.Call("uniformMatrix2fv", location.jsValue(), transpose, c.views.float32sView(value))
 }
 
 // Sets values for a 3x3 floating point vector matrix into a
 // uniform location as a matrix or a matrix array.
-func (c *Context) UniformMatrix3fv(location *js.Object, transpose bool, value []float32) {
-	c.Call("uniformMatrix3fv", location, transpose, value)
+func (c *Context) UniformMatrix3fv(location UniformLocation, transpose bool, value []float32) {
+	c.Call("uniformMatrix3fv", location.jsValue(), transpose, c.views.float32sView(value))
 }
 
 // Sets values for a 4x4 floating point vector matrix into a
 // uniform location as a matrix or a matrix array.
-func (c *Context) UniformMatrix4fv(location *js.Object, transpose bool, value []float32) {
-	c.Call("uniformMatrix4fv", location, transpose, value)
+func (c *Context) UniformMatrix4fv(location UniformLocation, transpose bool, value []float32) {
+	c.Call("uniformMatrix4fv", location.jsValue(), transpose, c.views.float32sView(value))
 }
 
 // Set the program object to use for rendering.
-func (c *Context) UseProgram(program *js.Object) {
-	c.Call("useProgram", program)
+func (c *Context) UseProgram(program Program) {
+	c.Call("useProgram", program.jsValue())
 }
 
 // Returns whether a given program can run in the current WebGL state.
-func (c *Context) ValidateProgram(program *js.Object) {
-	c.Call("validateProgram", program)
+func (c *Context) ValidateProgram(program Program) {
+	c.Call("validateProgram", program.jsValue())
 }
 
-func (c *Context) VertexAttribPointer(index, size, typ int, normal bool, stride int, offset int) {
+func (c *Context) VertexAttribPointer(index, size int, typ GLenum, normal bool, stride int, offset int) {
+	if c.vertexArrays != nil {
+		c.vertexArrays.attribPointer(index, size, typ, normal, stride, offset)
+	}
 	c.Call("vertexAttribPointer", index, size, typ, normal, stride, offset)
 }
 
-// public function vertexAttrib1f(indx:GLuint, x:GLfloat) : Void;
-// public function vertexAttrib2f(indx:GLuint, x:GLfloat, y:GLfloat) : Void;
-// public function vertexAttrib3f(indx:GLuint, x:GLfloat, y:GLfloat, z:GLfloat) : Void;
-// public function vertexAttrib4f(indx:GLuint, x:GLfloat, y:GLfloat, z:GLfloat, w:GLfloat) : Void;
-// public function vertexAttrib1fv(indx:GLuint, values:ArrayAccess<Float>) : Void;
-// public function vertexAttrib2fv(indx:GLuint, values:ArrayAccess<Float>) : Void;
-// public function vertexAttrib3fv(indx:GLuint, values:ArrayAccess<Float>) : Void;
-// public function vertexAttrib4fv(indx:GLuint, values:ArrayAccess<Float>) : Void;
+// Sets the value of generic vertex attribute index used while its array is
+// disabled. Missing components are 0, and w is 1.
+func (c *Context) VertexAttrib1f(index int, x float32) {
+	c.Call("vertexAttrib1f", index, x)
+}
+
+// Sets the value of generic vertex attribute index used while its array is
+// disabled. Missing components are 0, and w is 1.
+func (c *Context) VertexAttrib2f(index int, x, y float32) {
+	c.Call("vertexAttrib2f", index, x, y)
+}
+
+// Sets the value of generic vertex attribute index used while its array is
+// disabled. Missing components are 0, and w is 1.
+func (c *Context) VertexAttrib3f(index int, x, y, z float32) {
+	c.Call("vertexAttrib3f", index, x, y, z)
+}
+
+// Sets the value of generic vertex attribute index used while its array is
+// disabled.
+func (c *Context) VertexAttrib4f(index int, x, y, z, w float32) {
+	c.Call("vertexAttrib4f", index, x, y, z, w)
+}
+
+// Sets the value of generic vertex attribute index from the first element
+// of v.
+func (c *Context) VertexAttrib1fv(index int, v []float32) {
+	c.Call("vertexAttrib1fv", index, c.views.float32sView(v))
+}
+
+// Sets the value of generic vertex attribute index from the first 2
+// elements of v.
+func (c *Context) VertexAttrib2fv(index int, v []float32) {
+	c.Call("vertexAttrib2fv", index, c.views.float32sView(v))
+}
+
+// Sets the value of generic vertex attribute index from the first 3
+// elements of v.
+func (c *Context) VertexAttrib3fv(index int, v []float32) {
+	c.Call("vertexAttrib3fv", index, c.views.float32sView(v))
+}
+
+// Sets the value of generic vertex attribute index from the first 4
+// elements of v.
+func (c *Context) VertexAttrib4fv(index int, v []float32) {
+	c.Call("vertexAttrib4fv", index, c.views.float32sView(v))
+}
 
 // Represents a rectangular viewable area that contains
 // the rendering results of the drawing buffer.
 func (c *Context) Viewport(x, y, width, height int) {
 	c.Call("viewport", x, y, width, height)
 }
+
+// constructorName returns the name of the constructor of v, such as
+// "Number" or "Float32Array", or "null" if v is null or undefined.
+func constructorName(v *js.Object) string {
+	if v == nil || v == js.Undefined {
+		return "null"
+	}
+	return v.Get("constructor").Get("name").String()
+}
+
+// toBools returns the elements of a JavaScript array of booleans.
+func toBools(v *js.Object) []bool {
+	if v == nil || v == js.Undefined {
+		return nil
+	}
+	s := make([]bool, v.Length())
+	for i := range s {
+		s[i] = v.Index(i).Bool()
+	}
+	return s
+}
+
+// toInts returns the elements of a JavaScript array or typed array of
+// integers.
+func toInts(v *js.Object) []int {
+	if v == nil || v == js.Undefined {
+		return nil
+	}
+	s := make([]int, v.Length())
+	for i := range s {
+		s[i] = v.Index(i).Int()
+	}
+	return s
+}
+
+// toFloats returns the elements of a JavaScript array or typed array of
+// numbers.
+func toFloats(v *js.Object) []float32 {
+	if v == nil || v == js.Undefined {
+		return nil
+	}
+	s := make([]float32, v.Length())
+	for i := range s {
+		s[i] = float32(v.Index(i).Float())
+	}
+	return s
+}
+
+// activeInfo converts v, a WebGLActiveInfo or null.
+func activeInfo(v *js.Object) ActiveInfo {
+	if v == nil {
+		return ActiveInfo{}
+	}
+	return ActiveInfo{v.Get("name").String(), v.Get("size").Int(), GLenum(v.Get("type").Int())}
+}
+
+// jsBytes returns a copy of the bytes of data, an ArrayBuffer or an
+// ArrayBufferView, or nil if it is neither.
+func jsBytes(data interface{}) []byte {
+	// Array.of converts data to JavaScript as Call does.
+	v := js.Global.Get("Array").Call("of", data).Index(0)
+	if v == nil || v == js.Undefined || v.Get("byteLength") == js.Undefined {
+		return nil
+	}
+	u8 := js.Global.Get("Uint8Array")
+	if buf := v.Get("buffer"); buf != js.Undefined {
+		v = u8.New(buf, v.Get("byteOffset"), v.Get("byteLength"))
+	} else {
+		v = u8.New(v)
+	}
+	b := make([]byte, v.Length())
+	js.InternalObject(b).Get("$array").Call("set", v)
+	return b
+}
+
+// imageSize returns the size of image, an ImageData, image, canvas,
+// video or ImageBitmap, or 0, 0 if it is not known.
+func imageSize(image *js.Object) (width, height int) {
+	if image == nil || image == js.Undefined {
+		return 0, 0
+	}
+	for _, p := range [][2]string{{"videoWidth", "videoHeight"}, {"naturalWidth", "naturalHeight"}, {"width", "height"}} {
+		if w := image.Get(p[0]); w != js.Undefined {
+			return w.Int(), image.Get(p[1]).Int()
+		}
+	}
+	return 0, 0
+}
@@ -0,0 +1,266 @@
+// Copyright 2014 Joseph Hager. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package webgltest compares the output of code written against
+// webgl.Backend with golden files: the Trace of a webgl.Recorder with a
+// text file, and an image rendered by a software Backend with a PNG. It
+// is kept out of package webgl so that programs built for the browser do
+// not link the file and image encoding packages it needs.
+package webgltest
+
+import (
+	"bytes"
+	"fmt"
+	"image"
+	"image/draw"
+	"image/png"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// CompareGolden compares got, usually the Trace of a Recorder, against the
+// golden file at path. It returns nil if they match, and otherwise an error
+// holding a line diff of the two. If update is true, the golden file is
+// written with got instead, creating its directory if needed.
+func CompareGolden(path, got string, update bool) error {
+	if update {
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			return err
+		}
+		return ioutil.WriteFile(path, []byte(got), 0644)
+	}
+	want, err := ioutil.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	if string(want) == got {
+		return nil
+	}
+	return fmt.Errorf("webgltest: trace does not match %s:\n%s", path, Diff(string(want), got))
+}
+
+// CompareGoldenPNG compares got, usually rendered by a software Backend,
+// against the PNG golden file at path. Images match if they have the same
+// size and identical 8-bit pixels; otherwise the error reports the number
+// of differing pixels and the first one. The bytes of an *image.RGBA or
+// *image.NRGBA are stored and compared as they are, so framebuffer contents
+// that are not valid premultiplied colors survive the round trip. If update
+// is true, the golden file is written with got instead, creating its
+// directory if needed.
+func CompareGoldenPNG(path string, got image.Image, update bool) error {
+	if update {
+		var buf bytes.Buffer
+		if err := png.Encode(&buf, rawPixels(got)); err != nil {
+			return err
+		}
+		return CompareGolden(path, buf.String(), true)
+	}
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	golden, err := png.Decode(f)
+	if err != nil {
+		return fmt.Errorf("webgltest: decoding %s: %v", path, err)
+	}
+	want, have := rawPixels(golden), rawPixels(got)
+	if want.Rect.Size() != have.Rect.Size() {
+		return fmt.Errorf("webgltest: image is %v, golden %s is %v", have.Rect.Size(), path, want.Rect.Size())
+	}
+	var diffs int
+	var first string
+	for y := 0; y < want.Rect.Dy(); y++ {
+		for x := 0; x < want.Rect.Dx(); x++ {
+			i := y*want.Stride + 4*x
+			j := y*have.Stride + 4*x
+			w, h := want.Pix[i:i+4], have.Pix[j:j+4]
+			if bytes.Equal(w, h) {
+				continue
+			}
+			if diffs == 0 {
+				first = fmt.Sprintf("(%d, %d) is %v, want %v", x, y, h, w)
+			}
+			diffs++
+		}
+	}
+	if diffs > 0 {
+		return fmt.Errorf("webgltest: image does not match %s: %d pixels differ, first at %s", path, diffs, first)
+	}
+	return nil
+}
+
+// rawPixels returns the 8-bit pixels of img as an *image.NRGBA with its
+// origin at (0, 0). The bytes of *image.RGBA and *image.NRGBA images are
+// copied unchanged; other images are converted.
+func rawPixels(img image.Image) *image.NRGBA {
+	b := img.Bounds()
+	out := image.NewNRGBA(image.Rect(0, 0, b.Dx(), b.Dy()))
+	if b.Empty() {
+		return out
+	}
+	var pix []byte
+	var stride int
+	switch img := img.(type) {
+	case *image.RGBA:
+		pix, stride = img.Pix[img.PixOffset(b.Min.X, b.Min.Y):], img.Stride
+	case *image.NRGBA:
+		pix, stride = img.Pix[img.PixOffset(b.Min.X, b.Min.Y):], img.Stride
+	default:
+		draw.Draw(out, out.Rect, img, b.Min, draw.Src)
+		return out
+	}
+	for y := 0; y < b.Dy(); y++ {
+		copy(out.Pix[y*out.Stride:y*out.Stride+4*b.Dx()], pix[y*stride:])
+	}
+	return out
+}
+
+// Diff returns a line diff between want and got. Lines only in want are
+// prefixed with "-", lines only in got with "+", and runs of more than a
+// few unchanged lines are elided. It returns "" if want and got are equal.
+func Diff(want, got string) string {
+	if want == got {
+		return ""
+	}
+	a, b := splitLines(want), splitLines(got)
+
+	lines := diffMiddle(nil, a, b, 0, 0)
+
+	// Print changed lines with up to diffContext unchanged lines around them.
+	const diffContext = 3
+	var buf bytes.Buffer
+	last := -1
+	for k, l := range lines {
+		if l.op == ' ' {
+			near := false
+			for d := -diffContext; d <= diffContext && !near; d++ {
+				if n := k + d; n >= 0 && n < len(lines) && lines[n].op != ' ' {
+					near = true
+				}
+			}
+			if !near {
+				continue
+			}
+		}
+		if last >= 0 && k != last+1 {
+			buf.WriteString("...\n")
+		}
+		if last < 0 && k > 0 {
+			fmt.Fprintf(&buf, "@@ line %d\n", l.num)
+		}
+		last = k
+		fmt.Fprintf(&buf, "%c %s\n", l.op, l.text)
+	}
+	return buf.String()
+}
+
+// diffLine is a line of a diff. op is ' ' for a line in both texts, '-'
+// for a line only in want and '+' for a line only in got. num is the line
+// number in want, or in got for added lines.
+type diffLine struct {
+	op   byte
+	text string
+	num  int
+}
+
+// diffMiddle appends to lines a shortest edit script turning a into b. It
+// uses the linear space variant of Myers' algorithm: it finds the middle
+// snake of the script, the run of common lines at its halfway point, and
+// diffs the lines before and after it in turn. i and j are the numbers of
+// lines of want and got before a and b.
+func diffMiddle(lines []diffLine, a, b []string, i, j int) []diffLine {
+	for len(a) > 0 && len(b) > 0 && a[0] == b[0] {
+		lines = append(lines, diffLine{' ', a[0], i + 1})
+		a, b, i, j = a[1:], b[1:], i+1, j+1
+	}
+	suffix := 0
+	for suffix < len(a) && suffix < len(b) && a[len(a)-1-suffix] == b[len(b)-1-suffix] {
+		suffix++
+	}
+	common := a[len(a)-suffix:]
+	a, b = a[:len(a)-suffix], b[:len(b)-suffix]
+
+	switch {
+	case len(a) == 0:
+		for k, text := range b {
+			lines = append(lines, diffLine{'+', text, j + k + 1})
+		}
+	case len(b) == 0:
+		for k, text := range a {
+			lines = append(lines, diffLine{'-', text, i + k + 1})
+		}
+	default:
+		// With no common first or last line, at least two edits are
+		// needed, and either side of the middle snake needs fewer.
+		x, y, u, v := middleSnake(a, b)
+		lines = diffMiddle(lines, a[:x], b[:y], i, j)
+		for k := x; k < u; k++ {
+			lines = append(lines, diffLine{' ', a[k], i + k + 1})
+		}
+		lines = diffMiddle(lines, a[u:], b[v:], i+u, j+v)
+	}
+	for k, text := range common {
+		lines = append(lines, diffLine{' ', text, i + len(a) + k + 1})
+	}
+	return lines
+}
+
+// middleSnake returns the middle snake of a shortest edit script turning a
+// into b: a[x:u] equals b[y:v], and half of the edits are made before it.
+// It searches from both ends of the texts at once, keeping for each
+// diagonal only the furthest point reached.
+func middleSnake(a, b []string) (x, y, u, v int) {
+	n, m := len(a), len(b)
+	delta := n - m
+	off := n + m + 1
+
+	// forward[off+k] is the furthest x reached from (0, 0) on the diagonal
+	// x-y = k, and backward[off+k] the furthest distance reached from
+	// (n, m) on the diagonal (n-x)-(m-y) = k.
+	forward := make([]int, 2*off+1)
+	backward := make([]int, 2*off+1)
+	for d := 0; d <= (n+m+1)/2; d++ {
+		for k := -d; k <= d; k += 2 {
+			x := forward[off+k-1] + 1
+			if k == -d || k != d && forward[off+k-1] < forward[off+k+1] {
+				x = forward[off+k+1]
+			}
+			y := x - k
+			x0, y0 := x, y
+			for x < n && y < m && a[x] == b[y] {
+				x, y = x+1, y+1
+			}
+			forward[off+k] = x
+			if r := delta - k; delta%2 != 0 && r >= -(d-1) && r <= d-1 && x+backward[off+r] >= n {
+				return x0, y0, x, y
+			}
+		}
+		for k := -d; k <= d; k += 2 {
+			x := backward[off+k-1] + 1
+			if k == -d || k != d && backward[off+k-1] < backward[off+k+1] {
+				x = backward[off+k+1]
+			}
+			y := x - k
+			x0, y0 := x, y
+			for x < n && y < m && a[n-1-x] == b[m-1-y] {
+				x, y = x+1, y+1
+			}
+			backward[off+k] = x
+			if f := delta - k; delta%2 == 0 && f >= -d && f <= d && forward[off+f]+x >= n {
+				return n - x, m - y, n - x0, m - y0
+			}
+		}
+	}
+	panic("webgltest: no middle snake")
+}
+
+func splitLines(s string) []string {
+	if s == "" {
+		return nil
+	}
+	return strings.Split(strings.TrimSuffix(s, "\n"), "\n")
+}
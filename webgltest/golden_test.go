@@ -0,0 +1,104 @@
+// Copyright 2014 Joseph Hager. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package webgltest
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+)
+
+func TestDiff(t *testing.T) {
+	tests := []struct {
+		name      string
+		want, got string
+		diff      string
+	}{
+		{
+			name: "equal",
+			want: "Clear(COLOR_BUFFER_BIT)\n",
+			got:  "Clear(COLOR_BUFFER_BIT)\n",
+			diff: "",
+		},
+		{
+			name: "changed",
+			want: "Enable(BLEND)\nDrawArrays(TRIANGLES, 0, 3)\n",
+			got:  "Enable(DEPTH_TEST)\nDrawArrays(TRIANGLES, 0, 3)\n",
+			diff: "- Enable(BLEND)\n" +
+				"+ Enable(DEPTH_TEST)\n" +
+				"  DrawArrays(TRIANGLES, 0, 3)\n",
+		},
+		{
+			name: "added",
+			want: "a\nb\n",
+			got:  "a\nx\nb\n",
+			diff: "  a\n" +
+				"+ x\n" +
+				"  b\n",
+		},
+		{
+			name: "removed at end",
+			want: "a\nb\nc\n",
+			got:  "a\nb\n",
+			diff: "  a\n" +
+				"  b\n" +
+				"- c\n",
+		},
+		{
+			name: "from empty",
+			want: "",
+			got:  "a\n",
+			diff: "+ a\n",
+		},
+		{
+			name: "moved",
+			want: "a\nb\nc\n",
+			got:  "b\nc\na\n",
+			diff: "- a\n" +
+				"  b\n" +
+				"  c\n" +
+				"+ a\n",
+		},
+		{
+			name: "elided",
+			want: "1\n2\n3\n4\n5\n6\n7\n8\n9\n10\n11\n12\n13\n14\n15\n16\n",
+			got:  "1\n2\n3\n4\n5\nsix\n7\n8\n9\n10\n11\n12\n13\n14\n15\nsixteen\n",
+			diff: "@@ line 3\n" +
+				"  3\n  4\n  5\n" +
+				"- 6\n" +
+				"+ six\n" +
+				"  7\n  8\n  9\n" +
+				"...\n" +
+				"  13\n  14\n  15\n" +
+				"- 16\n" +
+				"+ sixteen\n",
+		},
+	}
+	for _, test := range tests {
+		if got := Diff(test.want, test.got); got != test.diff {
+			t.Errorf("%s: Diff =\n%s\nwant\n%s", test.name, got, test.diff)
+		}
+	}
+}
+
+// TestDiffLong checks that long traces are diffed without a matrix of
+// their line counts, and that the edit script is a shortest one.
+func TestDiffLong(t *testing.T) {
+	const n = 100000
+	var want, got strings.Builder
+	for i := 0; i < n; i++ {
+		fmt.Fprintf(&want, "DrawArrays(TRIANGLES, %d, 3)\n", i)
+		if i%1000 == 500 {
+			fmt.Fprintf(&got, "Flush()\n")
+			continue
+		}
+		fmt.Fprintf(&got, "DrawArrays(TRIANGLES, %d, 3)\n", i)
+	}
+	diff := Diff(want.String(), got.String())
+	removed, added := strings.Count(diff, "\n- "), strings.Count(diff, "\n+ ")
+	if removed != n/1000 || added != n/1000 {
+		t.Errorf("Diff removes %d and adds %d lines, want %d each", removed, added, n/1000)
+	}
+}